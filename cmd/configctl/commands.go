@@ -0,0 +1,357 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	"github.com/CSKU-Lab/config-server/internal/manifest"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+const chunkSize = 64 << 10
+
+func parseKind(name string) (manifest.Kind, error) {
+	switch strings.TrimSuffix(name, "s") {
+	case string(manifest.KindRunner):
+		return manifest.KindRunner, nil
+	case string(manifest.KindCompare):
+		return manifest.KindCompare, nil
+	default:
+		return "", fmt.Errorf("%w: unknown kind %q, expected runner or compare", errUsage, name)
+	}
+}
+
+func (c *ctl) list(ctx context.Context, kind manifest.Kind) error {
+	if kind == manifest.KindRunner {
+		res, err := c.client.GetAllRunners(ctx, &pb.GetAllRunnersRequest{IncludeMetadata: true})
+		if err != nil {
+			return err
+		}
+
+		return c.out.print(res, func(w *tabwriter.Writer) {
+			fmt.Fprintln(w, "ID\tNAME\tPARENT\tDESCRIPTION")
+			for _, runner := range res.GetRunners() {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", runner.GetId(), runner.GetName(), runner.GetParentId(), oneLine(runner.GetDescription()))
+			}
+		})
+	}
+
+	res, err := c.client.GetAllCompares(ctx, &pb.GetAllComparesRequest{IncludeMetadata: true})
+	if err != nil {
+		return err
+	}
+
+	return c.out.print(res, func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "ID\tNAME\tRUN NAME\tPRESET\tDESCRIPTION")
+		for _, compare := range res.GetCompares() {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", compare.GetId(), compare.GetName(), compare.GetRunName(), compare.GetPresetId(), oneLine(compare.GetDescription()))
+		}
+	})
+}
+
+func (c *ctl) get(ctx context.Context, kind manifest.Kind, ID string) error {
+	if kind == manifest.KindRunner {
+		runner, err := c.client.GetRunner(ctx, &pb.GetRunnerRequest{Id: ID})
+		if err != nil {
+			return err
+		}
+
+		return c.printDetails(runner, func(w *tabwriter.Writer) {
+			fmt.Fprintf(w, "ID:\t%s\n", runner.GetId())
+			fmt.Fprintf(w, "Name:\t%s\n", runner.GetName())
+			fmt.Fprintf(w, "Description:\t%s\n", oneLine(runner.GetDescription()))
+			fmt.Fprintf(w, "Parent:\t%s\n", runner.GetParentId())
+			fmt.Fprintf(w, "Limit profile:\t%s\n", runner.GetDefaultLimitProfileId())
+			printFiles(w, runner.GetInitialFiles())
+		}, runner.GetBuildScript(), runner.GetRunScript())
+	}
+
+	compare, err := c.client.GetCompare(ctx, &pb.GetCompareRequest{Id: ID})
+	if err != nil {
+		return err
+	}
+
+	return c.printDetails(compare, func(w *tabwriter.Writer) {
+		fmt.Fprintf(w, "ID:\t%s\n", compare.GetId())
+		fmt.Fprintf(w, "Name:\t%s\n", compare.GetName())
+		fmt.Fprintf(w, "Description:\t%s\n", oneLine(compare.GetDescription()))
+		fmt.Fprintf(w, "Run name:\t%s\n", compare.GetRunName())
+		if compare.GetPresetId() != "" {
+			fmt.Fprintf(w, "Preset:\t%s v%d\n", compare.GetPresetId(), compare.GetPresetVersion())
+		}
+		fmt.Fprintf(w, "Golden cases:\t%d\n", len(compare.GetGoldenCases()))
+		printFiles(w, compare.GetFiles())
+	}, compare.GetBuildScript(), compare.GetRunScript())
+}
+
+func printFiles(w *tabwriter.Writer, files []*pb.File) {
+	for i, f := range files {
+		label := ""
+		if i == 0 {
+			label = "Files:"
+		}
+		fmt.Fprintf(w, "%s\t%s (%d bytes)\n", label, f.GetName(), f.GetSize())
+	}
+}
+
+// printDetails prints the scripts after the table since they span many
+// lines.
+func (c *ctl) printDetails(msg proto.Message, table func(w *tabwriter.Writer), buildScript string, runScript string) error {
+	err := c.out.print(msg, table)
+	if err != nil || c.out.format != formatTable {
+		return err
+	}
+
+	for _, script := range [][2]string{{"Build script", buildScript}, {"Run script", runScript}} {
+		if script[1] != "" {
+			fmt.Fprintf(c.out.w, "\n%s:\n%s\n", script[0], strings.TrimRight(script[1], "\n"))
+		}
+	}
+	return nil
+}
+
+func (c *ctl) create(ctx context.Context, kind manifest.Kind, dir string) error {
+	tree, err := manifest.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	m, err := manifest.Parse(tree)
+	if err != nil {
+		return err
+	}
+	if m.Kind != kind {
+		return fmt.Errorf("%s describes a %s, not a %s", dir, m.Kind, kind)
+	}
+	if m.ID != "" {
+		return fmt.Errorf("%s already has the id %s, use apply to update it", dir, m.ID)
+	}
+
+	var buf bytes.Buffer
+	err = manifest.WriteArchive(&buf, manifest.FormatTarGz, tree)
+	if err != nil {
+		return err
+	}
+
+	var ID string
+	if kind == manifest.KindRunner {
+		stream, err := c.client.ImportRunnerArchive(ctx)
+		if err != nil {
+			return err
+		}
+		res, err := sendChunks(stream, buf.Bytes(), func(data []byte) *pb.ImportArchiveRequest {
+			return &pb.ImportArchiveRequest{Data: data}
+		})
+		if err != nil {
+			return err
+		}
+		ID = res.GetId()
+	} else {
+		stream, err := c.client.ImportCompareArchive(ctx)
+		if err != nil {
+			return err
+		}
+		res, err := sendChunks(stream, buf.Bytes(), func(data []byte) *pb.ImportArchiveRequest {
+			return &pb.ImportArchiveRequest{Data: data}
+		})
+		if err != nil {
+			return err
+		}
+		ID = res.GetId()
+	}
+
+	fmt.Fprintf(c.out.w, "Created %s %s\n", kind, ID)
+	return nil
+}
+
+// edit opens the scripts of a runner or compare in $VISUAL or $EDITOR and
+// saves them if they changed. Runners are edited as stored, without what
+// they inherit.
+func (c *ctl) edit(ctx context.Context, kind manifest.Kind, ID string) error {
+	getCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var buildScript, runScript string
+	if kind == manifest.KindRunner {
+		runner, err := c.client.GetRunner(getCtx, &pb.GetRunnerRequest{Id: ID, IncludeRaw: true})
+		if err != nil {
+			return err
+		}
+		if runner.GetRaw() != nil {
+			runner = runner.GetRaw()
+		}
+		buildScript, runScript = runner.GetBuildScript(), runner.GetRunScript()
+	} else {
+		compare, err := c.client.GetCompare(getCtx, &pb.GetCompareRequest{Id: ID})
+		if err != nil {
+			return err
+		}
+		if compare.GetPresetId() != "" {
+			return fmt.Errorf("compare %s is generated from preset %s and cannot be edited", ID, compare.GetPresetId())
+		}
+		buildScript, runScript = compare.GetBuildScript(), compare.GetRunScript()
+	}
+
+	dir, err := os.MkdirTemp("", "configctl-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	buildPath := filepath.Join(dir, "build.sh")
+	runPath := filepath.Join(dir, "run.sh")
+	for path, script := range map[string]string{buildPath: buildScript, runPath: runScript} {
+		err := os.WriteFile(path, []byte(script), 0o600)
+		if err != nil {
+			return err
+		}
+	}
+
+	editor := envOr("VISUAL", envOr("EDITOR", "vi"))
+	args := append(strings.Fields(editor), buildPath, runPath)
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	err = cmd.Run()
+	if err != nil {
+		return fmt.Errorf("editor failed: %w", err)
+	}
+
+	newBuild, err := os.ReadFile(buildPath)
+	if err != nil {
+		return err
+	}
+	newRun, err := os.ReadFile(runPath)
+	if err != nil {
+		return err
+	}
+
+	if string(newBuild) == buildScript && string(newRun) == runScript {
+		fmt.Fprintln(c.out.w, "Nothing changed.")
+		return nil
+	}
+
+	updateCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	build, run := string(newBuild), string(newRun)
+	if kind == manifest.KindRunner {
+		_, err = c.client.UpdateRunner(updateCtx, &pb.UpdateRunnerRequest{Id: ID, BuildScript: &build, RunScript: &run})
+	} else {
+		_, err = c.client.UpdateCompare(updateCtx, &pb.UpdateCompareRequest{Id: ID, BuildScript: &build, RunScript: &run})
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.out.w, "Updated %s %s\n", kind, ID)
+	return nil
+}
+
+func (c *ctl) delete(ctx context.Context, kind manifest.Kind, ID string) error {
+	var err error
+	if kind == manifest.KindRunner {
+		_, err = c.client.DeleteRunner(ctx, &pb.DeleteRunnerRequest{Id: ID})
+	} else {
+		_, err = c.client.DeleteCompare(ctx, &pb.DeleteCompareRequest{Id: ID})
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.out.w, "Deleted %s %s\n", kind, ID)
+	return nil
+}
+
+func (c *ctl) apply(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("apply", flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "only print the plan")
+	prune := fs.Bool("prune", false, "delete runners and compares missing from the directory")
+	err := fs.Parse(args)
+	if err != nil {
+		return errUsage
+	}
+	if fs.NArg() != 1 {
+		return errUsage
+	}
+
+	res, err := c.sync(ctx, fs.Arg(0), *dryRun, *prune)
+	if err != nil {
+		return err
+	}
+
+	return c.out.print(res, func(w *tabwriter.Writer) {
+		counts := map[string]int{}
+		for _, change := range res.GetChanges() {
+			counts[change.GetAction()]++
+			if change.GetAction() != "unchanged" {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", change.GetAction(), change.GetKind(), change.GetName(), change.GetId(), strings.Join(change.GetPaths(), ", "))
+			}
+		}
+		w.Flush()
+
+		fmt.Fprintf(w, "\n%d to create, %d to update, %d to delete, %d unchanged.\n", counts["create"], counts["update"], counts["delete"], counts["unchanged"])
+		if !res.GetApplied() {
+			fmt.Fprintln(w, "Nothing was applied.")
+		}
+	})
+}
+
+func (c *ctl) sync(ctx context.Context, dir string, dryRun bool, prune bool) (*pb.SyncResponse, error) {
+	tree, err := manifest.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = manifest.WriteArchive(&buf, manifest.FormatTarGz, tree)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.client.Sync(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return sendChunks(stream, buf.Bytes(), func(data []byte) *pb.SyncRequest {
+		return &pb.SyncRequest{Data: data, DryRun: dryRun, Prune: prune}
+	})
+}
+
+// sendChunks streams data in chunks, always sending at least one message.
+func sendChunks[Req any, Res any](stream grpc.ClientStreamingClient[Req, Res], data []byte, chunk func(data []byte) *Req) (*Res, error) {
+	for first := true; first || len(data) > 0; first = false {
+		n := min(len(data), chunkSize)
+		// A failed send is reported by CloseAndRecv.
+		if stream.Send(chunk(data[:n])) != nil {
+			break
+		}
+		data = data[n:]
+	}
+	return stream.CloseAndRecv()
+}
+
+func receiveChunks(stream grpc.ServerStreamingClient[pb.ArchiveChunk]) ([]byte, error) {
+	var buf bytes.Buffer
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return buf.Bytes(), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(chunk.GetData())
+	}
+}
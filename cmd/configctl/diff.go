@@ -0,0 +1,315 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	"github.com/CSKU-Lab/config-server/internal/gitops"
+	"github.com/CSKU-Lab/config-server/internal/manifest"
+	"google.golang.org/grpc"
+)
+
+const (
+	diffContext = 3
+	// maxDiffCells bounds the memory of a line diff, larger files are shown
+	// as replaced.
+	maxDiffCells = 1 << 22
+)
+
+// errDiffer makes configctl exit with 1 when diff finds changes, like diff.
+var errDiffer = errors.New("definitions differ from the server")
+
+// diff prints what apply would change, as a unified diff of every changed
+// file.
+func (c *ctl) diff(ctx context.Context, dir string) error {
+	res, err := c.sync(ctx, dir, true, false)
+	if err != nil {
+		return err
+	}
+
+	tree, err := manifest.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	ids := map[string]string{}
+	for _, change := range res.GetChanges() {
+		ids[change.GetKind()+"/"+change.GetName()] = change.GetId()
+	}
+
+	local := map[string]manifest.Tree{}
+	for _, def := range gitops.Split(tree) {
+		m, err := manifest.Parse(def)
+		if err != nil {
+			return err
+		}
+		local[string(m.Kind)+"/"+m.Name], err = canonical(ctx, m, def, ids)
+		if err != nil {
+			return err
+		}
+	}
+
+	differ := false
+	for _, change := range res.GetChanges() {
+		action := gitops.Action(change.GetAction())
+		if action == gitops.ActionUnchanged {
+			continue
+		}
+		differ = true
+
+		var current manifest.Tree
+		if action != gitops.ActionCreate {
+			current, err = c.export(ctx, manifest.Kind(change.GetKind()), change.GetId())
+			if err != nil {
+				return err
+			}
+		}
+		desired := local[change.GetKind()+"/"+change.GetName()]
+
+		paths := change.GetPaths()
+		if action != gitops.ActionUpdate {
+			paths = slices.Sorted(maps.Keys(current))
+			paths = append(paths, slices.Sorted(maps.Keys(desired))...)
+		}
+		prefix := change.GetKind() + "s/" + change.GetName() + "/"
+		for _, p := range paths {
+			writeDiff(c.out.w, prefix+p, lookup(current, p), lookup(desired, p))
+		}
+	}
+
+	if differ {
+		return errDiffer
+	}
+	return nil
+}
+
+// canonical builds the tree of a local definition as the server would store
+// it, so that only meaningful changes show up. ids holds the ID of every
+// definition keyed by kind and name.
+func canonical(ctx context.Context, m *manifest.Manifest, def manifest.Tree, ids map[string]string) (manifest.Tree, error) {
+	if m.Kind == manifest.KindCompare {
+		compare, err := m.Compare(def)
+		if err != nil {
+			return nil, err
+		}
+		compare.ID = ids[string(manifest.KindCompare)+"/"+compare.Name]
+		return manifest.FromCompare(ctx, compare, nil)
+	}
+
+	runner, err := m.Runner(def)
+	if err != nil {
+		return nil, err
+	}
+	runner.ID = ids[string(manifest.KindRunner)+"/"+runner.Name]
+	// Parents may be given by the name of a runner of the directory.
+	if ID, ok := ids[string(manifest.KindRunner)+"/"+runner.ParentID]; ok && ID != "" {
+		runner.ParentID = ID
+	}
+	return manifest.FromRunner(ctx, runner, nil)
+}
+
+func (c *ctl) export(ctx context.Context, kind manifest.Kind, ID string) (manifest.Tree, error) {
+	req := &pb.ExportArchiveRequest{Id: ID, Format: pb.ArchiveFormat_ARCHIVE_FORMAT_TAR_GZ}
+
+	var (
+		stream grpc.ServerStreamingClient[pb.ArchiveChunk]
+		err    error
+	)
+	if kind == manifest.KindRunner {
+		stream, err = c.client.ExportRunnerArchive(ctx, req)
+	} else {
+		stream, err = c.client.ExportCompareArchive(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := receiveChunks(stream)
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest.ReadArchive(data, manifest.DefaultLimits)
+}
+
+func lookup(tree manifest.Tree, p string) *manifest.Entry {
+	entry, ok := tree[p]
+	if !ok {
+		return nil
+	}
+	return &entry
+}
+
+// writeDiff writes the unified diff of a file. A nil entry is a missing
+// file.
+func writeDiff(w io.Writer, name string, current *manifest.Entry, desired *manifest.Entry) {
+	from, to := "a/"+name, "b/"+name
+	if current == nil {
+		from = "/dev/null"
+	}
+	if desired == nil {
+		to = "/dev/null"
+	}
+
+	var oldData, newData []byte
+	if current != nil {
+		oldData = current.Data
+	}
+	if desired != nil {
+		newData = desired.Data
+	}
+
+	if current != nil && desired != nil && current.Mode != desired.Mode {
+		fmt.Fprintf(w, "diff %s\nold mode %o\nnew mode %o\n", name, current.Mode, desired.Mode)
+	}
+	if string(oldData) == string(newData) && current != nil && desired != nil {
+		return
+	}
+	if !utf8.Valid(oldData) || !utf8.Valid(newData) {
+		fmt.Fprintf(w, "Binary files %s and %s differ\n", from, to)
+		return
+	}
+
+	fmt.Fprintf(w, "--- %s\n+++ %s\n", from, to)
+	for _, hunk := range hunks(splitLines(oldData), splitLines(newData)) {
+		fmt.Fprint(w, hunk)
+	}
+}
+
+func splitLines(data []byte) []string {
+	if len(data) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(string(data), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+type diffLine struct {
+	op   byte
+	text string
+}
+
+// lineDiff returns the edit script turning a into b, from their longest
+// common subsequence.
+func lineDiff(a []string, b []string) []diffLine {
+	if (len(a)+1)*(len(b)+1) > maxDiffCells {
+		var lines []diffLine
+		for _, text := range a {
+			lines = append(lines, diffLine{'-', text})
+		}
+		for _, text := range b {
+			lines = append(lines, diffLine{'+', text})
+		}
+		return lines
+	}
+
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var lines []diffLine
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, diffLine{' ', a[i]})
+			i, j = i+1, j+1
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, diffLine{'-', a[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, diffLine{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, diffLine{'+', b[j]})
+	}
+	return lines
+}
+
+// hunks groups the edit script into hunks with a few lines of context.
+func hunks(a []string, b []string) []string {
+	lines := lineDiff(a, b)
+
+	var result []string
+	for start := 0; start < len(lines); {
+		// Find the next change and every change close enough to it.
+		first := start
+		for first < len(lines) && lines[first].op == ' ' {
+			first++
+		}
+		if first == len(lines) {
+			break
+		}
+		last := first
+		for k := first; k < len(lines) && k-last <= 2*diffContext; k++ {
+			if lines[k].op != ' ' {
+				last = k
+			}
+		}
+
+		from := max(first-diffContext, start)
+		to := min(last+diffContext+1, len(lines))
+
+		// Line numbers of the hunk in both files.
+		oldStart, newStart := 1, 1
+		for _, line := range lines[:from] {
+			if line.op != '+' {
+				oldStart++
+			}
+			if line.op != '-' {
+				newStart++
+			}
+		}
+
+		var body strings.Builder
+		oldCount, newCount := 0, 0
+		for _, line := range lines[from:to] {
+			if line.op != '+' {
+				oldCount++
+			}
+			if line.op != '-' {
+				newCount++
+			}
+			body.WriteByte(line.op)
+			body.WriteString(line.text)
+			if !strings.HasSuffix(line.text, "\n") {
+				body.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		if oldCount == 0 {
+			oldStart--
+		}
+		if newCount == 0 {
+			newStart--
+		}
+
+		result = append(result, fmt.Sprintf("@@ -%d,%d +%d,%d @@\n%s", oldStart, oldCount, newStart, newCount, body.String()))
+		start = to
+	}
+	return result
+}
@@ -0,0 +1,189 @@
+// Command configctl administers runners and compares of a config server.
+//
+//	configctl [flags] list runners|compares
+//	configctl [flags] get runner|compare ID
+//	configctl [flags] create runner|compare DIR
+//	configctl [flags] edit runner|compare ID
+//	configctl [flags] delete runner|compare ID
+//	configctl [flags] diff DIR
+//	configctl [flags] apply [-dry-run] [-prune] DIR
+//
+// DIR holds manifests in the layout of the archive import, one definition
+// per directory for diff and apply.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const usage = `usage: configctl [flags] COMMAND
+
+Commands:
+  list runners|compares
+  get runner|compare ID
+  create runner|compare DIR
+  edit runner|compare ID
+  delete runner|compare ID
+  diff DIR
+  apply [-dry-run] [-prune] DIR
+
+Flags:
+`
+
+// errUsage makes configctl print its usage.
+var errUsage = errors.New("invalid arguments")
+
+type ctl struct {
+	client  pb.ConfigServiceClient
+	out     *printer
+	timeout time.Duration
+}
+
+func main() {
+	addr := flag.String("addr", envOr("CONFIG_SERVER_ADDR", "localhost:8081"), "config server address, defaults to $CONFIG_SERVER_ADDR")
+	token := flag.String("token", os.Getenv("CONFIG_SERVER_TOKEN"), "bearer token, defaults to $CONFIG_SERVER_TOKEN")
+	useTLS := flag.Bool("tls", false, "connect with TLS")
+	caFile := flag.String("ca", "", "CA certificate to verify the server with, implies -tls")
+	serverName := flag.String("server-name", "", "server name to verify, defaults to the host of -addr")
+	skipVerify := flag.Bool("insecure-skip-verify", false, "don't verify the server certificate")
+	output := flag.String("o", "table", "output format: table, json or yaml")
+	timeout := flag.Duration("timeout", time.Minute, "request timeout")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	out, err := newPrinter(*output)
+	if err != nil {
+		fail(err)
+	}
+
+	creds := insecure.NewCredentials()
+	if *useTLS || *caFile != "" || *skipVerify {
+		tlsConfig := &tls.Config{
+			ServerName:         *serverName,
+			InsecureSkipVerify: *skipVerify,
+		}
+		if *caFile != "" {
+			pem, err := os.ReadFile(*caFile)
+			if err != nil {
+				fail(err)
+			}
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+				fail(fmt.Errorf("no certificate found in %s", *caFile))
+			}
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		fail(err)
+	}
+	defer conn.Close()
+
+	ctx := context.Background()
+	if *token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+*token)
+	}
+
+	c := &ctl{
+		client:  pb.NewConfigServiceClient(conn),
+		out:     out,
+		timeout: *timeout,
+	}
+
+	err = c.run(ctx, flag.Args())
+	if errors.Is(err, errUsage) {
+		if err != errUsage {
+			fmt.Fprintln(os.Stderr, "configctl:", err)
+		}
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fail(err)
+	}
+}
+
+func (c *ctl) run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	command, args := args[0], args[1:]
+
+	// Edits time out per request, since the editor may stay open for long.
+	if command != "edit" {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	switch command {
+	case "apply":
+		return c.apply(ctx, args)
+	case "diff":
+		if len(args) != 1 {
+			return errUsage
+		}
+		return c.diff(ctx, args[0])
+	case "list":
+		if len(args) != 1 {
+			return errUsage
+		}
+		kind, err := parseKind(args[0])
+		if err != nil {
+			return err
+		}
+		return c.list(ctx, kind)
+	}
+
+	if len(args) != 2 {
+		return errUsage
+	}
+	kind, err := parseKind(args[0])
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case "get":
+		return c.get(ctx, kind, args[1])
+	case "create":
+		return c.create(ctx, kind, args[1])
+	case "edit":
+		return c.edit(ctx, kind, args[1])
+	case "delete":
+		return c.delete(ctx, kind, args[1])
+	default:
+		return fmt.Errorf("%w: unknown command %q", errUsage, command)
+	}
+}
+
+func envOr(key string, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "configctl:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+type format string
+
+const (
+	formatTable format = "table"
+	formatJSON  format = "json"
+	formatYAML  format = "yaml"
+)
+
+type printer struct {
+	format format
+	w      io.Writer
+}
+
+func newPrinter(name string) (*printer, error) {
+	f := format(name)
+	if f != formatTable && f != formatJSON && f != formatYAML {
+		return nil, fmt.Errorf("unknown output format %q, expected table, json or yaml", name)
+	}
+	return &printer{format: f, w: os.Stdout}, nil
+}
+
+// print writes msg as JSON or YAML, or calls table for the table format.
+func (p *printer) print(msg proto.Message, table func(w *tabwriter.Writer)) error {
+	if p.format == formatTable {
+		w := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+		table(w)
+		return w.Flush()
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true, Multiline: true}.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	if p.format == formatJSON {
+		_, err = fmt.Fprintln(p.w, string(data))
+		return err
+	}
+
+	var doc any
+	err = json.Unmarshal(data, &doc)
+	if err != nil {
+		return err
+	}
+	data, err = yaml.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	_, err = p.w.Write(data)
+	return err
+}
+
+// oneLine shortens text to a single table cell.
+func oneLine(text string) string {
+	text, _, cut := strings.Cut(text, "\n")
+	if runes := []rune(text); len(runes) > 60 {
+		text, cut = string(runes[:57]), true
+	}
+	if cut {
+		text += "..."
+	}
+	return text
+}
@@ -0,0 +1,302 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"slices"
+
+	"github.com/CSKU-Lab/config-server/domain/requests"
+	"github.com/CSKU-Lab/config-server/domain/services"
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	"github.com/CSKU-Lab/config-server/internal/manifest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxArchiveSize = 64 << 20
+
+func (c *configServiceServer) ImportCompareArchive(stream grpc.ClientStreamingServer[pb.ImportArchiveRequest, pb.CreateCompareResponse]) error {
+	ctx := stream.Context()
+
+	ID, m, tree, err := receiveArchive(stream)
+	if err != nil {
+		return err
+	}
+
+	compare, err := m.Compare(tree)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	imported := ID
+	err = c.importInTransaction(ctx, func(ctx context.Context) error {
+		if ID == "" {
+			var err error
+			imported, err = c.compareService.Create(ctx, &requests.CreateCompare{
+				Name:          compare.Name,
+				Slug:          compare.Slug,
+				Files:         compare.Files,
+				BuildScript:   compare.BuildScript,
+				RunScript:     compare.RunScript,
+				RunName:       compare.RunName,
+				Description:   compare.Description,
+				GoldenCases:   compare.GoldenCases,
+				PresetID:      compare.PresetID,
+				PresetVersion: compare.PresetVersion,
+				PresetParams:  compare.PresetParams,
+				Namespace:     compare.Namespace,
+			})
+			return err
+		}
+
+		body := &requests.UpdateCompare{
+			Name:        &compare.Name,
+			Files:       compare.Files,
+			BuildScript: &compare.BuildScript,
+			RunScript:   &compare.RunScript,
+			RunName:     &compare.RunName,
+			Description: &compare.Description,
+			GoldenCases: compare.GoldenCases,
+		}
+		err := c.skipGenerated(ctx, ID, tree, body)
+		if err != nil {
+			return err
+		}
+
+		err = c.compareService.UpdateByID(ctx, ID, body)
+		if err != nil || compare.Slug == "" {
+			return err
+		}
+		return c.compareService.RenameSlug(ctx, ID, compare.Slug)
+	})
+	if err != nil {
+		return toStatus(err)
+	}
+
+	c.publisher.Publish()
+
+	return stream.SendAndClose(&pb.CreateCompareResponse{
+		Id: imported,
+	})
+}
+
+func (c *configServiceServer) ExportCompareArchive(req *pb.ExportArchiveRequest, stream grpc.ServerStreamingServer[pb.ArchiveChunk]) error {
+	if req.GetId() == "" {
+		return status.Error(codes.InvalidArgument, "Id is required!")
+	}
+
+	compare, err := c.compareService.GetByID(stream.Context(), req.GetId())
+	if err != nil {
+		return toStatus(err)
+	}
+
+	tree, err := manifest.FromCompare(stream.Context(), compare, c.fileService)
+	if err != nil {
+		return toStatus(err)
+	}
+
+	return sendArchive(stream, req.GetFormat(), tree)
+}
+
+func (c *configServiceServer) ImportRunnerArchive(stream grpc.ClientStreamingServer[pb.ImportArchiveRequest, pb.CreateRunnerResponse]) error {
+	ctx := stream.Context()
+
+	ID, m, tree, err := receiveArchive(stream)
+	if err != nil {
+		return err
+	}
+
+	runner, err := m.Runner(tree)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Runners are created without scripts and files, so they are always set
+	// with an update.
+	imported := ID
+	err = c.importInTransaction(ctx, func(ctx context.Context) error {
+		if ID == "" {
+			var err error
+			imported, err = c.runnerService.Create(ctx, &requests.CreateRunner{
+				Name:        runner.Name,
+				Slug:        runner.Slug,
+				Description: runner.Description,
+				ParentID:    runner.ParentID,
+				Variables:   runner.Variables,
+				Env:         runner.Env,
+
+				DefaultLimitProfileID: runner.DefaultLimitProfileID,
+				LimitMultipliers:      runner.LimitMultipliers,
+				Metadata:              runner.Metadata,
+
+				Namespace: runner.Namespace,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		err := c.runnerService.UpdateByID(ctx, imported, &requests.UpdateRunner{
+			Name:         &runner.Name,
+			Description:  &runner.Description,
+			BuildScript:  &runner.BuildScript,
+			RunScript:    &runner.RunScript,
+			InitialFiles: runner.InitialFiles,
+			ParentID:     &runner.ParentID,
+			Variables:    runner.Variables,
+			Env:          runner.Env,
+
+			DefaultLimitProfileID: &runner.DefaultLimitProfileID,
+			LimitMultipliers:      runner.LimitMultipliers,
+			Metadata:              runner.Metadata,
+
+			StarterTemplate: &runner.StarterTemplate,
+			EntryPoint:      &runner.EntryPoint,
+		})
+		if err != nil || runner.Slug == "" {
+			return err
+		}
+		return c.runnerService.RenameSlug(ctx, imported, runner.Slug)
+	})
+	if err != nil {
+		return toStatus(err)
+	}
+
+	c.publisher.Publish()
+
+	return stream.SendAndClose(&pb.CreateRunnerResponse{
+		Id: imported,
+	})
+}
+
+func (c *configServiceServer) ExportRunnerArchive(req *pb.ExportArchiveRequest, stream grpc.ServerStreamingServer[pb.ArchiveChunk]) error {
+	if req.GetId() == "" {
+		return status.Error(codes.InvalidArgument, "Id is required!")
+	}
+
+	runner, err := c.runnerService.GetRawByID(stream.Context(), req.GetId())
+	if err != nil {
+		return toStatus(err)
+	}
+
+	tree, err := manifest.FromRunner(stream.Context(), runner, c.fileService)
+	if err != nil {
+		return toStatus(err)
+	}
+
+	return sendArchive(stream, req.GetFormat(), tree)
+}
+
+// importInTransaction runs an import in a single transaction, so that a
+// failing step doesn't leave a half imported runner or compare behind. Its
+// changes are recorded as a single revision once committed.
+func (c *configServiceServer) importInTransaction(ctx context.Context, apply func(ctx context.Context) error) error {
+	var changes *services.ChangeSet
+	err := c.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		// The transaction may be retried, only the last try counts.
+		changes = &services.ChangeSet{}
+		return apply(services.WithChangeSet(ctx, changes))
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.revisionService.Record(ctx, changes.Changes()...)
+	return err
+}
+
+// skipGenerated leaves the scripts and files of a preset compare out of the
+// update when the archive has the generated ones, so that an exported compare
+// can be imported back. Edited ones are kept, for the update to refuse them.
+func (c *configServiceServer) skipGenerated(ctx context.Context, ID string, tree manifest.Tree, body *requests.UpdateCompare) error {
+	current, err := c.compareService.GetByID(ctx, ID)
+	if err != nil || !current.IsReadOnly() {
+		return err
+	}
+
+	currentTree, err := manifest.FromCompare(ctx, current, c.fileService)
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(manifest.Diff(currentTree, tree), func(p string) bool { return p != manifest.ManifestName }) {
+		body.Files, body.BuildScript, body.RunScript = nil, nil, nil
+	}
+	if *body.RunName == current.RunName {
+		body.RunName = nil
+	}
+	return nil
+}
+
+// receiveArchive collects the archive sent in chunks. The target ID is taken
+// from the first message.
+func receiveArchive(stream grpc.ServerStream) (string, *manifest.Manifest, manifest.Tree, error) {
+	var (
+		ID  string
+		buf bytes.Buffer
+	)
+	for first := true; ; first = false {
+		var chunk pb.ImportArchiveRequest
+		err := stream.RecvMsg(&chunk)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", nil, nil, err
+		}
+
+		if first {
+			ID = chunk.GetId()
+		}
+		if buf.Len()+len(chunk.GetData()) > maxArchiveSize {
+			return "", nil, nil, status.Errorf(codes.ResourceExhausted, "archive is larger than %d bytes", maxArchiveSize)
+		}
+		buf.Write(chunk.GetData())
+	}
+
+	tree, err := manifest.ReadArchive(buf.Bytes(), manifest.DefaultLimits)
+	if err != nil {
+		return "", nil, nil, archiveError(err)
+	}
+
+	m, err := manifest.Parse(tree)
+	if err != nil {
+		return "", nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return ID, m, tree, nil
+}
+
+// archiveError answers ResourceExhausted for archives extracting past their
+// limits and InvalidArgument for other unreadable archives.
+func archiveError(err error) error {
+	if errors.Is(err, manifest.ErrTooLarge) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+func sendArchive(stream grpc.ServerStreamingServer[pb.ArchiveChunk], format pb.ArchiveFormat, tree manifest.Tree) error {
+	archiveFormat := manifest.FormatZip
+	if format == pb.ArchiveFormat_ARCHIVE_FORMAT_TAR_GZ {
+		archiveFormat = manifest.FormatTarGz
+	}
+
+	var buf bytes.Buffer
+	err := manifest.WriteArchive(&buf, archiveFormat, tree)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	for data := buf.Bytes(); len(data) > 0; {
+		n := min(len(data), downloadChunkSize)
+		err := stream.Send(&pb.ArchiveChunk{Data: data[:n]})
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	return nil
+}
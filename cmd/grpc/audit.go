@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/CSKU-Lab/config-server/domain/requests"
+	"github.com/CSKU-Lab/config-server/domain/services"
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	"github.com/CSKU-Lab/config-server/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// authorUnaryInterceptor attributes the changes made by a call to the
+// authenticated caller.
+func authorUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(services.WithAuthor(ctx, auth.FromContext(ctx).Name), req)
+	}
+}
+
+func authorStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := services.WithAuthor(ss.Context(), auth.FromContext(ss.Context()).Name)
+		return handler(srv, &authorStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type authorStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorStream) Context() context.Context {
+	return s.ctx
+}
+
+// paginationRequest converts a pagination request. Sorting and filtering by
+// audit is refused unless audited is set.
+func paginationRequest(p *pb.PaginationRequest, audited bool) (*requests.GetPagination, error) {
+	req := &requests.GetPagination{
+		Page:      int(p.GetPage()),
+		PageSize:  int(p.GetPageSize()),
+		SortOrder: p.GetSortOrder(),
+		Search:    p.GetSearch(),
+		SortBy:    p.GetSortBy(),
+
+		CreatedBy:     p.GetCreatedBy(),
+		UpdatedBy:     p.GetUpdatedBy(),
+		CreatedAfter:  fromTimestamp(p.GetCreatedAfter()),
+		CreatedBefore: fromTimestamp(p.GetCreatedBefore()),
+		UpdatedAfter:  fromTimestamp(p.GetUpdatedAfter()),
+		UpdatedBefore: fromTimestamp(p.GetUpdatedBefore()),
+	}
+
+	switch req.SortBy {
+	case "", requests.SortByName:
+	case requests.SortByCreatedAt, requests.SortByUpdatedAt:
+		if !audited {
+			return nil, status.Errorf(codes.InvalidArgument, "Cannot sort by %s, only by name!", req.SortBy)
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Cannot sort by %s, expected name, created_at or updated_at!", req.SortBy)
+	}
+
+	filtered := req.CreatedBy != "" || req.UpdatedBy != "" ||
+		!req.CreatedAfter.IsZero() || !req.CreatedBefore.IsZero() ||
+		!req.UpdatedAfter.IsZero() || !req.UpdatedBefore.IsZero()
+	if filtered && !audited {
+		return nil, status.Error(codes.InvalidArgument, "Only runners and compares can be filtered by creation or update!")
+	}
+
+	return req, nil
+}
+
+// timestamp converts t, leaving it unset when t is zero.
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func fromTimestamp(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	"github.com/CSKU-Lab/config-server/internal/broadcast"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// BeginBatch holds the grader broadcasts until the batch is committed, so a
+// bulk edit is broadcast once. Changes are still applied right away. A batch
+// left open expires on its own.
+func (c *configServiceServer) BeginBatch(ctx context.Context, req *pb.BeginBatchRequest) (*pb.BeginBatchResponse, error) {
+	ID, expiry, err := c.publisher.BeginBatch()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.BeginBatchResponse{
+		BatchId:    ID,
+		ExpireTime: timestamppb.New(expiry),
+	}, nil
+}
+
+// CommitBatch closes a batch and broadcasts the changes made meanwhile, once
+// no other batch is open.
+func (c *configServiceServer) CommitBatch(ctx context.Context, req *pb.CommitBatchRequest) (*emptypb.Empty, error) {
+	if req.GetBatchId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "BatchId is required!")
+	}
+
+	err := c.publisher.CommitBatch(req.GetBatchId())
+	if errors.Is(err, broadcast.ErrUnknownBatch) {
+		return nil, status.Errorf(codes.NotFound, "batch %s : %v", req.GetBatchId(), err)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// parseDuration parses the duration set in the environment variable name,
+// zero when unset.
+func parseDuration(name string, value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Fatalf("Invalid %s: %v", name, err)
+	}
+	return d
+}
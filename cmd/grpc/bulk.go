@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/CSKU-Lab/config-server/domain/services"
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	taskPB "github.com/CSKU-Lab/config-server/genproto/task/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxBulkSize = 500
+
+// bulkItem applies one item of a bulk mutation and returns the ID it
+// changed.
+type bulkItem func(ctx context.Context) (string, error)
+
+func (c *configServiceServer) BatchCreateRunners(ctx context.Context, req *pb.BatchCreateRunnersRequest) (*pb.BatchMutationResponse, error) {
+	items := make([]bulkItem, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		items[i] = func(ctx context.Context) (string, error) {
+			return c.runnerService.Create(ctx, createRunnerBody(r))
+		}
+	}
+	return c.runBulk(ctx, items, req.GetContinueOnError())
+}
+
+func (c *configServiceServer) BatchUpdateRunners(ctx context.Context, req *pb.BatchUpdateRunnersRequest) (*pb.BatchMutationResponse, error) {
+	items := make([]bulkItem, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		items[i] = func(ctx context.Context) (string, error) {
+			if r.GetId() == "" {
+				return "", status.Error(codes.InvalidArgument, "Id is required!")
+			}
+			return r.GetId(), c.runnerService.UpdateByID(ctx, r.GetId(), updateRunnerBody(r))
+		}
+	}
+	return c.runBulk(ctx, items, req.GetContinueOnError())
+}
+
+func (c *configServiceServer) BatchDeleteRunners(ctx context.Context, req *pb.BatchDeleteRunnersRequest) (*pb.BatchMutationResponse, error) {
+	items := make([]bulkItem, len(req.GetIds()))
+	for i, ID := range req.GetIds() {
+		items[i] = func(ctx context.Context) (string, error) {
+			if ID == "" {
+				return "", status.Error(codes.InvalidArgument, "Id is required!")
+			}
+			return ID, c.runnerService.DeleteByID(ctx, ID)
+		}
+	}
+
+	res, err := c.runBulk(ctx, items, req.GetContinueOnError())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ID := range succeeded(res.GetResults()) {
+		_, err := c.taskClient.RemoveRunnerOnCascade(ctx, &taskPB.RemoveRunnerOnCascadeRequest{
+			RunnerId: ID,
+		})
+		if err != nil {
+			log.Printf("[BatchDeleteRunners] cascade removal of %s failed (non-fatal): %v", ID, err)
+		}
+	}
+
+	return res, nil
+}
+
+func (c *configServiceServer) BatchCreateCompares(ctx context.Context, req *pb.BatchCreateComparesRequest) (*pb.BatchMutationResponse, error) {
+	items := make([]bulkItem, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		items[i] = func(ctx context.Context) (string, error) {
+			body, err := createCompareBody(r)
+			if err != nil {
+				return "", err
+			}
+			return c.compareService.Create(ctx, body)
+		}
+	}
+	return c.runBulk(ctx, items, req.GetContinueOnError())
+}
+
+func (c *configServiceServer) BatchUpdateCompares(ctx context.Context, req *pb.BatchUpdateComparesRequest) (*pb.BatchMutationResponse, error) {
+	items := make([]bulkItem, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		items[i] = func(ctx context.Context) (string, error) {
+			if r.GetId() == "" {
+				return "", status.Error(codes.InvalidArgument, "Id is required!")
+			}
+			body, err := updateCompareBody(r)
+			if err != nil {
+				return "", err
+			}
+			return r.GetId(), c.compareService.UpdateByID(ctx, r.GetId(), body)
+		}
+	}
+	return c.runBulk(ctx, items, req.GetContinueOnError())
+}
+
+func (c *configServiceServer) BatchDeleteCompares(ctx context.Context, req *pb.BatchDeleteComparesRequest) (*pb.BatchMutationResponse, error) {
+	items := make([]bulkItem, len(req.GetIds()))
+	for i, ID := range req.GetIds() {
+		items[i] = func(ctx context.Context) (string, error) {
+			if ID == "" {
+				return "", status.Error(codes.InvalidArgument, "Id is required!")
+			}
+			return ID, c.compareService.DeleteByID(ctx, ID)
+		}
+	}
+
+	res, err := c.runBulk(ctx, items, req.GetContinueOnError())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ID := range succeeded(res.GetResults()) {
+		_, err := c.taskClient.RemoveCompareScriptOnCascade(ctx, &taskPB.RemoveCompareScriptOnCascadeRequest{
+			CompareScriptId: ID,
+		})
+		if err != nil {
+			log.Printf("[BatchDeleteCompares] cascade removal of %s failed (non-fatal): %v", ID, err)
+		}
+	}
+
+	return res, nil
+}
+
+// runBulk applies every item in a single transaction, failing with the error
+// of the first item that fails. With continueOnError, items are applied one
+// by one instead and the result of each is returned. Either way, the changes
+// are recorded as a single revision and broadcast once.
+func (c *configServiceServer) runBulk(ctx context.Context, items []bulkItem, continueOnError bool) (*pb.BatchMutationResponse, error) {
+	if len(items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "At least one item is required!")
+	}
+	if len(items) > maxBulkSize {
+		return nil, status.Errorf(codes.InvalidArgument, "At most %d items are allowed!", maxBulkSize)
+	}
+
+	results := make([]*pb.BatchResult, len(items))
+	changes := &services.ChangeSet{}
+	if continueOnError {
+		changesCtx := services.WithChangeSet(ctx, changes)
+		for i, item := range items {
+			ID, err := item(changesCtx)
+			results[i] = bulkResult(ID, err)
+		}
+	} else {
+		err := c.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+			// The transaction may be retried, only the last try counts.
+			changes = &services.ChangeSet{}
+			ctx = services.WithChangeSet(ctx, changes)
+			for i, item := range items {
+				ID, err := item(ctx)
+				if err != nil {
+					st := status.Convert(toStatus(err))
+					return status.Errorf(st.Code(), "item %d : %s", i, st.Message())
+				}
+				results[i] = bulkResult(ID, nil)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, toStatus(err)
+		}
+	}
+
+	revision, err := c.revisionService.Record(ctx, changes.Changes()...)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if len(succeeded(results)) > 0 {
+		c.publisher.Publish()
+	}
+
+	return &pb.BatchMutationResponse{
+		Revision: revision,
+		Results:  results,
+	}, nil
+}
+
+func bulkResult(ID string, err error) *pb.BatchResult {
+	if err == nil {
+		return &pb.BatchResult{Id: ID}
+	}
+
+	st := status.Convert(toStatus(err))
+	return &pb.BatchResult{
+		Id:    ID,
+		Code:  int32(st.Code()),
+		Error: st.Message(),
+	}
+}
+
+// succeeded returns the IDs of the items that were applied.
+func succeeded(results []*pb.BatchResult) []string {
+	var IDs []string
+	for _, result := range results {
+		if result.GetCode() == int32(codes.OK) {
+			IDs = append(IDs, result.GetId())
+		}
+	}
+	return IDs
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	corsAllowedMethods = "GET, POST, PATCH, DELETE, OPTIONS"
+	corsAllowedHeaders = "Authorization, Content-Type, Idempotency-Key, Namespace"
+	corsExposedHeaders = "Config-Revision"
+	corsMaxAge         = "600"
+)
+
+// newGateway returns the HTTP/JSON API of ConfigService. Requests are
+// proxied to the gRPC server at grpcAddr, so they go through the same
+// authentication and tracing as gRPC calls.
+func newGateway(ctx context.Context, grpcAddr string, allowedOrigins []string) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+		runtime.WithForwardResponseOption(setCreatedStatus),
+		runtime.WithIncomingHeaderMatcher(incomingHeader),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeader),
+	)
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if err := pb.RegisterConfigServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		return nil, err
+	}
+
+	err := mux.HandlePath(http.MethodGet, "/v1/openapi.json", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(pb.OpenAPI)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return withCORS(mux, allowedOrigins), nil
+}
+
+// setCreatedStatus answers 201 instead of 200 to the requests creating a
+// resource.
+func setCreatedStatus(_ context.Context, w http.ResponseWriter, msg proto.Message) error {
+	switch msg.(type) {
+	case *pb.CreateRunnerResponse, *pb.CreateCompareResponse, *pb.CreateLimitProfileResponse, *pb.CreateSharedFileResponse, *pb.BeginBatchResponse,
+		*pb.CopyRunnerResponse, *pb.CopyCompareResponse:
+		w.WriteHeader(http.StatusCreated)
+	}
+	return nil
+}
+
+// incomingHeader forwards the idempotency key and the namespace along with
+// the default headers.
+func incomingHeader(key string) (string, bool) {
+	if strings.EqualFold(key, idempotencyHeader) {
+		return idempotencyHeader, true
+	}
+	if strings.EqualFold(key, namespaceHeader) {
+		return namespaceHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeader keeps the name of the revision header, other gRPC headers
+// get the default prefix.
+func outgoingHeader(key string) (string, bool) {
+	if key == revisionHeader {
+		return key, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// parseOrigins parses a comma separated list of origins, "*" allows every
+// origin.
+func parseOrigins(value string) []string {
+	var origins []string
+	for origin := range strings.SplitSeq(value, ",") {
+		origin = strings.TrimRight(strings.TrimSpace(origin), "/")
+		if origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+// withCORS allows browsers on allowedOrigins to call h. Without origins, h is
+// returned as is and only same origin requests work.
+func withCORS(h http.Handler, allowedOrigins []string) http.Handler {
+	if len(allowedOrigins) == 0 {
+		return h
+	}
+	allowAll := slices.Contains(allowedOrigins, "*")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" || !(allowAll || slices.Contains(allowedOrigins, origin)) {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Add("Vary", "Origin")
+		w.Header().Set("Access-Control-Expose-Headers", corsExposedHeaders)
+
+		// Preflight requests never reach the API.
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			w.Header().Set("Access-Control-Allow-Methods", corsAllowedMethods)
+			w.Header().Set("Access-Control-Allow-Headers", corsAllowedHeaders)
+			w.Header().Set("Access-Control-Max-Age", corsMaxAge)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
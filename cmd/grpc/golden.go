@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/services"
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	graderPB "github.com/CSKU-Lab/config-server/genproto/grader/v1"
+)
+
+// goldenCaseRunner runs the golden cases of compares through the grader. It
+// is the services.GoldenCaseVerifier of the compare, shared file and snapshot
+// services.
+type goldenCaseRunner struct {
+	files  services.FileService
+	grader graderPB.GraderServiceClient
+}
+
+func newGoldenCaseRunner(files services.FileService, grader graderPB.GraderServiceClient) *goldenCaseRunner {
+	return &goldenCaseRunner{
+		files:  files,
+		grader: grader,
+	}
+}
+
+// Run executes every golden case of the compare through the grader and
+// reports whether each produced its expected verdict.
+func (g *goldenCaseRunner) Run(ctx context.Context, compare *models.Compare) ([]*pb.GoldenCaseResult, bool, error) {
+	compareFiles, err := g.files.Resolve(ctx, compare.Files)
+	if err != nil {
+		return nil, false, err
+	}
+
+	files := make([]*graderPB.File, len(compareFiles))
+	for i, f := range compareFiles {
+		data, err := f.Load(ctx, g.files)
+		if err != nil {
+			return nil, false, err
+		}
+
+		files[i] = &graderPB.File{
+			Name: f.Name,
+			Mode: f.Mode,
+		}
+		if utf8.Valid(data) {
+			files[i].Content = string(data)
+		} else {
+			files[i].Data = data
+		}
+	}
+
+	passed := true
+	results := make([]*pb.GoldenCaseResult, len(compare.GoldenCases))
+	for i, golden := range compare.GoldenCases {
+		res, err := g.grader.Compare(ctx, &graderPB.CompareRequest{
+			Files:          files,
+			BuildScript:    compare.BuildScript,
+			RunScript:      compare.RunScript,
+			RunName:        compare.RunName,
+			ExpectedOutput: golden.ExpectedOutput,
+			ActualOutput:   golden.ActualOutput,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+
+		verdict := models.VerdictUnspecified
+		switch res.GetStatus() {
+		case graderPB.ExecutionStatus_STATUS_RUN_PASSED:
+			verdict = models.VerdictAccepted
+		case graderPB.ExecutionStatus_STATUS_RUN_FAILED:
+			verdict = models.VerdictWrongAnswer
+		}
+
+		message := res.GetMessage()
+		if verdict == models.VerdictUnspecified && message == "" {
+			message = res.GetStatus().String()
+		}
+
+		// A compare that fails to build or run never passes, whatever the
+		// expected verdict.
+		results[i] = &pb.GoldenCaseResult{
+			Name:            golden.Name,
+			ExpectedVerdict: models.VerdictToPBVerdict(golden.ExpectedVerdict),
+			ActualVerdict:   models.VerdictToPBVerdict(verdict),
+			Passed:          verdict != models.VerdictUnspecified && verdict == golden.ExpectedVerdict,
+			Message:         message,
+		}
+		passed = passed && results[i].Passed
+	}
+
+	return results, passed, nil
+}
+
+// Verify blocks a compare from being published when any of its golden cases
+// doesn't produce the expected verdict.
+func (g *goldenCaseRunner) Verify(ctx context.Context, compare *models.Compare) error {
+	if len(compare.GoldenCases) == 0 {
+		return nil
+	}
+
+	results, passed, err := g.Run(ctx, compare)
+	if err != nil {
+		return err
+	}
+	if passed {
+		return nil
+	}
+
+	var failed []string
+	for _, res := range results {
+		if !res.Passed {
+			failed = append(failed, fmt.Sprintf("%q expected %s, got %s", res.Name, res.ExpectedVerdict, res.ActualVerdict))
+		}
+	}
+
+	return fmt.Errorf("%w: golden cases of compare %q failed: %s", cerrors.New(cerrors.INVALID_DATA), compare.Name, strings.Join(failed, "; "))
+}
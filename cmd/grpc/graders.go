@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetGraderStatus lists the graders with the latest revision each of them
+// acknowledged, to spot the ones lagging behind.
+func (c *configServiceServer) GetGraderStatus(ctx context.Context, req *pb.GetGraderStatusRequest) (*pb.GetGraderStatusResponse, error) {
+	graders, revision, err := c.broadcaster.Status(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res := &pb.GetGraderStatusResponse{
+		Revision: revision,
+		Graders:  make([]*pb.GraderStatus, len(graders)),
+	}
+	for i, grader := range graders {
+		res.Graders[i] = &pb.GraderStatus{
+			Address:       grader.Address,
+			AckedRevision: grader.AckedRevision,
+			Lagging:       grader.AckedRevision < revision,
+			LastError:     grader.LastError,
+			Failures:      int32(grader.Failures),
+		}
+		if !grader.LastAck.IsZero() {
+			res.Graders[i].LastAckTime = timestamppb.New(grader.LastAck)
+		}
+		if !grader.LastAttempt.IsZero() {
+			res.Graders[i].LastAttemptTime = timestamppb.New(grader.LastAttempt)
+		}
+	}
+
+	return res, nil
+}
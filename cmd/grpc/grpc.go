@@ -2,27 +2,43 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"slices"
 	"sync"
 	"syscall"
 	"time"
 
 	cskuotel "github.com/CSKU-Lab/otel"
 	"github.com/CSKU-Lab/config-server/configs"
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
 	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/presets"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
 	"github.com/CSKU-Lab/config-server/domain/requests"
 	"github.com/CSKU-Lab/config-server/domain/services"
 	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
 	graderPB "github.com/CSKU-Lab/config-server/genproto/grader/v1"
 	taskPB "github.com/CSKU-Lab/config-server/genproto/task/v1"
+	"github.com/CSKU-Lab/config-server/internal/adapters/filesystem"
+	"github.com/CSKU-Lab/config-server/internal/adapters/memory"
 	"github.com/CSKU-Lab/config-server/internal/adapters/mongodb"
+	"github.com/CSKU-Lab/config-server/internal/auth"
+	"github.com/CSKU-Lab/config-server/internal/broadcast"
+	"github.com/CSKU-Lab/config-server/internal/gitops"
+	"github.com/CSKU-Lab/config-server/internal/migrate"
+	"github.com/CSKU-Lab/config-server/internal/secrets"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
@@ -31,6 +47,8 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+const downloadChunkSize = 64 << 10
+
 func main() {
 	otelShutdown, err := cskuotel.Init(context.Background())
 	if err != nil {
@@ -47,28 +65,113 @@ func main() {
 
 	env := configs.NewEnv()
 
-	client, err := mongo.Connect(options.Client().
-		ApplyURI(env.Get("MONGO_URI")).
-		SetAuth(options.Credential{
-			Username:   env.Get("MONGO_USERNAME"),
-			Password:   env.Get("MONGO_PASSWORD"),
-			AuthSource: env.Get("DATABASE_NAME"),
-		}))
-	if err != nil {
-		log.Fatalln(err)
+	var (
+		client           *mongo.Client
+		runnerRepo       repositories.RunnerRepository
+		compareRepo      repositories.CompareRepository
+		limitProfileRepo repositories.LimitProfileRepository
+		sharedFileRepo   repositories.SharedFileRepository
+		blobRepo         repositories.BlobRepository
+		revisionRepo     repositories.RevisionRepository
+		transactor       repositories.Transactor
+		idempotencyRepo  repositories.IdempotencyRepository
+		migrationRepo    repositories.MigrationRepository
+		migrations       []migrate.Migration
+	)
+
+	switch env.Get("STORAGE_DRIVER") {
+	case "memory":
+		log.Println("Using in-memory storage, every change is lost on shutdown")
+		runnerRepo = memory.NewRunnerRepo()
+		compareRepo = memory.NewCompareRepo()
+		limitProfileRepo = memory.NewLimitProfileRepo()
+		sharedFileRepo = memory.NewSharedFileRepo()
+		revisionRepo = memory.NewRevisionRepo()
+		transactor = memory.NewTransactor(runnerRepo, compareRepo, sharedFileRepo)
+		idempotencyRepo = memory.NewIdempotencyRepo()
+		migrationRepo = memory.NewMigrationRepo()
+		migrations = memory.Migrations()
+
+		blobDir := env.Get("BLOB_DIR")
+		if blobDir == "" {
+			blobDir = filepath.Join(os.TempDir(), "config-server-blobs")
+		}
+		blobRepo, err = filesystem.NewBlobRepo(blobDir)
+		if err != nil {
+			log.Fatalln(err)
+		}
+	default:
+		client, err = mongo.Connect(options.Client().
+			ApplyURI(env.Get("MONGO_URI")).
+			SetAuth(options.Credential{
+				Username:   env.Get("MONGO_USERNAME"),
+				Password:   env.Get("MONGO_PASSWORD"),
+				AuthSource: env.Get("DATABASE_NAME"),
+			}))
+		if err != nil {
+			log.Fatalln(err)
+		}
+
+		err = client.Ping(context.Background(), nil)
+		if err != nil {
+			log.Fatalln("Failed to ping MongoDB: ", err)
+		}
+
+		db := client.Database(env.Get("DATABASE_NAME"))
+		runnerRepo = mongodb.NewRunnerRepo(db)
+		compareRepo = mongodb.NewCompareRepo(db)
+		limitProfileRepo = mongodb.NewLimitProfileRepo(db)
+		sharedFileRepo = mongodb.NewSharedFileRepo(db)
+		blobRepo = mongodb.NewBlobRepo(db)
+		revisionRepo = mongodb.NewRevisionRepo(db)
+		transactor = mongodb.NewTransactor(client)
+		idempotencyRepo = mongodb.NewIdempotencyRepo(db)
+		migrationRepo = mongodb.NewMigrationRepo(db)
+		migrations = mongodb.Migrations(db)
 	}
 
-	err = client.Ping(context.Background(), nil)
+	migrator, err := migrate.New(migrationRepo, migrations)
 	if err != nil {
-		log.Fatalln("Failed to ping MongoDB: ", err)
+		log.Fatalln("Invalid migrations: ", err)
+	}
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		err := runMigrate(migrator, os.Args[2:])
+		if client != nil {
+			client.Disconnect(context.Background())
+		}
+		if err != nil {
+			log.Fatalln(err)
+		}
+		return
+	}
+	if env.Get("MIGRATE_ON_STARTUP") != "false" {
+		applied, err := migrator.Up(context.Background())
+		if err != nil {
+			log.Fatalln("Failed to migrate the storage: ", err)
+		}
+		for _, migration := range applied {
+			log.Printf("Applied migration %d %s", migration.Version, migration.Description)
+		}
 	}
 
-	db := client.Database(env.Get("DATABASE_NAME"))
-	runnerRepo := mongodb.NewRunnerRepo(db)
-	runnerService := services.NewRunnerService(runnerRepo)
+	var secretBox services.SecretBox
+	if keyFile := env.Get("SECRETS_KEY_FILE"); keyFile != "" {
+		box, err := secrets.NewBoxFromKeyFile(keyFile)
+		if err != nil {
+			log.Fatalln("Failed to load secrets key: ", err)
+		}
+		secretBox = box
+	}
 
-	compareRepo := mongodb.NewCompareRepo(db)
-	compareService := services.NewCompareService(compareRepo)
+	authenticator, err := auth.NewAuthenticator(env.Get("AUTH_TOKENS"))
+	if err != nil {
+		log.Fatalln("Failed to parse auth tokens: ", err)
+	}
+
+	graderIdentity := env.Get("GRADER_IDENTITY")
+	if graderIdentity == "" {
+		graderIdentity = "grader"
+	}
 
 	taskGrpcClient, closeConn, err := initTaskGRPCClient(env.Get("TASK_SERVER_URL"))
 	if err != nil {
@@ -82,6 +185,43 @@ func main() {
 	}
 	defer closeConn()
 
+	revisionService := services.NewRevisionService(revisionRepo, runnerRepo, compareRepo)
+	idempotencyService := services.NewIdempotencyService(idempotencyRepo, parseDuration("IDEMPOTENCY_TTL", env.Get("IDEMPOTENCY_TTL")))
+	fileService := services.NewFileService(blobRepo, sharedFileRepo)
+	goldenCases := newGoldenCaseRunner(fileService, graderGRPCClient)
+	runnerService := services.NewRunnerService(runnerRepo, limitProfileRepo, fileService, secretBox, revisionService)
+	compareService := services.NewCompareService(compareRepo, fileService, revisionService, goldenCases)
+	limitProfileService := services.NewLimitProfileService(limitProfileRepo, runnerService)
+	sharedFileService := services.NewSharedFileService(sharedFileRepo, runnerRepo, compareRepo, fileService, revisionService, goldenCases, transactor)
+	syncer := gitops.NewSyncer(runnerService, compareService, fileService)
+	snapshotService := services.NewSnapshotService(runnerRepo, compareRepo, limitProfileRepo, sharedFileRepo, blobRepo, revisionService, goldenCases)
+
+	graderEndpoints := env.Get("GRADER_ENDPOINTS")
+	if graderEndpoints == "" {
+		graderEndpoints = env.Get("GO_GRADER_SERVER_URL")
+	}
+	graderResolver, err := broadcast.NewResolver(graderEndpoints)
+	if err != nil {
+		log.Fatalln("Failed to parse grader endpoints: ", err)
+	}
+	broadcaster := broadcast.New(graderResolver, revisionService.Latest, broadcast.Options{
+		DialOptions: []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		},
+	})
+	defer broadcaster.Close()
+
+	broadcastCtx, cancelBroadcast := context.WithCancel(context.Background())
+	defer cancelBroadcast()
+	go broadcaster.Run(broadcastCtx)
+
+	publisher := broadcast.NewPublisher(broadcaster, broadcast.PublisherOptions{
+		Window:       parseDuration("BROADCAST_WINDOW", env.Get("BROADCAST_WINDOW")),
+		MaxDelay:     parseDuration("BROADCAST_MAX_DELAY", env.Get("BROADCAST_MAX_DELAY")),
+		BatchTimeout: parseDuration("BATCH_TIMEOUT", env.Get("BATCH_TIMEOUT")),
+	})
+
 	lis, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%s", env.Get("PORT")))
 	if err != nil {
 		log.Fatalln("failed to listen: ", err)
@@ -96,11 +236,39 @@ func main() {
 	// }
 	// defer redis.Close()
 
-	s := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
-	pb.RegisterConfigServiceServer(s, newServer(runnerService, compareService, taskGrpcClient, graderGRPCClient))
+	server := newServer(runnerService, compareService, limitProfileService, fileService, sharedFileService, syncer, snapshotService, revisionService, idempotencyService, transactor, taskGrpcClient, graderGRPCClient, goldenCases, graderIdentity, broadcaster, publisher)
+
+	s := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(authenticator.UnaryInterceptor(), authorUnaryInterceptor(), namespaceUnaryInterceptor(), server.revisionUnaryInterceptor()),
+		grpc.ChainStreamInterceptor(authenticator.StreamInterceptor(), authorStreamInterceptor(), namespaceStreamInterceptor(), server.revisionStreamInterceptor()),
+	)
+	pb.RegisterConfigServiceServer(s, server)
 	reflection.Register(s)
 	log.Println("gRPC ConfigService registered")
 
+	gatewayCtx, cancelGateway := context.WithCancel(context.Background())
+	defer cancelGateway()
+
+	var gateway *http.Server
+	if httpPort := env.Get("HTTP_PORT"); httpPort != "" {
+		handler, err := newGateway(gatewayCtx, fmt.Sprintf("localhost:%s", env.Get("PORT")), parseOrigins(env.Get("CORS_ALLOWED_ORIGINS")))
+		if err != nil {
+			log.Fatalln("Cannot create HTTP gateway: ", err)
+		}
+		gateway = &http.Server{
+			Addr:              fmt.Sprintf("0.0.0.0:%s", httpPort),
+			Handler:           handler,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		go func() {
+			if err := gateway.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Fatalln("Cannot start HTTP gateway :", err)
+			}
+		}()
+		log.Println("HTTP gateway listening on port", httpPort)
+	}
+
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 
@@ -108,6 +276,14 @@ func main() {
 	wg.Go(func() {
 		sig := <-sigs
 		log.Printf("Receive %s signal from OS, going to shutdown...\n", sig)
+		if gateway != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			if err := gateway.Shutdown(ctx); err != nil {
+				log.Println("Can't shutdown HTTP gateway : ", err)
+			}
+			cancel()
+			cancelGateway()
+		}
 		timer := time.AfterFunc(10*time.Second, func() {
 			log.Println("Server couldn't stop grafully in time. Doing force stop.")
 			s.Stop()
@@ -118,8 +294,10 @@ func main() {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
-		if err := client.Disconnect(ctx); err != nil {
-			log.Println("Can't disconnect mongodb : ", err)
+		if client != nil {
+			if err := client.Disconnect(ctx); err != nil {
+				log.Println("Can't disconnect mongodb : ", err)
+			}
 		}
 		log.Println("Successfully gracefully shutdown the server :D")
 	})
@@ -133,24 +311,48 @@ func main() {
 
 type configServiceServer struct {
 	pb.UnimplementedConfigServiceServer
-	runnerService  services.RunnerService
-	compareService services.CompareService
-	taskClient     taskPB.TaskServiceClient
-	graderClient   graderPB.GraderServiceClient
+	runnerService       services.RunnerService
+	compareService      services.CompareService
+	limitProfileService services.LimitProfileService
+	fileService         services.FileService
+	sharedFileService   services.SharedFileService
+	syncer              *gitops.Syncer
+	snapshotService     services.SnapshotService
+	revisionService     services.RevisionService
+	idempotencyService  services.IdempotencyService
+	transactor          repositories.Transactor
+	taskClient          taskPB.TaskServiceClient
+	graderClient        graderPB.GraderServiceClient
+	goldenCases         *goldenCaseRunner
+	graderIdentity      string
+	broadcaster         *broadcast.Broadcaster
+	publisher           *broadcast.Publisher
 	// runnerCache    cache.CacheBuild
 	// compareCache   cache.CacheBuild
 	// cacheApp       cache.CacheApp
 }
 
-func newServer(runnerService services.RunnerService, compareService services.CompareService, taskClient taskPB.TaskServiceClient, graderClient graderPB.GraderServiceClient) *configServiceServer {
+func newServer(runnerService services.RunnerService, compareService services.CompareService, limitProfileService services.LimitProfileService, fileService services.FileService, sharedFileService services.SharedFileService, syncer *gitops.Syncer, snapshotService services.SnapshotService, revisionService services.RevisionService, idempotencyService services.IdempotencyService, transactor repositories.Transactor, taskClient taskPB.TaskServiceClient, graderClient graderPB.GraderServiceClient, goldenCases *goldenCaseRunner, graderIdentity string, broadcaster *broadcast.Broadcaster, publisher *broadcast.Publisher) *configServiceServer {
 	// runnerCache := cacheApp.Build("runnerCache")
 	// compareCache := cacheApp.Build("compareCache")
 
 	return &configServiceServer{
-		runnerService:  runnerService,
-		compareService: compareService,
-		taskClient:     taskClient,
-		graderClient:   graderClient,
+		runnerService:       runnerService,
+		compareService:      compareService,
+		limitProfileService: limitProfileService,
+		fileService:         fileService,
+		sharedFileService:   sharedFileService,
+		syncer:              syncer,
+		snapshotService:     snapshotService,
+		revisionService:     revisionService,
+		idempotencyService:  idempotencyService,
+		transactor:          transactor,
+		taskClient:          taskClient,
+		graderClient:        graderClient,
+		goldenCases:         goldenCases,
+		graderIdentity:      graderIdentity,
+		broadcaster:         broadcaster,
+		publisher:           publisher,
 		// runnerCache:    runnerCache,
 		// compareCache:   compareCache,
 		// cacheApp:       cacheApp,
@@ -162,14 +364,15 @@ func (c *configServiceServer) GetRunnersPagination(ctx context.Context, req *pb.
 	// cacheInstance := cache.NewCacheInstance[*pb.GetRunnersPaginationResponse](cacheObj)
 
 	// paginationRes, err := cacheInstance.LazyCaching(ctx, func() (*pb.GetRunnersPaginationResponse, error) {
-	runners, total, err := c.runnerService.GetPagination(
-		ctx,
-		&requests.GetPagination{
-			Page:      int(req.Pagination.GetPage()),
-			PageSize:  int(req.Pagination.GetPageSize()),
-			SortOrder: req.Pagination.GetSortOrder(),
-			Search:    req.Pagination.GetSearch(),
-		})
+	pagination, err := paginationRequest(req.GetPagination(), true)
+	if err != nil {
+		return nil, err
+	}
+	pagination.Language = req.GetLanguage()
+	pagination.Tags = req.GetTags()
+	pagination.Extension = req.GetExtension()
+
+	runners, total, err := c.runnerService.GetPagination(ctx, pagination)
 	if err != nil {
 		return nil, err
 	}
@@ -180,12 +383,36 @@ func (c *configServiceServer) GetRunnersPagination(ctx context.Context, req *pb.
 			Id:          runner.ID,
 			Name:        runner.Name,
 			Description: runner.Description,
+			ParentId:    runner.ParentID,
+			Variables:   runner.Variables,
+
+			DefaultLimitProfileId: runner.DefaultLimitProfileID,
+			LimitMultipliers:      models.LimitMultipliersToPBLimitMultipliers(runner.LimitMultipliers),
+			Metadata:              models.RunnerMetadataToPBRunnerMetadata(runner.Metadata),
+			EntryPoint:            runner.EntryPoint,
+
+			Slug:      runner.Slug,
+			Aliases:   runner.Aliases,
+			Namespace: runner.Namespace,
+
+			CreateTime: timestamp(runner.CreatedAt),
+			UpdateTime: timestamp(runner.UpdatedAt),
+			CreatedBy:  runner.CreatedBy,
+			UpdatedBy:  runner.UpdatedBy,
 		}
 
 		if req.GetIncludeScripts() {
 			responseRunners[i].BuildScript = runner.BuildScript
 			responseRunners[i].RunScript = runner.RunScript
-			responseRunners[i].InitialFiles = models.FileToPBFile(runner.InitialFiles)
+			responseRunners[i].StarterTemplate = runner.StarterTemplate
+			responseRunners[i].InitialFiles, err = models.FileToPBFile(ctx, c.fileService, runner.InitialFiles)
+			if err != nil {
+				return nil, toStatus(err)
+			}
+			responseRunners[i].Env, err = c.runnerEnv(ctx, &runner)
+			if err != nil {
+				return nil, toStatus(err)
+			}
 		}
 	}
 
@@ -202,56 +429,117 @@ func (c *configServiceServer) GetRunnersPagination(ctx context.Context, req *pb.
 }
 
 func (c *configServiceServer) GetAllRunners(ctx context.Context, req *pb.GetAllRunnersRequest) (*pb.GetAllRunnersResponse, error) {
+	// The revision is read first, so that a delta from it holds whatever
+	// changes while runners are read.
+	revision, err := c.revisionService.Latest(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
 	runners, err := c.runnerService.GetAll(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
 	runnersRes := make([]*pb.RunnerResponse, len(runners))
-	for i, runner := range runners {
-		runnersRes[i] = &pb.RunnerResponse{
-			Id: runner.ID,
+	for i := range runners {
+		runnersRes[i], err = c.runnerToPBListedRunner(ctx, &runners[i], req.GetIncludeMetadata(), req.GetIncludeScripts())
+		if err != nil {
+			return nil, toStatus(err)
 		}
+	}
 
-		if req.GetIncludeMetadata() {
-			runnersRes[i].Name = runner.Name
-			runnersRes[i].Description = runner.Description
-		}
+	return &pb.GetAllRunnersResponse{
+		Runners:  runnersRes,
+		Revision: revision,
+	}, nil
+}
 
-		if req.GetIncludeScripts() {
-			runnersRes[i].BuildScript = runner.BuildScript
-			runnersRes[i].RunScript = runner.RunScript
-			runnersRes[i].InitialFiles = models.FileToPBFile(runner.InitialFiles)
+// runnerToPBListedRunner converts a runner as listed by GetAllRunners, with
+// only the requested parts.
+func (c *configServiceServer) runnerToPBListedRunner(ctx context.Context, runner *models.Runner, includeMetadata bool, includeScripts bool) (*pb.RunnerResponse, error) {
+	res := &pb.RunnerResponse{
+		Id: runner.ID,
+	}
+
+	if includeMetadata {
+		res.Name = runner.Name
+		res.Description = runner.Description
+		res.ParentId = runner.ParentID
+		res.DefaultLimitProfileId = runner.DefaultLimitProfileID
+		res.Metadata = models.RunnerMetadataToPBRunnerMetadata(runner.Metadata)
+		res.EntryPoint = runner.EntryPoint
+		res.Slug = runner.Slug
+		res.Namespace = runner.Namespace
+		res.Aliases = runner.Aliases
+		res.CreateTime = timestamp(runner.CreatedAt)
+		res.UpdateTime = timestamp(runner.UpdatedAt)
+		res.CreatedBy = runner.CreatedBy
+		res.UpdatedBy = runner.UpdatedBy
+	}
+
+	if includeScripts {
+		var err error
+		res.BuildScript = runner.BuildScript
+		res.RunScript = runner.RunScript
+		res.StarterTemplate = runner.StarterTemplate
+		res.InitialFiles, err = models.FileToPBFile(ctx, c.fileService, runner.InitialFiles)
+		if err != nil {
+			return nil, err
+		}
+		res.Env, err = c.runnerEnv(ctx, runner)
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	return &pb.GetAllRunnersResponse{
-		Runners: runnersRes,
-	}, nil
+	return res, nil
 }
 
 func (c *configServiceServer) GetRunner(ctx context.Context, req *pb.GetRunnerRequest) (*pb.RunnerResponse, error) {
 	if req.GetId() == "" {
-		return nil, fmt.Errorf("Id is required!")
+		return nil, status.Error(codes.InvalidArgument, "Id is required!")
 	}
 
 	// cacheObj := c.runnerCache.One(time.Hour*4, req.GetId())
 	// cacheInstance := cache.NewCacheInstance[*pb.RunnerResponse](cacheObj)
 	//
 	// runnerRes, err := cacheInstance.LazyCaching(ctx, func() (*pb.RunnerResponse, error) {
-	runner, err := c.runnerService.GetByID(ctx, req.GetId())
+	runnerID, err := c.runnerService.ResolveID(ctx, req.GetId())
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
-	return &pb.RunnerResponse{
-		Id:           runner.ID,
-		Name:         runner.Name,
-		Description:  runner.Description,
-		BuildScript:  runner.BuildScript,
-		RunScript:    runner.RunScript,
-		InitialFiles: models.FileToPBFile(runner.InitialFiles),
-	}, nil
+	runner, err := c.runnerService.GetByID(ctx, runnerID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res, err := c.runnerToPBRunner(ctx, runner)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res.Env, err = c.runnerEnv(ctx, runner)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	if req.GetIncludeRaw() {
+		raw, err := c.runnerService.GetRawByID(ctx, runnerID)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		res.Raw, err = c.runnerToPBRunner(ctx, raw)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		res.Raw.Env, err = c.runnerEnv(ctx, raw)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+	}
+
+	return res, nil
 	// })
 	// if err != nil {
 	// 	return nil, err
@@ -261,14 +549,11 @@ func (c *configServiceServer) GetRunner(ctx context.Context, req *pb.GetRunnerRe
 }
 
 func (c *configServiceServer) CreateRunner(ctx context.Context, req *pb.CreateRunnerRequest) (*pb.CreateRunnerResponse, error) {
-	runner := &requests.CreateRunner{
-		Name:        req.GetName(),
-		Description: req.GetDescription(),
-	}
-
-	runnerID, err := c.runnerService.Create(ctx, runner)
+	runnerID, err := c.idempotent(ctx, req, func() (string, error) {
+		return c.runnerService.Create(ctx, createRunnerBody(req))
+	})
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	// err = c.runnerCache.InvalidateAll(ctx)
@@ -276,13 +561,7 @@ func (c *configServiceServer) CreateRunner(ctx context.Context, req *pb.CreateRu
 	// 	return nil, err
 	// }
 
-	broadcastReq := &graderPB.BroadcastRequest{
-		Action: graderPB.BroadcastAction_REFETCH_CONFIG,
-	}
-	_, err = c.graderClient.Broadcast(ctx, broadcastReq)
-	if err != nil {
-		return nil, err
-	}
+	c.publisher.Publish()
 
 	return &pb.CreateRunnerResponse{
 		Id: runnerID,
@@ -291,18 +570,12 @@ func (c *configServiceServer) CreateRunner(ctx context.Context, req *pb.CreateRu
 
 func (c *configServiceServer) UpdateRunner(ctx context.Context, req *pb.UpdateRunnerRequest) (*emptypb.Empty, error) {
 	if req.GetId() == "" {
-		return nil, fmt.Errorf("Id is required!")
+		return nil, status.Error(codes.InvalidArgument, "Id is required!")
 	}
 
-	err := c.runnerService.UpdateByID(ctx, req.GetId(), &requests.UpdateRunner{
-		Name:         req.Name,
-		Description:  req.Description,
-		BuildScript:  req.BuildScript,
-		RunScript:    req.RunScript,
-		InitialFiles: models.PBFileToFile(req.GetInitialFiles()),
-	})
+	err := c.runnerService.UpdateByID(ctx, req.GetId(), updateRunnerBody(req))
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	// err = c.runnerCache.InvalidateAll(ctx)
@@ -310,25 +583,19 @@ func (c *configServiceServer) UpdateRunner(ctx context.Context, req *pb.UpdateRu
 	// 	return nil, err
 	// }
 
-	broadcastReq := &graderPB.BroadcastRequest{
-		Action: graderPB.BroadcastAction_REFETCH_CONFIG,
-	}
-	_, err = c.graderClient.Broadcast(ctx, broadcastReq)
-	if err != nil {
-		return nil, err
-	}
+	c.publisher.Publish()
 
 	return nil, nil
 }
 
 func (c *configServiceServer) DeleteRunner(ctx context.Context, req *pb.DeleteRunnerRequest) (*emptypb.Empty, error) {
 	if req.GetId() == "" {
-		return nil, fmt.Errorf("Id is required!")
+		return nil, status.Error(codes.InvalidArgument, "Id is required!")
 	}
 
 	err := c.runnerService.DeleteByID(ctx, req.GetId())
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	// err = c.runnerCache.InvalidateAll(ctx)
@@ -344,28 +611,117 @@ func (c *configServiceServer) DeleteRunner(ctx context.Context, req *pb.DeleteRu
 		return nil, err
 	}
 
-	broadcastReq := &graderPB.BroadcastRequest{
-		Action: graderPB.BroadcastAction_REFETCH_CONFIG,
+	c.publisher.Publish()
+
+	return &emptypb.Empty{}, nil
+}
+
+func createRunnerBody(req *pb.CreateRunnerRequest) *requests.CreateRunner {
+	return &requests.CreateRunner{
+		Name:        req.GetName(),
+		Slug:        req.GetSlug(),
+		Description: req.GetDescription(),
+		ParentID:    req.GetParentId(),
+		Variables:   req.GetVariables(),
+		Env:         models.PBEnvVarToEnvVar(req.GetEnv()),
+
+		DefaultLimitProfileID: req.GetDefaultLimitProfileId(),
+		LimitMultipliers:      models.PBLimitMultipliersToLimitMultipliers(req.GetLimitMultipliers()),
+		Metadata:              models.PBRunnerMetadataToRunnerMetadata(req.GetMetadata()),
+
+		StarterTemplate: req.GetStarterTemplate(),
+		EntryPoint:      req.GetEntryPoint(),
+	}
+}
+
+func updateRunnerBody(req *pb.UpdateRunnerRequest) *requests.UpdateRunner {
+	return &requests.UpdateRunner{
+		Name:         req.Name,
+		Description:  req.Description,
+		BuildScript:  req.BuildScript,
+		RunScript:    req.RunScript,
+		InitialFiles: models.PBFileToFile(req.GetInitialFiles()),
+		ParentID:     req.ParentId,
+		Variables:    req.GetVariables(),
+		Env:          models.PBEnvVarToEnvVar(req.GetEnv()),
+
+		DefaultLimitProfileID: req.DefaultLimitProfileId,
+		LimitMultipliers:      models.PBLimitMultipliersToLimitMultipliers(req.GetLimitMultipliers()),
+		Metadata:              models.PBRunnerMetadataToRunnerMetadata(req.GetMetadata()),
+
+		StarterTemplate: req.StarterTemplate,
+		EntryPoint:      req.EntryPoint,
 	}
-	_, err = c.graderClient.Broadcast(ctx, broadcastReq)
+}
+
+func (c *configServiceServer) runnerToPBRunner(ctx context.Context, runner *models.Runner) (*pb.RunnerResponse, error) {
+	files, err := models.FileToPBFile(ctx, c.fileService, runner.InitialFiles)
 	if err != nil {
 		return nil, err
 	}
 
-	return &emptypb.Empty{}, nil
+	return &pb.RunnerResponse{
+		Id:           runner.ID,
+		Name:         runner.Name,
+		Description:  runner.Description,
+		BuildScript:  runner.BuildScript,
+		RunScript:    runner.RunScript,
+		InitialFiles: files,
+		ParentId:     runner.ParentID,
+		Variables:    runner.Variables,
+
+		DefaultLimitProfileId: runner.DefaultLimitProfileID,
+		LimitMultipliers:      models.LimitMultipliersToPBLimitMultipliers(runner.LimitMultipliers),
+		Metadata:              models.RunnerMetadataToPBRunnerMetadata(runner.Metadata),
+
+		StarterTemplate: runner.StarterTemplate,
+		EntryPoint:      runner.EntryPoint,
+
+		Slug:      runner.Slug,
+		Aliases:   runner.Aliases,
+		Namespace: runner.Namespace,
+
+		CreateTime: timestamp(runner.CreatedAt),
+		UpdateTime: timestamp(runner.UpdatedAt),
+		CreatedBy:  runner.CreatedBy,
+		UpdatedBy:  runner.UpdatedBy,
+	}, nil
+}
+
+// runnerEnv releases secret values only to the grader, everyone else gets
+// them redacted.
+func (c *configServiceServer) runnerEnv(ctx context.Context, runner *models.Runner) ([]*pb.EnvVar, error) {
+	// Secrets are only revealed to the global grader identity, a token bound
+	// to a namespace never gets them even when named after the grader.
+	if identity := auth.FromContext(ctx); !identity.IsAnonymous() && identity.Name == c.graderIdentity && identity.Namespace == "" {
+		revealed := *runner
+		revealed.Env = slices.Clone(runner.Env)
+		err := c.runnerService.RevealSecrets(&revealed)
+		if err != nil {
+			return nil, err
+		}
+		return models.EnvVarToPBEnvVar(revealed.Env), nil
+	}
+
+	env := models.EnvVarToPBEnvVar(runner.Env)
+	for _, e := range env {
+		if e.Secret {
+			e.Value = ""
+		}
+	}
+	return env, nil
 }
 
 func (c *configServiceServer) CreateCompare(ctx context.Context, req *pb.CreateCompareRequest) (*pb.CreateCompareResponse, error) {
-	compareID, err := c.compareService.Create(ctx, &requests.CreateCompare{
-		Name:        req.GetName(),
-		Files:       models.PBFileToFile(req.GetFiles()),
-		BuildScript: req.GetBuildScript(),
-		RunScript:   req.GetRunScript(),
-		RunName:     req.GetRunName(),
-		Description: req.GetDescription(),
+	compareID, err := c.idempotent(ctx, req, func() (string, error) {
+		body, err := createCompareBody(req)
+		if err != nil {
+			return "", err
+		}
+		return c.compareService.Create(ctx, body)
 	})
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	// err = c.compareCache.InvalidateAll(ctx)
@@ -373,13 +729,7 @@ func (c *configServiceServer) CreateCompare(ctx context.Context, req *pb.CreateC
 	// 	return nil, err
 	// }
 
-	broadcastReq := &graderPB.BroadcastRequest{
-		Action: graderPB.BroadcastAction_REFETCH_CONFIG,
-	}
-	_, err = c.graderClient.Broadcast(ctx, broadcastReq)
-	if err != nil {
-		return nil, err
-	}
+	c.publisher.Publish()
 
 	return &pb.CreateCompareResponse{
 		Id: compareID,
@@ -388,26 +738,51 @@ func (c *configServiceServer) CreateCompare(ctx context.Context, req *pb.CreateC
 
 func (c *configServiceServer) GetCompare(ctx context.Context, req *pb.GetCompareRequest) (*pb.CompareResponse, error) {
 	if req.GetId() == "" {
-		return nil, fmt.Errorf("Id is required!")
+		return nil, status.Error(codes.InvalidArgument, "Id is required!")
 	}
 
 	// cacheObj := c.compareCache.One(time.Hour*4, req.GetId())
 	// cacheInstance := cache.NewCacheInstance[*pb.CompareResponse](cacheObj)
 
 	// compareRes, err := cacheInstance.LazyCaching(ctx, func() (*pb.CompareResponse, error) {
-	compare, err := c.compareService.GetByID(ctx, req.GetId())
+	compareID, err := c.compareService.ResolveID(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	compare, err := c.compareService.GetByID(ctx, compareID)
 	if err != nil {
 		return nil, err
 	}
 
+	files, err := models.FileToPBFile(ctx, c.fileService, compare.Files)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
 	return &pb.CompareResponse{
 		Id:          compare.ID,
 		Name:        compare.Name,
-		Files:       models.FileToPBFile(compare.Files),
+		Files:       files,
 		BuildScript: compare.BuildScript,
 		RunScript:   compare.RunScript,
 		RunName:     compare.RunName,
 		Description: compare.Description,
+		GoldenCases: models.GoldenCaseToPBGoldenCase(compare.GoldenCases),
+
+		PresetId:      compare.PresetID,
+		PresetVersion: int32(compare.PresetVersion),
+		PresetParams:  compare.PresetParams,
+		ReadOnly:      compare.IsReadOnly(),
+
+		Slug:      compare.Slug,
+		Aliases:   compare.Aliases,
+		Namespace: compare.Namespace,
+
+		CreateTime: timestamp(compare.CreatedAt),
+		UpdateTime: timestamp(compare.UpdatedAt),
+		CreatedBy:  compare.CreatedBy,
+		UpdatedBy:  compare.UpdatedBy,
 	}, nil
 	// })
 	// if err != nil {
@@ -417,36 +792,67 @@ func (c *configServiceServer) GetCompare(ctx context.Context, req *pb.GetCompare
 }
 
 func (c *configServiceServer) GetAllCompares(ctx context.Context, req *pb.GetAllComparesRequest) (*pb.GetAllComparesResponse, error) {
+	revision, err := c.revisionService.Latest(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
 	compares, err := c.compareService.GetAll(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
 	responses := make([]*pb.CompareResponse, len(compares))
-	for i, compare := range compares {
-		responses[i] = &pb.CompareResponse{
-			Id: compare.ID,
+	for i := range compares {
+		responses[i], err = c.compareToPBListedCompare(ctx, &compares[i], req.GetIncludeMetadata(), req.GetIncludeScripts(), req.GetIncludeFiles())
+		if err != nil {
+			return nil, toStatus(err)
 		}
+	}
 
-		if req.GetIncludeMetadata() {
-			responses[i].Name = compare.Name
-			responses[i].Description = compare.Description
-		}
+	return &pb.GetAllComparesResponse{
+		Compares: responses,
+		Revision: revision,
+	}, nil
+}
 
-		if req.GetIncludeScripts() {
-			responses[i].BuildScript = compare.BuildScript
-			responses[i].RunScript = compare.RunScript
-			responses[i].RunName = compare.RunName
-		}
+// compareToPBListedCompare converts a compare as listed by GetAllCompares,
+// with only the requested parts.
+func (c *configServiceServer) compareToPBListedCompare(ctx context.Context, compare *models.Compare, includeMetadata bool, includeScripts bool, includeFiles bool) (*pb.CompareResponse, error) {
+	res := &pb.CompareResponse{
+		Id: compare.ID,
+	}
 
-		if req.GetIncludeFiles() {
-			responses[i].Files = models.FileToPBFile(compare.Files)
+	if includeMetadata {
+		res.Name = compare.Name
+		res.Description = compare.Description
+		res.PresetId = compare.PresetID
+		res.PresetVersion = int32(compare.PresetVersion)
+		res.ReadOnly = compare.IsReadOnly()
+		res.Slug = compare.Slug
+		res.Namespace = compare.Namespace
+		res.Aliases = compare.Aliases
+		res.CreateTime = timestamp(compare.CreatedAt)
+		res.UpdateTime = timestamp(compare.UpdatedAt)
+		res.CreatedBy = compare.CreatedBy
+		res.UpdatedBy = compare.UpdatedBy
+	}
+
+	if includeScripts {
+		res.BuildScript = compare.BuildScript
+		res.RunScript = compare.RunScript
+		res.RunName = compare.RunName
+	}
+
+	if includeFiles {
+		var err error
+		res.Files, err = models.FileToPBFile(ctx, c.fileService, compare.Files)
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	return &pb.GetAllComparesResponse{
-		Compares: responses,
-	}, nil
+	return res, nil
 }
 
 func (c *configServiceServer) GetComparesPagination(ctx context.Context, req *pb.GetComparesPaginationRequest) (*pb.GetComparesPaginationResponse, error) {
@@ -454,28 +860,46 @@ func (c *configServiceServer) GetComparesPagination(ctx context.Context, req *pb
 	// cacheInstance := cache.NewCacheInstance[*pb.GetComparesPaginationResponse](cacheObj)
 
 	// paginationRes, err := cacheInstance.LazyCaching(ctx, func() (*pb.GetComparesPaginationResponse, error) {
-	compares, total, err := c.compareService.GetPagination(
-		ctx,
-		&requests.GetPagination{
-			Page:      int(req.Pagination.GetPage()),
-			PageSize:  int(req.Pagination.GetPageSize()),
-			SortOrder: req.Pagination.GetSortOrder(),
-			Search:    req.Pagination.GetSearch(),
-		})
+	pagination, err := paginationRequest(req.GetPagination(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	compares, total, err := c.compareService.GetPagination(ctx, pagination)
 	if err != nil {
 		return nil, err
 	}
 
 	responses := []*pb.CompareResponse{}
 	for _, compare := range compares {
+		files, err := models.FileToPBFile(ctx, c.fileService, compare.Files)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+
 		responses = append(responses, &pb.CompareResponse{
 			Id:          compare.ID,
 			Name:        compare.Name,
-			Files:       models.FileToPBFile(compare.Files),
+			Files:       files,
 			BuildScript: compare.BuildScript,
 			RunScript:   compare.RunScript,
 			RunName:     compare.RunName,
 			Description: compare.Description,
+			GoldenCases: models.GoldenCaseToPBGoldenCase(compare.GoldenCases),
+
+			PresetId:      compare.PresetID,
+			PresetVersion: int32(compare.PresetVersion),
+			PresetParams:  compare.PresetParams,
+			ReadOnly:      compare.IsReadOnly(),
+
+			Slug:      compare.Slug,
+			Aliases:   compare.Aliases,
+			Namespace: compare.Namespace,
+
+			CreateTime: timestamp(compare.CreatedAt),
+			UpdateTime: timestamp(compare.UpdatedAt),
+			CreatedBy:  compare.CreatedBy,
+			UpdatedBy:  compare.UpdatedBy,
 		})
 	}
 
@@ -492,19 +916,17 @@ func (c *configServiceServer) GetComparesPagination(ctx context.Context, req *pb
 
 func (c *configServiceServer) UpdateCompare(ctx context.Context, req *pb.UpdateCompareRequest) (*emptypb.Empty, error) {
 	if req.GetId() == "" {
-		return nil, fmt.Errorf("Id is required!")
+		return nil, status.Error(codes.InvalidArgument, "Id is required!")
 	}
 
-	err := c.compareService.UpdateByID(ctx, req.GetId(), &requests.UpdateCompare{
-		Name:        req.Name,
-		Files:       models.PBFileToFile(req.GetFiles()),
-		BuildScript: req.BuildScript,
-		RunScript:   req.RunScript,
-		RunName:     req.RunName,
-		Description: req.Description,
-	})
+	body, err := updateCompareBody(req)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
+	}
+
+	err = c.compareService.UpdateByID(ctx, req.GetId(), body)
+	if err != nil {
+		return nil, toStatus(err)
 	}
 
 	// err = c.compareCache.InvalidateAll(ctx)
@@ -512,20 +934,14 @@ func (c *configServiceServer) UpdateCompare(ctx context.Context, req *pb.UpdateC
 	// 	return nil, err
 	// }
 
-	broadcastReq := &graderPB.BroadcastRequest{
-		Action: graderPB.BroadcastAction_REFETCH_CONFIG,
-	}
-	_, err = c.graderClient.Broadcast(ctx, broadcastReq)
-	if err != nil {
-		return nil, err
-	}
+	c.publisher.Publish()
 
 	return nil, nil
 }
 
 func (c *configServiceServer) DeleteCompare(ctx context.Context, req *pb.DeleteCompareRequest) (*emptypb.Empty, error) {
 	if req.GetId() == "" {
-		return nil, fmt.Errorf("Id is required!")
+		return nil, status.Error(codes.InvalidArgument, "Id is required!")
 	}
 
 	err := c.compareService.DeleteByID(ctx, req.GetId())
@@ -546,15 +962,224 @@ func (c *configServiceServer) DeleteCompare(ctx context.Context, req *pb.DeleteC
 		log.Printf("[DeleteCompare] cascade removal failed (non-fatal): %v", err)
 	}
 
-	broadcastReq := &graderPB.BroadcastRequest{
-		Action: graderPB.BroadcastAction_REFETCH_CONFIG,
+	c.publisher.Publish()
+
+	return nil, nil
+}
+
+func (c *configServiceServer) GetComparePresets(ctx context.Context, req *pb.GetComparePresetsRequest) (*pb.GetComparePresetsResponse, error) {
+	list := presets.List()
+
+	responses := make([]*pb.ComparePreset, len(list))
+	for i, preset := range list {
+		params := make([]*pb.ComparePresetParam, len(preset.Params))
+		for j, param := range preset.Params {
+			params[j] = &pb.ComparePresetParam{
+				Name:         param.Name,
+				Description:  param.Description,
+				Type:         string(param.Type),
+				DefaultValue: param.Default,
+			}
+		}
+
+		responses[i] = &pb.ComparePreset{
+			Id:          preset.ID,
+			Name:        preset.Name,
+			Description: preset.Description,
+			Version:     int32(preset.Version),
+			Params:      params,
+		}
 	}
-	_, err = c.graderClient.Broadcast(ctx, broadcastReq)
+
+	return &pb.GetComparePresetsResponse{
+		Presets: responses,
+	}, nil
+}
+
+func (c *configServiceServer) CreateCompareFromPreset(ctx context.Context, req *pb.CreateCompareFromPresetRequest) (*pb.CreateCompareResponse, error) {
+	compareID, err := c.idempotent(ctx, req, func() (string, error) {
+		return c.compareService.CreateFromPreset(ctx, &requests.CreateCompareFromPreset{
+			PresetID:    req.GetPresetId(),
+			Name:        req.GetName(),
+			Slug:        req.GetSlug(),
+			Description: req.GetDescription(),
+			Params:      req.GetParams(),
+		})
+	})
+	if err != nil {
+		return nil, presetError(err)
+	}
+
+	c.publisher.Publish()
+
+	return &pb.CreateCompareResponse{
+		Id: compareID,
+	}, nil
+}
+
+func (c *configServiceServer) UpgradeComparePreset(ctx context.Context, req *pb.UpgradeComparePresetRequest) (*emptypb.Empty, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Id is required!")
+	}
+
+	err := c.compareService.UpgradePreset(ctx, req.GetId(), req.GetParams())
+	if err != nil {
+		return nil, presetError(err)
+	}
+
+	c.publisher.Publish()
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *configServiceServer) DownloadFile(req *pb.DownloadFileRequest, stream grpc.ServerStreamingServer[pb.FileChunk]) error {
+	if req.GetSha256() == "" {
+		return status.Error(codes.InvalidArgument, "Sha256 is required!")
+	}
+
+	reader, size, err := c.fileService.Open(stream.Context(), req.GetSha256())
+	if err != nil {
+		return toStatus(err)
+	}
+	defer reader.Close()
+
+	chunk := &pb.FileChunk{
+		Size:   size,
+		Sha256: req.GetSha256(),
+	}
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			chunk.Data = buf[:n]
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+			// Only the first chunk carries the metadata.
+			chunk = &pb.FileChunk{}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	// Empty files still send their metadata.
+	if chunk.Sha256 != "" {
+		return stream.Send(chunk)
+	}
+	return nil
+}
+
+func presetError(err error) error {
+	if errors.Is(err, presets.ErrUnknownPreset) || errors.Is(err, presets.ErrInvalidParam) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, presets.ErrNotPresetBacked) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return toStatus(err)
+}
+
+func (c *configServiceServer) TestCompare(ctx context.Context, req *pb.TestCompareRequest) (*pb.TestCompareResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Id is required!")
+	}
+
+	compareID, err := c.compareService.ResolveID(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	compare, err := c.compareService.GetByID(ctx, compareID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	results, passed, err := c.goldenCases.Run(ctx, compare)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.TestCompareResponse{
+		Passed:  passed,
+		Results: results,
+	}, nil
+}
+
+func createCompareBody(req *pb.CreateCompareRequest) (*requests.CreateCompare, error) {
+	goldenCases, err := models.PBGoldenCaseToGoldenCase(req.GetGoldenCases())
 	if err != nil {
 		return nil, err
 	}
 
-	return nil, nil
+	return &requests.CreateCompare{
+		Name:        req.GetName(),
+		Slug:        req.GetSlug(),
+		Files:       models.PBFileToFile(req.GetFiles()),
+		BuildScript: req.GetBuildScript(),
+		RunScript:   req.GetRunScript(),
+		RunName:     req.GetRunName(),
+		Description: req.GetDescription(),
+		GoldenCases: goldenCases,
+	}, nil
+}
+
+func updateCompareBody(req *pb.UpdateCompareRequest) (*requests.UpdateCompare, error) {
+	goldenCases, err := models.PBGoldenCaseToGoldenCase(req.GetGoldenCases())
+	if err != nil {
+		return nil, err
+	}
+
+	return &requests.UpdateCompare{
+		Name:        req.Name,
+		Files:       models.PBFileToFile(req.GetFiles()),
+		BuildScript: req.BuildScript,
+		RunScript:   req.RunScript,
+		RunName:     req.RunName,
+		Description: req.Description,
+		GoldenCases: goldenCases,
+	}, nil
+}
+
+// toStatus maps domain errors onto the matching gRPC status codes.
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, cerrors.INVALID_DATA):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, cerrors.DUPLICATE_DATA):
+		return conflictStatus(err)
+	case errors.Is(err, cerrors.DATA_IN_USE), errors.Is(err, cerrors.READ_ONLY):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, cerrors.FORBIDDEN):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case isNotFound(err):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+// conflictStatus answers AlreadyExists, with the ID of the runner or compare
+// holding the name in the details when known.
+func conflictStatus(err error) error {
+	st := status.New(codes.AlreadyExists, err.Error())
+
+	var conflict *cerrors.ConflictError
+	if !errors.As(err, &conflict) || conflict.ID == "" {
+		return st.Err()
+	}
+
+	detailed, detailsErr := st.WithDetails(&errdetails.ResourceInfo{
+		ResourceType: conflict.Kind,
+		ResourceName: conflict.ID,
+		Description:  fmt.Sprintf("%s %q already exists", conflict.Kind, conflict.Name),
+	})
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
 }
 
 func initTaskGRPCClient(clientAddr string) (taskPB.TaskServiceClient, func(), error) {
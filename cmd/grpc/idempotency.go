@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+
+	"github.com/CSKU-Lab/config-server/domain/namespaces"
+	"github.com/CSKU-Lab/config-server/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// idempotencyHeader carries a key chosen by the client, so that retrying a
+	// create request doesn't create twice.
+	idempotencyHeader = "idempotency-key"
+	maxIdempotencyKey = 255
+)
+
+// idempotent runs create once per idempotency key sent in the metadata, or
+// every time without a key. Keys are scoped to the caller, the method and the
+// namespace.
+func (c *configServiceServer) idempotent(ctx context.Context, req proto.Message, create func() (string, error)) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(idempotencyHeader)
+	if len(values) == 0 || values[0] == "" {
+		return create()
+	}
+
+	key := values[0]
+	if len(key) > maxIdempotencyKey {
+		return "", status.Errorf(codes.InvalidArgument, "Idempotency key must be at most %d characters!", maxIdempotencyKey)
+	}
+
+	method, _ := grpc.Method(ctx)
+	scope := auth.FromContext(ctx).Name + " " + method
+	if namespace, ok := namespaces.FromContext(ctx); ok {
+		scope += " " + namespace
+	}
+
+	request, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+
+	ID, err := c.idempotencyService.Do(ctx, scope, key, request, create)
+	if err != nil {
+		return "", toStatus(err)
+	}
+	return ID, nil
+}
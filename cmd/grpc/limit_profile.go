@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func limitProfileToPBLimitProfile(profile *models.LimitProfile) *pb.LimitProfileResponse {
+	return &pb.LimitProfileResponse{
+		Id:          profile.ID,
+		Name:        profile.Name,
+		Description: profile.Description,
+		Limit:       models.LimitToPBLimit(profile.Limit),
+	}
+}
+
+func (c *configServiceServer) CreateLimitProfile(ctx context.Context, req *pb.CreateLimitProfileRequest) (*pb.CreateLimitProfileResponse, error) {
+	profileID, err := c.idempotent(ctx, req, func() (string, error) {
+		return c.limitProfileService.Create(ctx, &requests.CreateLimitProfile{
+			Name:        req.GetName(),
+			Description: req.GetDescription(),
+			Limit:       models.PBLimitToLimit(req.GetLimit()),
+		})
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.CreateLimitProfileResponse{
+		Id: profileID,
+	}, nil
+}
+
+func (c *configServiceServer) GetLimitProfilesPagination(ctx context.Context, req *pb.GetLimitProfilesPaginationRequest) (*pb.GetLimitProfilesPaginationResponse, error) {
+	pagination, err := paginationRequest(req.GetPagination(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, total, err := c.limitProfileService.GetPagination(ctx, pagination)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	responses := make([]*pb.LimitProfileResponse, len(profiles))
+	for i := range profiles {
+		responses[i] = limitProfileToPBLimitProfile(&profiles[i])
+	}
+
+	return &pb.GetLimitProfilesPaginationResponse{
+		LimitProfiles: responses,
+		Count:         int32(total),
+	}, nil
+}
+
+func (c *configServiceServer) GetLimitProfile(ctx context.Context, req *pb.GetLimitProfileRequest) (*pb.LimitProfileResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Id is required!")
+	}
+
+	profile, err := c.limitProfileService.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return limitProfileToPBLimitProfile(profile), nil
+}
+
+func (c *configServiceServer) GetAllLimitProfiles(ctx context.Context, req *pb.GetAllLimitProfilesRequest) (*pb.GetAllLimitProfilesResponse, error) {
+	profiles, err := c.limitProfileService.GetAll(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	responses := make([]*pb.LimitProfileResponse, len(profiles))
+	for i := range profiles {
+		responses[i] = limitProfileToPBLimitProfile(&profiles[i])
+	}
+
+	return &pb.GetAllLimitProfilesResponse{
+		LimitProfiles: responses,
+	}, nil
+}
+
+func (c *configServiceServer) UpdateLimitProfile(ctx context.Context, req *pb.UpdateLimitProfileRequest) (*emptypb.Empty, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Id is required!")
+	}
+
+	body := &requests.UpdateLimitProfile{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+	if req.Limit != nil {
+		limit := models.PBLimitToLimit(req.Limit)
+		body.Limit = &limit
+	}
+
+	err := c.limitProfileService.UpdateByID(ctx, req.GetId(), body)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *configServiceServer) DeleteLimitProfile(ctx context.Context, req *pb.DeleteLimitProfileRequest) (*emptypb.Empty, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Id is required!")
+	}
+
+	err := c.limitProfileService.DeleteByID(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *configServiceServer) ResolveLimit(ctx context.Context, req *pb.ResolveLimitRequest) (*pb.ResolveLimitResponse, error) {
+	profileID, limit, err := c.limitProfileService.Resolve(ctx, req.GetLimitProfileId(), req.GetRunnerId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.ResolveLimitResponse{
+		LimitProfileId: profileID,
+		Limit:          models.LimitToPBLimit(limit),
+	}, nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/CSKU-Lab/config-server/internal/migrate"
+)
+
+// runMigrate runs the migrate subcommand, applying, reverting or listing the
+// schema migrations of the configured storage.
+func runMigrate(migrator *migrate.Migrator, args []string) error {
+	if len(args) < 1 {
+		fmt.Fprintln(os.Stderr, "usage: grpc migrate up|down|status [flags]")
+		os.Exit(2)
+	}
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			fmt.Printf("Applied %d %s\n", migration.Version, migration.Description)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("Already up to date.")
+		}
+		return err
+	case "down":
+		fs := flag.NewFlagSet("down", flag.ExitOnError)
+		steps := fs.Int("steps", 1, "number of migrations to revert")
+		fs.Parse(args[1:])
+		if *steps < 1 {
+			return errors.New("steps must be at least 1")
+		}
+
+		reverted, err := migrator.Down(ctx, *steps)
+		for _, migration := range reverted {
+			fmt.Printf("Reverted %d %s\n", migration.Version, migration.Description)
+		}
+		if err == nil && len(reverted) == 0 {
+			fmt.Println("Nothing to revert.")
+		}
+		return err
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tDESCRIPTION\tAPPLIED AT")
+		for _, s := range statuses {
+			appliedAt := "pending"
+			if s.Applied {
+				appliedAt = s.AppliedAt.Format(time.RFC3339)
+			}
+			if s.Unknown {
+				appliedAt += " (unknown to this build)"
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\n", s.Version, s.Description, appliedAt)
+		}
+		return w.Flush()
+	default:
+		fmt.Fprintf(os.Stderr, "unknown migrate command %q, expected up, down or status\n", args[0])
+		os.Exit(2)
+	}
+	return nil
+}
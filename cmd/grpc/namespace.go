@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+
+	"github.com/CSKU-Lab/config-server/domain/namespaces"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	"github.com/CSKU-Lab/config-server/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// namespaceHeader lets admins and anonymous callers work in one namespace.
+const namespaceHeader = "namespace"
+
+// adminMethods span every namespace, or change what every namespace shares,
+// so only admins may call them. They ignore the namespace header.
+var adminMethods = map[string]bool{
+	pb.ConfigService_MoveRunner_FullMethodName:         true,
+	pb.ConfigService_CopyRunner_FullMethodName:         true,
+	pb.ConfigService_MoveCompare_FullMethodName:        true,
+	pb.ConfigService_CopyCompare_FullMethodName:        true,
+	pb.ConfigService_CreateLimitProfile_FullMethodName: true,
+	pb.ConfigService_UpdateLimitProfile_FullMethodName: true,
+	pb.ConfigService_DeleteLimitProfile_FullMethodName: true,
+	pb.ConfigService_CreateSharedFile_FullMethodName:   true,
+	pb.ConfigService_UpdateSharedFile_FullMethodName:   true,
+	pb.ConfigService_DeleteSharedFile_FullMethodName:   true,
+	pb.ConfigService_GetFileUsages_FullMethodName:      true,
+	pb.ConfigService_Sync_FullMethodName:               true,
+	pb.ConfigService_ExportSnapshot_FullMethodName:     true,
+	pb.ConfigService_ImportSnapshot_FullMethodName:     true,
+	pb.ConfigService_GetGraderStatus_FullMethodName:    true,
+}
+
+// namespaceUnaryInterceptor scopes a call to the namespace of the caller.
+func namespaceUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := withNamespace(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func namespaceStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := withNamespace(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &namespaceStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type namespaceStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *namespaceStream) Context() context.Context {
+	return s.ctx
+}
+
+// withNamespace scopes ctx to the namespace the caller is bound to. Admins work
+// in the one of the namespace header, or in every namespace without it.
+// Anonymous callers, only left when no token is declared, work in the one of
+// the namespace header or in the global one, and cannot call adminMethods.
+func withNamespace(ctx context.Context, method string) (context.Context, error) {
+	identity := auth.FromContext(ctx)
+
+	var requested string
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(namespaceHeader); len(values) > 0 {
+		requested = values[0]
+	}
+
+	if identity.IsAdmin() {
+		if requested == "" || adminMethods[method] {
+			return ctx, nil
+		}
+		err := namespaces.Validate(requested)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		return namespaces.With(ctx, requested), nil
+	}
+
+	if identity.IsAnonymous() {
+		if adminMethods[method] {
+			return nil, status.Errorf(codes.PermissionDenied, "anonymous callers cannot call %s", method)
+		}
+		err := namespaces.Validate(requested)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		return namespaces.With(ctx, requested), nil
+	}
+
+	if adminMethods[method] {
+		return nil, status.Errorf(codes.PermissionDenied, "%s is bound to the %s namespace and cannot call %s", identity.Name, identity.Namespace, method)
+	}
+	if requested != "" && requested != identity.Namespace {
+		return nil, status.Errorf(codes.PermissionDenied, "%s is bound to the %s namespace and cannot work in %s", identity.Name, identity.Namespace, requested)
+	}
+	return namespaces.With(ctx, identity.Namespace), nil
+}
+
+func (c *configServiceServer) MoveRunner(ctx context.Context, req *pb.MoveRunnerRequest) (*emptypb.Empty, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Id is required!")
+	}
+
+	err := c.runnerService.MoveToNamespace(ctx, req.GetId(), req.GetNamespace())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	c.publisher.Publish()
+
+	return nil, nil
+}
+
+func (c *configServiceServer) CopyRunner(ctx context.Context, req *pb.CopyRunnerRequest) (*pb.CopyRunnerResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Id is required!")
+	}
+
+	ID, err := c.idempotent(ctx, req, func() (string, error) {
+		return c.runnerService.CopyToNamespace(ctx, req.GetId(), copyToNamespaceBody(req.GetNamespace(), req.GetName(), req.GetSlug()))
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	c.publisher.Publish()
+
+	return &pb.CopyRunnerResponse{
+		Id: ID,
+	}, nil
+}
+
+func (c *configServiceServer) MoveCompare(ctx context.Context, req *pb.MoveCompareRequest) (*emptypb.Empty, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Id is required!")
+	}
+
+	err := c.compareService.MoveToNamespace(ctx, req.GetId(), req.GetNamespace())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	c.publisher.Publish()
+
+	return nil, nil
+}
+
+func (c *configServiceServer) CopyCompare(ctx context.Context, req *pb.CopyCompareRequest) (*pb.CopyCompareResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Id is required!")
+	}
+
+	ID, err := c.idempotent(ctx, req, func() (string, error) {
+		return c.compareService.CopyToNamespace(ctx, req.GetId(), copyToNamespaceBody(req.GetNamespace(), req.GetName(), req.GetSlug()))
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	c.publisher.Publish()
+
+	return &pb.CopyCompareResponse{
+		Id: ID,
+	}, nil
+}
+
+func copyToNamespaceBody(namespace string, name string, slug string) *requests.CopyToNamespace {
+	return &requests.CopyToNamespace{
+		Namespace: namespace,
+		Name:      name,
+		Slug:      slug,
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/CSKU-Lab/config-server/domain/namespaces"
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	"github.com/CSKU-Lab/config-server/internal/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestWithNamespace(t *testing.T) {
+	var (
+		anonymous = auth.Identity{}
+		admin     = auth.Identity{Name: "admin"}
+		teacher   = auth.Identity{Name: "teacher", Namespace: "cs101"}
+	)
+	const (
+		method      = pb.ConfigService_GetAllRunners_FullMethodName
+		adminMethod = pb.ConfigService_Sync_FullMethodName
+	)
+
+	tests := []struct {
+		name      string
+		identity  auth.Identity
+		method    string
+		header    string
+		namespace string
+		scoped    bool
+		code      codes.Code
+	}{
+		{name: "admin sees every namespace", identity: admin, method: method},
+		{name: "admin works in the header namespace", identity: admin, method: method, header: "cs101", namespace: "cs101", scoped: true},
+		{name: "admin sends an invalid namespace", identity: admin, method: method, header: "CS 101", code: codes.InvalidArgument},
+		{name: "admin method ignores the header", identity: admin, method: adminMethod, header: "cs101"},
+		{name: "bound caller works in its namespace", identity: teacher, method: method, namespace: "cs101", scoped: true},
+		{name: "bound caller sends its namespace", identity: teacher, method: method, header: "cs101", namespace: "cs101", scoped: true},
+		{name: "bound caller sends another namespace", identity: teacher, method: method, header: "cs102", code: codes.PermissionDenied},
+		{name: "bound caller calls an admin method", identity: teacher, method: adminMethod, code: codes.PermissionDenied},
+		{name: "anonymous caller works in the global namespace", identity: anonymous, method: method, namespace: namespaces.Global, scoped: true},
+		{name: "anonymous caller works in the header namespace", identity: anonymous, method: method, header: "cs101", namespace: "cs101", scoped: true},
+		{name: "anonymous caller sends an invalid namespace", identity: anonymous, method: method, header: "CS 101", code: codes.InvalidArgument},
+		{name: "anonymous caller calls an admin method", identity: anonymous, method: adminMethod, code: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := auth.WithIdentity(context.Background(), tt.identity)
+			if tt.header != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(namespaceHeader, tt.header))
+			}
+
+			ctx, err := withNamespace(ctx, tt.method)
+			if status.Code(err) != tt.code {
+				t.Fatalf("withNamespace error = %v, want code %v", err, tt.code)
+			}
+			if err != nil {
+				return
+			}
+
+			namespace, scoped := namespaces.FromContext(ctx)
+			if namespace != tt.namespace || scoped != tt.scoped {
+				t.Errorf("withNamespace scoped to %q, %v, want %q, %v", namespace, scoped, tt.namespace, tt.scoped)
+			}
+		})
+	}
+}
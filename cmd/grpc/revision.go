@@ -0,0 +1,244 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	watchBatchSize = 100
+	// revisionHeader carries the revision every response is at least as
+	// recent as.
+	revisionHeader = "config-revision"
+)
+
+var (
+	changeKinds = map[models.ChangeKind]pb.ConfigKind{
+		models.ChangeRunner:  pb.ConfigKind_CONFIG_KIND_RUNNER,
+		models.ChangeCompare: pb.ConfigKind_CONFIG_KIND_COMPARE,
+	}
+	changeActions = map[models.ChangeAction]pb.ConfigAction{
+		models.ChangeCreated: pb.ConfigAction_CONFIG_ACTION_CREATED,
+		models.ChangeUpdated: pb.ConfigAction_CONFIG_ACTION_UPDATED,
+		models.ChangeDeleted: pb.ConfigAction_CONFIG_ACTION_DELETED,
+	}
+)
+
+// WatchConfig streams the changes of every revision after the requested one,
+// then every new change as it happens, leaving out the ones the caller cannot
+// see. Consumers resume after a disconnect from the last revision they
+// received.
+func (c *configServiceServer) WatchConfig(req *pb.WatchConfigRequest, stream grpc.ServerStreamingServer[pb.ConfigEvent]) error {
+	ctx := stream.Context()
+
+	since := req.GetSinceRevision()
+	_, err := c.checkRevision(ctx, since)
+	if err != nil {
+		return err
+	}
+
+	for {
+		revisions, err := c.revisionService.GetSince(ctx, since, watchBatchSize)
+		if err != nil {
+			return toStatus(err)
+		}
+
+		for _, revision := range revisions {
+			for _, change := range revision.Changes {
+				err := stream.Send(&pb.ConfigEvent{
+					Revision: revision.Number,
+					Kind:     changeKinds[change.Kind],
+					Action:   changeActions[change.Action],
+					Id:       change.ID,
+				})
+				if err != nil {
+					return err
+				}
+			}
+			since = revision.Number
+		}
+		if len(revisions) == watchBatchSize {
+			continue
+		}
+
+		err = c.revisionService.Wait(ctx, since)
+		if err != nil {
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			return toStatus(err)
+		}
+	}
+}
+
+// GetConfigDelta returns the runners and compares changed after a revision,
+// so that graders refetch only those. Callers bound to a namespace only get
+// the changes of the runners and compares they can see.
+func (c *configServiceServer) GetConfigDelta(ctx context.Context, req *pb.GetConfigDeltaRequest) (*pb.GetConfigDeltaResponse, error) {
+	latest, err := c.checkRevision(ctx, req.GetSinceRevision())
+	if err != nil {
+		return nil, err
+	}
+
+	changes, last, err := c.revisionService.GetChanges(ctx, req.GetSinceRevision())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res := &pb.GetConfigDeltaResponse{
+		Revision:          max(latest, last),
+		Runners:           []*pb.RunnerResponse{},
+		Compares:          []*pb.CompareResponse{},
+		DeletedRunnerIds:  []string{},
+		DeletedCompareIds: []string{},
+	}
+	deleted := map[string]bool{}
+	for _, change := range changes {
+		if change.Action != models.ChangeDeleted {
+			found, err := c.addToDelta(ctx, res, change, req)
+			if err != nil {
+				return nil, toStatus(err)
+			}
+			if found {
+				continue
+			}
+		} else {
+			// Moved to another namespace the caller sees, its creation
+			// there is among the changes.
+			found, err := c.isVisible(ctx, change)
+			if err != nil {
+				return nil, toStatus(err)
+			}
+			if found {
+				continue
+			}
+		}
+
+		// Deleted, maybe after the revisions got read, or moved out of the
+		// namespaces the caller sees.
+		key := string(change.Kind) + "/" + change.ID
+		if deleted[key] {
+			continue
+		}
+		deleted[key] = true
+		if change.Kind == models.ChangeRunner {
+			res.DeletedRunnerIds = append(res.DeletedRunnerIds, change.ID)
+		} else {
+			res.DeletedCompareIds = append(res.DeletedCompareIds, change.ID)
+		}
+	}
+
+	return res, nil
+}
+
+// addToDelta adds the current runner or compare of the change to res, or
+// returns false when it doesn't exist anymore.
+func (c *configServiceServer) addToDelta(ctx context.Context, res *pb.GetConfigDeltaResponse, change models.Change, req *pb.GetConfigDeltaRequest) (bool, error) {
+	if change.Kind == models.ChangeRunner {
+		runner, err := c.runnerService.GetByID(ctx, change.ID)
+		if isNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		entry, err := c.runnerToPBListedRunner(ctx, runner, req.GetIncludeMetadata(), req.GetIncludeScripts())
+		if err != nil {
+			return false, err
+		}
+		res.Runners = append(res.Runners, entry)
+		return true, nil
+	}
+
+	compare, err := c.compareService.GetByID(ctx, change.ID)
+	if isNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	entry, err := c.compareToPBListedCompare(ctx, compare, req.GetIncludeMetadata(), req.GetIncludeScripts(), req.GetIncludeFiles())
+	if err != nil {
+		return false, err
+	}
+	res.Compares = append(res.Compares, entry)
+	return true, nil
+}
+
+// isVisible reports whether the runner or compare of the change exists and
+// can be seen from ctx.
+func (c *configServiceServer) isVisible(ctx context.Context, change models.Change) (bool, error) {
+	var err error
+	if change.Kind == models.ChangeRunner {
+		_, err = c.runnerService.GetRawByID(ctx, change.ID)
+	} else {
+		_, err = c.compareService.GetByID(ctx, change.ID)
+	}
+	if isNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// checkRevision returns the latest revision, failing when since is after it,
+// e.g. for a consumer resuming against a server whose storage got reset.
+func (c *configServiceServer) checkRevision(ctx context.Context, since int64) (int64, error) {
+	if since < 0 {
+		return 0, status.Error(codes.InvalidArgument, "SinceRevision must not be negative!")
+	}
+
+	latest, err := c.revisionService.Latest(ctx)
+	if err != nil {
+		return 0, toStatus(err)
+	}
+	if since > latest {
+		return 0, status.Errorf(codes.OutOfRange, "revision %d is after the latest revision %d, the whole configuration must be fetched again", since, latest)
+	}
+	return latest, nil
+}
+
+// revisionUnaryInterceptor sends the latest revision in the header of every
+// response. It's read before the call, so the response is at least as recent.
+func (c *configServiceServer) revisionUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if md, ok := c.revisionMetadata(ctx); ok {
+			grpc.SetHeader(ctx, md)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (c *configServiceServer) revisionStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if md, ok := c.revisionMetadata(ss.Context()); ok {
+			ss.SetHeader(md)
+		}
+		return handler(srv, ss)
+	}
+}
+
+func (c *configServiceServer) revisionMetadata(ctx context.Context) (metadata.MD, bool) {
+	revision, err := c.revisionService.Latest(ctx)
+	if err != nil {
+		log.Printf("Cannot get the latest revision : %v", err)
+		return nil, false
+	}
+	return metadata.Pairs(revisionHeader, strconv.FormatInt(revision, 10)), true
+}
+
+func isNotFound(err error) bool {
+	return errors.Is(err, cerrors.CANNOT_GET_DATA) || errors.Is(err, mongo.ErrNoDocuments)
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (c *configServiceServer) sharedFileToPBSharedFile(ctx context.Context, sharedFile *models.SharedFile, includeContent bool) (*pb.SharedFileResponse, error) {
+	res := &pb.SharedFileResponse{
+		Id:          sharedFile.ID,
+		Name:        sharedFile.Name,
+		Description: sharedFile.Description,
+		File: &pb.File{
+			Name:   sharedFile.File.Name,
+			Size:   sharedFile.File.Size,
+			Sha256: sharedFile.File.SHA256,
+			Mode:   sharedFile.File.Mode,
+		},
+	}
+
+	if includeContent {
+		files, err := models.FileToPBFile(ctx, c.fileService, []models.File{sharedFile.File})
+		if err != nil {
+			return nil, err
+		}
+		res.File = files[0]
+	}
+
+	return res, nil
+}
+
+func pbFileToSharedFile(file *pb.File) models.File {
+	if file == nil {
+		return models.File{}
+	}
+	return models.PBFileToFile([]*pb.File{file})[0]
+}
+
+func (c *configServiceServer) CreateSharedFile(ctx context.Context, req *pb.CreateSharedFileRequest) (*pb.CreateSharedFileResponse, error) {
+	sharedFileID, linked, err := c.sharedFileService.Create(ctx, &requests.CreateSharedFile{
+		Name:         req.GetName(),
+		Description:  req.GetDescription(),
+		File:         pbFileToSharedFile(req.GetFile()),
+		LinkExisting: req.GetLinkExisting(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	if linked > 0 {
+		c.publisher.Publish()
+	}
+
+	return &pb.CreateSharedFileResponse{
+		Id:          sharedFileID,
+		LinkedCount: int32(linked),
+	}, nil
+}
+
+func (c *configServiceServer) GetSharedFilesPagination(ctx context.Context, req *pb.GetSharedFilesPaginationRequest) (*pb.GetSharedFilesPaginationResponse, error) {
+	pagination, err := paginationRequest(req.GetPagination(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedFiles, total, err := c.sharedFileService.GetPagination(ctx, pagination)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	responses := make([]*pb.SharedFileResponse, len(sharedFiles))
+	for i := range sharedFiles {
+		responses[i], err = c.sharedFileToPBSharedFile(ctx, &sharedFiles[i], false)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+	}
+
+	return &pb.GetSharedFilesPaginationResponse{
+		SharedFiles: responses,
+		Count:       int32(total),
+	}, nil
+}
+
+func (c *configServiceServer) GetSharedFile(ctx context.Context, req *pb.GetSharedFileRequest) (*pb.SharedFileResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Id is required!")
+	}
+
+	sharedFile, err := c.sharedFileService.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res, err := c.sharedFileToPBSharedFile(ctx, sharedFile, true)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (c *configServiceServer) GetAllSharedFiles(ctx context.Context, req *pb.GetAllSharedFilesRequest) (*pb.GetAllSharedFilesResponse, error) {
+	sharedFiles, err := c.sharedFileService.GetAll(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	responses := make([]*pb.SharedFileResponse, len(sharedFiles))
+	for i := range sharedFiles {
+		responses[i], err = c.sharedFileToPBSharedFile(ctx, &sharedFiles[i], req.GetIncludeContent())
+		if err != nil {
+			return nil, toStatus(err)
+		}
+	}
+
+	return &pb.GetAllSharedFilesResponse{
+		SharedFiles: responses,
+	}, nil
+}
+
+// UpdateSharedFile changes the file for every runner and compare linking it,
+// so graders are told to refetch once for all of them.
+func (c *configServiceServer) UpdateSharedFile(ctx context.Context, req *pb.UpdateSharedFileRequest) (*emptypb.Empty, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Id is required!")
+	}
+
+	body := &requests.UpdateSharedFile{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+	if req.File != nil {
+		file := pbFileToSharedFile(req.File)
+		body.File = &file
+	}
+
+	err := c.sharedFileService.UpdateByID(ctx, req.GetId(), body)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	if body.File != nil {
+		c.publisher.Publish()
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *configServiceServer) DeleteSharedFile(ctx context.Context, req *pb.DeleteSharedFileRequest) (*emptypb.Empty, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Id is required!")
+	}
+
+	err := c.sharedFileService.DeleteByID(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *configServiceServer) GetFileUsages(ctx context.Context, req *pb.GetFileUsagesRequest) (*pb.GetFileUsagesResponse, error) {
+	if req.GetSha256() == "" && req.GetSharedFileId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Sha256 or SharedFileId is required!")
+	}
+
+	usages, err := c.sharedFileService.GetUsages(ctx, req.GetSha256(), req.GetSharedFileId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	responses := make([]*pb.FileUsage, len(usages))
+	for i, usage := range usages {
+		responses[i] = &pb.FileUsage{
+			Kind:         string(usage.Kind),
+			Id:           usage.ID,
+			Name:         usage.Name,
+			FileName:     usage.FileName,
+			SharedFileId: usage.SharedFileID,
+		}
+	}
+
+	return &pb.GetFileUsagesResponse{
+		Usages: responses,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (c *configServiceServer) RenameRunnerSlug(ctx context.Context, req *pb.RenameRunnerSlugRequest) (*emptypb.Empty, error) {
+	if req.GetId() == "" || req.GetSlug() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Id and slug are required!")
+	}
+
+	err := c.runnerService.RenameSlug(ctx, req.GetId(), req.GetSlug())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	c.publisher.Publish()
+
+	return nil, nil
+}
+
+func (c *configServiceServer) RenameCompareSlug(ctx context.Context, req *pb.RenameCompareSlugRequest) (*emptypb.Empty, error) {
+	if req.GetId() == "" || req.GetSlug() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Id and slug are required!")
+	}
+
+	err := c.compareService.RenameSlug(ctx, req.GetId(), req.GetSlug())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	c.publisher.Publish()
+
+	return nil, nil
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log"
+
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	taskPB "github.com/CSKU-Lab/config-server/genproto/task/v1"
+	"github.com/CSKU-Lab/config-server/internal/snapshot"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxSnapshotSize = 1 << 30
+
+func (c *configServiceServer) ExportSnapshot(req *pb.ExportSnapshotRequest, stream grpc.ServerStreamingServer[pb.ArchiveChunk]) error {
+	snap, err := c.snapshotService.Export(stream.Context())
+	if err != nil {
+		return toStatus(err)
+	}
+
+	var buf bytes.Buffer
+	err = snapshot.Write(stream.Context(), &buf, snap, c.fileService)
+	if err != nil {
+		return toStatus(err)
+	}
+
+	for data := buf.Bytes(); len(data) > 0; {
+		n := min(len(data), downloadChunkSize)
+		err := stream.Send(&pb.ArchiveChunk{Data: data[:n]})
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	return nil
+}
+
+func (c *configServiceServer) ImportSnapshot(stream grpc.ClientStreamingServer[pb.ImportSnapshotRequest, pb.ImportSnapshotResponse]) error {
+	ctx := stream.Context()
+
+	var (
+		opts requests.ImportSnapshot
+		buf  bytes.Buffer
+	)
+	for first := true; ; first = false {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if first {
+			opts.DryRun = req.GetDryRun()
+			opts.RemapIDs = req.GetRemapIds()
+			switch req.GetMode() {
+			case pb.RestoreMode_RESTORE_MODE_UNSPECIFIED, pb.RestoreMode_RESTORE_MODE_MERGE:
+				opts.Mode = models.RestoreMerge
+			case pb.RestoreMode_RESTORE_MODE_REPLACE:
+				opts.Mode = models.RestoreReplace
+			default:
+				return status.Errorf(codes.InvalidArgument, "unknown restore mode %s", req.GetMode())
+			}
+		}
+		if buf.Len()+len(req.GetData()) > maxSnapshotSize {
+			return status.Errorf(codes.ResourceExhausted, "snapshot is larger than %d bytes", maxSnapshotSize)
+		}
+		buf.Write(req.GetData())
+	}
+
+	snap, blobs, err := snapshot.Read(buf.Bytes())
+	if err != nil {
+		return archiveError(err)
+	}
+	opts.Blobs = blobs
+
+	changes, err := c.snapshotService.Import(ctx, snap, &opts)
+	if err != nil {
+		return toStatus(err)
+	}
+
+	applied := false
+	for _, change := range changes {
+		if change.Action != models.SnapshotUnchanged {
+			applied = !opts.DryRun
+		}
+	}
+
+	if applied {
+		for _, change := range changes {
+			if change.Action != models.SnapshotDelete {
+				continue
+			}
+
+			var err error
+			switch change.Kind {
+			case models.SnapshotRunner:
+				_, err = c.taskClient.RemoveRunnerOnCascade(ctx, &taskPB.RemoveRunnerOnCascadeRequest{RunnerId: change.ID})
+			case models.SnapshotCompare:
+				_, err = c.taskClient.RemoveCompareScriptOnCascade(ctx, &taskPB.RemoveCompareScriptOnCascadeRequest{CompareScriptId: change.ID})
+			}
+			if err != nil {
+				log.Printf("[ImportSnapshot] cascade removal of %s %s failed (non-fatal): %v", change.Kind, change.ID, err)
+			}
+		}
+
+		c.publisher.Publish()
+	}
+
+	res := &pb.ImportSnapshotResponse{
+		Changes: make([]*pb.SnapshotChange, len(changes)),
+		Applied: applied,
+	}
+	for i, change := range changes {
+		res.Changes[i] = &pb.SnapshotChange{
+			Action:     string(change.Action),
+			Kind:       string(change.Kind),
+			Id:         change.ID,
+			Name:       change.Name,
+			SnapshotId: change.SnapshotID,
+		}
+	}
+
+	return stream.SendAndClose(res)
+}
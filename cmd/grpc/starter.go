@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+
+	"github.com/CSKU-Lab/config-server/domain/requests"
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (c *configServiceServer) RenderStarterTemplate(ctx context.Context, req *pb.RenderStarterTemplateRequest) (*pb.RenderStarterTemplateResponse, error) {
+	if req.GetRunnerId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Runner id is required!")
+	}
+
+	ID, err := c.runnerService.ResolveID(ctx, req.GetRunnerId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	filename, content, err := c.runnerService.RenderStarter(ctx, ID, &requests.RenderStarter{
+		TaskName: req.GetTaskName(),
+		IOStyle:  req.GetIoStyle(),
+		Params:   req.GetParams(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.RenderStarterTemplateResponse{
+		Filename: filename,
+		Content:  content,
+	}, nil
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log"
+
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	taskPB "github.com/CSKU-Lab/config-server/genproto/task/v1"
+	"github.com/CSKU-Lab/config-server/internal/gitops"
+	"github.com/CSKU-Lab/config-server/internal/manifest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Sync brings the runners and compares in line with a directory of
+// manifests sent as an archive. Graders are told to refetch once, after
+// every change is applied.
+func (c *configServiceServer) Sync(stream grpc.ClientStreamingServer[pb.SyncRequest, pb.SyncResponse]) error {
+	ctx := stream.Context()
+
+	var (
+		dryRun, prune bool
+		buf           bytes.Buffer
+	)
+	for first := true; ; first = false {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if first {
+			dryRun, prune = req.GetDryRun(), req.GetPrune()
+		}
+		if buf.Len()+len(req.GetData()) > maxArchiveSize {
+			return status.Errorf(codes.ResourceExhausted, "archive is larger than %d bytes", maxArchiveSize)
+		}
+		buf.Write(req.GetData())
+	}
+
+	tree, err := manifest.ReadArchive(buf.Bytes(), manifest.DefaultLimits)
+	if err != nil {
+		return archiveError(err)
+	}
+
+	plan, err := c.syncer.Plan(ctx, tree, prune)
+	if err != nil {
+		return toStatus(err)
+	}
+
+	// Golden cases are checked up front too, so that dry runs report them and
+	// a failing compare doesn't leave the sync half applied.
+	for i := range plan.Changes {
+		if compare := plan.Changes[i].Compare(); compare != nil && plan.Changes[i].Action != gitops.ActionUnchanged {
+			err := c.goldenCases.Verify(ctx, compare)
+			if err != nil {
+				return toStatus(err)
+			}
+		}
+	}
+
+	applied := !dryRun && plan.HasChanges()
+	if applied {
+		// Only what was deleted is cascaded, a failed delete keeps its tasks.
+		done, applyErr := c.syncer.Apply(ctx, plan)
+
+		for _, change := range done {
+			if change.Action != gitops.ActionDelete {
+				continue
+			}
+
+			var err error
+			switch change.Kind {
+			case manifest.KindRunner:
+				_, err = c.taskClient.RemoveRunnerOnCascade(ctx, &taskPB.RemoveRunnerOnCascadeRequest{RunnerId: change.ID})
+			case manifest.KindCompare:
+				_, err = c.taskClient.RemoveCompareScriptOnCascade(ctx, &taskPB.RemoveCompareScriptOnCascadeRequest{CompareScriptId: change.ID})
+			}
+			if err != nil {
+				log.Printf("[Sync] cascade removal of %s %s failed (non-fatal): %v", change.Kind, change.ID, err)
+			}
+		}
+
+		// Part of the plan may have been applied even when it failed.
+		c.publisher.Publish()
+		if applyErr != nil {
+			return toStatus(applyErr)
+		}
+	}
+
+	changes := make([]*pb.SyncChange, len(plan.Changes))
+	for i, change := range plan.Changes {
+		changes[i] = &pb.SyncChange{
+			Action: string(change.Action),
+			Kind:   string(change.Kind),
+			Id:     change.ID,
+			Name:   change.Name,
+			Paths:  change.Paths,
+		}
+	}
+
+	return stream.SendAndClose(&pb.SyncResponse{
+		Changes: changes,
+		Applied: applied,
+	})
+}
@@ -0,0 +1,199 @@
+// Command snapshot backs up and restores the whole configuration of a config
+// server, file contents included.
+//
+//	snapshot export [-o config.snapshot.tar.gz]
+//	snapshot import [-mode merge|replace] [-dry-run] [-remap-ids] config.snapshot.tar.gz
+//
+// Secret environment variables stay sealed, so they can only be read by a
+// server using the same secret key.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const chunkSize = 64 << 10
+
+type options struct {
+	addr    string
+	token   string
+	timeout time.Duration
+}
+
+func (o *options) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.addr, "addr", "localhost:8081", "config server address")
+	fs.StringVar(&o.token, "token", os.Getenv("CONFIG_SERVER_TOKEN"), "bearer token, defaults to $CONFIG_SERVER_TOKEN")
+	fs.DurationVar(&o.timeout, "timeout", 10*time.Minute, "request timeout")
+}
+
+func (o *options) connect() (pb.ConfigServiceClient, context.Context, func()) {
+	conn, err := grpc.NewClient(o.addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalln("Cannot connect to config server: ", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), o.timeout)
+	if o.token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+o.token)
+	}
+
+	return pb.NewConfigServiceClient(conn), ctx, func() {
+		cancel()
+		conn.Close()
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: snapshot export|import [flags]")
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "export":
+		export(os.Args[2:])
+	case "import":
+		restore(os.Args[2:])
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q, expected export or import\n", os.Args[1])
+		os.Exit(2)
+	}
+}
+
+func export(args []string) {
+	var opts options
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	opts.register(fs)
+	output := fs.String("o", fmt.Sprintf("config-%s.snapshot.tar.gz", time.Now().UTC().Format("20060102-150405")), "output file, - for stdout")
+	fs.Parse(args)
+
+	client, ctx, closeFn := opts.connect()
+	defer closeFn()
+
+	stream, err := client.ExportSnapshot(ctx, &pb.ExportSnapshotRequest{})
+	if err != nil {
+		log.Fatalln("Cannot export snapshot: ", err)
+	}
+
+	out := os.Stdout
+	if *output != "-" {
+		out, err = os.Create(*output)
+		if err != nil {
+			log.Fatalln("Cannot create output file: ", err)
+		}
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatalln("Cannot export snapshot: ", err)
+		}
+
+		_, err = out.Write(chunk.GetData())
+		if err != nil {
+			log.Fatalln("Cannot write snapshot: ", err)
+		}
+	}
+
+	err = out.Close()
+	if err != nil {
+		log.Fatalln("Cannot write snapshot: ", err)
+	}
+	if *output != "-" {
+		fmt.Fprintln(os.Stderr, "Snapshot written to", *output)
+	}
+}
+
+func restore(args []string) {
+	var opts options
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	opts.register(fs)
+	mode := fs.String("mode", "merge", "merge keeps documents missing from the snapshot, replace deletes them")
+	dryRun := fs.Bool("dry-run", false, "only print what would change")
+	remapIDs := fs.Bool("remap-ids", false, "match documents by name, giving new IDs to the others")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: snapshot import [flags] FILE")
+		os.Exit(2)
+	}
+
+	var restoreMode pb.RestoreMode
+	switch *mode {
+	case "merge":
+		restoreMode = pb.RestoreMode_RESTORE_MODE_MERGE
+	case "replace":
+		restoreMode = pb.RestoreMode_RESTORE_MODE_REPLACE
+	default:
+		log.Fatalf("Unknown mode %q, expected merge or replace", *mode)
+	}
+
+	data, err := os.ReadFile(fs.Arg(0))
+	if err != nil {
+		log.Fatalln("Cannot read snapshot: ", err)
+	}
+
+	client, ctx, closeFn := opts.connect()
+	defer closeFn()
+
+	stream, err := client.ImportSnapshot(ctx)
+	if err != nil {
+		log.Fatalln("Cannot import snapshot: ", err)
+	}
+
+	for first := true; first || len(data) > 0; first = false {
+		n := min(len(data), chunkSize)
+		err = stream.Send(&pb.ImportSnapshotRequest{
+			Data:     data[:n],
+			Mode:     restoreMode,
+			DryRun:   *dryRun,
+			RemapIds: *remapIDs,
+		})
+		if err != nil {
+			break
+		}
+		data = data[n:]
+	}
+
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		log.Fatalln("Cannot import snapshot: ", err)
+	}
+
+	counts := map[string]int{}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, change := range res.GetChanges() {
+		counts[change.GetAction()]++
+		if change.GetAction() == "unchanged" {
+			continue
+		}
+
+		remapped := ""
+		if change.GetSnapshotId() != "" && change.GetSnapshotId() != change.GetId() {
+			remapped = "from " + change.GetSnapshotId()
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", change.GetAction(), change.GetKind(), change.GetName(), change.GetId(), remapped)
+	}
+	w.Flush()
+
+	fmt.Printf("\n%d to create, %d to update, %d to delete, %d unchanged.\n", counts["create"], counts["update"], counts["delete"], counts["unchanged"])
+	if !res.GetApplied() {
+		fmt.Println("Nothing was applied.")
+	}
+}
@@ -0,0 +1,114 @@
+// Command sync applies a directory of runner and compare manifests to the
+// config server. Every definition lives in its own directory:
+//
+//	runners/python/manifest.yaml
+//	runners/python/build.sh
+//	runners/python/run.sh
+//	compares/exact/manifest.yaml
+//	compares/exact/files/checker.py
+//
+// Runners and compares missing from the directory are only deleted with
+// -prune. Use -dry-run to only print the plan.
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+	"github.com/CSKU-Lab/config-server/internal/manifest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const chunkSize = 64 << 10
+
+func main() {
+	addr := flag.String("addr", "localhost:8081", "config server address")
+	dir := flag.String("dir", ".", "directory of manifests")
+	token := flag.String("token", os.Getenv("CONFIG_SERVER_TOKEN"), "bearer token, defaults to $CONFIG_SERVER_TOKEN")
+	dryRun := flag.Bool("dry-run", false, "only print the plan")
+	prune := flag.Bool("prune", false, "delete runners and compares missing from the directory")
+	timeout := flag.Duration("timeout", time.Minute, "request timeout")
+	flag.Parse()
+
+	tree, err := manifest.ReadDir(*dir)
+	if err != nil {
+		log.Fatalln("Cannot read manifests: ", err)
+	}
+
+	var buf bytes.Buffer
+	err = manifest.WriteArchive(&buf, manifest.FormatTarGz, tree)
+	if err != nil {
+		log.Fatalln("Cannot archive manifests: ", err)
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalln("Cannot connect to config server: ", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	if *token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+*token)
+	}
+
+	stream, err := pb.NewConfigServiceClient(conn).Sync(ctx)
+	if err != nil {
+		log.Fatalln("Cannot start sync: ", err)
+	}
+
+	data := buf.Bytes()
+	for first := true; first || len(data) > 0; first = false {
+		n := min(len(data), chunkSize)
+		err = stream.Send(&pb.SyncRequest{
+			Data:   data[:n],
+			DryRun: *dryRun,
+			Prune:  *prune,
+		})
+		if err != nil {
+			break
+		}
+		data = data[n:]
+	}
+
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		log.Fatalln("Sync failed: ", err)
+	}
+
+	printPlan(res)
+}
+
+func printPlan(res *pb.SyncResponse) {
+	counts := map[string]int{}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, change := range res.GetChanges() {
+		counts[change.GetAction()]++
+		if change.GetAction() == "unchanged" {
+			continue
+		}
+
+		paths := ""
+		if len(change.GetPaths()) > 0 {
+			paths = strings.Join(change.GetPaths(), ", ")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", change.GetAction(), change.GetKind(), change.GetName(), change.GetId(), paths)
+	}
+	w.Flush()
+
+	fmt.Printf("\n%d to create, %d to update, %d to delete, %d unchanged.\n", counts["create"], counts["update"], counts["delete"], counts["unchanged"])
+	if !res.GetApplied() {
+		fmt.Println("Nothing was applied.")
+	}
+}
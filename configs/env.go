@@ -23,8 +23,21 @@ func NewEnv() *env {
 		"DATABASE_NAME":        os.Getenv("DATABASE_NAME"),
 		"TASK_SERVER_URL":      os.Getenv("TASK_SERVER_URL"),
 		"GO_GRADER_SERVER_URL": os.Getenv("GO_GRADER_SERVER_URL"),
+		"GRADER_ENDPOINTS":     os.Getenv("GRADER_ENDPOINTS"),
+		"BROADCAST_WINDOW":     os.Getenv("BROADCAST_WINDOW"),
+		"BROADCAST_MAX_DELAY":  os.Getenv("BROADCAST_MAX_DELAY"),
+		"BATCH_TIMEOUT":        os.Getenv("BATCH_TIMEOUT"),
+		"IDEMPOTENCY_TTL":      os.Getenv("IDEMPOTENCY_TTL"),
 		"REDIS_SERVER_URL":     os.Getenv("REDIS_SERVER_URL"),
 		"REDIS_PASSWORD":       os.Getenv("REDIS_PASSWORD"),
+		"STORAGE_DRIVER":       os.Getenv("STORAGE_DRIVER"),
+		"MIGRATE_ON_STARTUP":   os.Getenv("MIGRATE_ON_STARTUP"),
+		"AUTH_TOKENS":          os.Getenv("AUTH_TOKENS"),
+		"GRADER_IDENTITY":      os.Getenv("GRADER_IDENTITY"),
+		"SECRETS_KEY_FILE":     os.Getenv("SECRETS_KEY_FILE"),
+		"BLOB_DIR":             os.Getenv("BLOB_DIR"),
+		"HTTP_PORT":            os.Getenv("HTTP_PORT"),
+		"CORS_ALLOWED_ORIGINS": os.Getenv("CORS_ALLOWED_ORIGINS"),
 	}
 }
 
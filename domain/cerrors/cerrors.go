@@ -1,15 +1,21 @@
 package cerrors
 
-import "errors"
-
 type CError string
 
 const (
 	DUPLICATE_DATA  CError = "duplicate data"
 	CANNOT_GET_DATA CError = "cannot get the data"
 	UNKNOWN_ERROR   CError = "unknown error"
+	INVALID_DATA    CError = "invalid data"
+	DATA_IN_USE     CError = "data is in use"
+	FORBIDDEN       CError = "forbidden"
+	READ_ONLY       CError = "read only"
 )
 
+func (e CError) Error() string {
+	return string(e)
+}
+
 func New(err CError) error {
-	return errors.New(string(err))
+	return err
 }
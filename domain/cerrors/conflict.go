@@ -0,0 +1,22 @@
+package cerrors
+
+import "fmt"
+
+// ConflictError tells that a name is already taken by another document.
+type ConflictError struct {
+	Kind string
+	Name string
+	// ID is the ID of the document holding the name, empty when unknown.
+	ID string
+}
+
+func (e *ConflictError) Error() string {
+	if e.ID == "" {
+		return fmt.Sprintf("%s: %s %q already exists", DUPLICATE_DATA, e.Kind, e.Name)
+	}
+	return fmt.Sprintf("%s: %s %q already exists with ID %s", DUPLICATE_DATA, e.Kind, e.Name, e.ID)
+}
+
+func (e *ConflictError) Is(target error) bool {
+	return target == DUPLICATE_DATA
+}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// Audit tells when and by whom a document was created and last updated.
+// Authors are empty for anonymous callers.
+type Audit struct {
+	CreatedAt time.Time `bson:"created_at"`
+	UpdatedAt time.Time `bson:"updated_at"`
+	CreatedBy string    `bson:"created_by,omitempty"`
+	UpdatedBy string    `bson:"updated_by,omitempty"`
+}
@@ -1,11 +1,32 @@
 package models
 
 type Compare struct {
-	ID          string `bson:"_id"`
-	Name        string `bson:"name"`
-	Files       []File `bson:"files"`
-	BuildScript string `bson:"build_script"`
-	RunScript   string `bson:"run_script"`
-	RunName     string `bson:"run_name"`
-	Description string `bson:"description"`
+	ID          string       `bson:"_id"`
+	Name        string       `bson:"name"`
+	Files       []File       `bson:"files"`
+	BuildScript string       `bson:"build_script"`
+	RunScript   string       `bson:"run_script"`
+	RunName     string       `bson:"run_name"`
+	Description string       `bson:"description"`
+	GoldenCases []GoldenCase `bson:"golden_cases"`
+
+	PresetID      string            `bson:"preset_id,omitempty"`
+	PresetVersion int               `bson:"preset_version,omitempty"`
+	PresetParams  map[string]string `bson:"preset_params,omitempty"`
+
+	// Namespace is the course or organization owning the compare, empty for the
+	// global namespace shared with all of them.
+	Namespace string `bson:"namespace"`
+
+	Slug string `bson:"slug,omitempty"`
+	// Aliases are the previous slugs, still resolving to the compare.
+	Aliases []string `bson:"aliases,omitempty"`
+
+	Audit `bson:",inline"`
+}
+
+// IsReadOnly reports whether the compare scripts are generated from a preset
+// and therefore must not be edited by hand.
+func (c *Compare) IsReadOnly() bool {
+	return c.PresetID != ""
 }
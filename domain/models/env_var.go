@@ -0,0 +1,35 @@
+package models
+
+import pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+
+// EnvVar is an environment variable passed to a runner. Secret values are
+// stored sealed and only released to the grader.
+type EnvVar struct {
+	Name   string `bson:"name"`
+	Value  string `bson:"value"`
+	Secret bool   `bson:"secret"`
+}
+
+func PBEnvVarToEnvVar(pb []*pb.EnvVar) []EnvVar {
+	var env []EnvVar
+	for _, e := range pb {
+		env = append(env, EnvVar{
+			Name:   e.Name,
+			Value:  e.Value,
+			Secret: e.Secret,
+		})
+	}
+	return env
+}
+
+func EnvVarToPBEnvVar(env []EnvVar) []*pb.EnvVar {
+	var pbEnv []*pb.EnvVar
+	for _, e := range env {
+		pbEnv = append(pbEnv, &pb.EnvVar{
+			Name:   e.Name,
+			Value:  e.Value,
+			Secret: e.Secret,
+		})
+	}
+	return pbEnv
+}
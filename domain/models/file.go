@@ -1,10 +1,50 @@
 package models
 
-import pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"unicode/utf8"
 
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+)
+
+// MaxInlineFileSize is the largest file whose content is sent inline, bigger
+// files only carry their reference and have to be fetched with DownloadFile.
+const MaxInlineFileSize = 1 << 20
+
+// File is either stored inline in Content, as a reference to a blob in the
+// blob store, or as a link to a shared file. Data holds content that hasn't
+// been stored yet and is never persisted.
 type File struct {
-	Name    string `bson:"name"`
-	Content string `bson:"content"`
+	Name         string `bson:"name"`
+	Content      string `bson:"content,omitempty"`
+	SHA256       string `bson:"sha256,omitempty"`
+	Size         int64  `bson:"size,omitempty"`
+	Mode         uint32 `bson:"mode,omitempty"`
+	SharedFileID string `bson:"shared_file_id,omitempty"`
+	Data         []byte `bson:"-"`
+}
+
+type BlobReader interface {
+	Get(ctx context.Context, sha256 string) ([]byte, error)
+}
+
+// IsReference reports whether the content of the file lives in the blob store.
+func (f *File) IsReference() bool {
+	return f.Data == nil && f.Content == "" && f.SHA256 != ""
+}
+
+// Load returns the content of the file, reading it from the blob store when
+// the file is only a reference.
+func (f *File) Load(ctx context.Context, blobs BlobReader) ([]byte, error) {
+	if f.IsReference() {
+		return blobs.Get(ctx, f.SHA256)
+	}
+	if f.Data != nil {
+		return f.Data, nil
+	}
+	return []byte(f.Content), nil
 }
 
 func PBFileToFile(pb []*pb.File) []File {
@@ -13,18 +53,49 @@ func PBFileToFile(pb []*pb.File) []File {
 		files = append(files, File{
 			Name:    f.Name,
 			Content: f.Content,
+			SHA256:  f.Sha256,
+			Mode:    f.Mode,
+			Data:    f.Data,
+
+			SharedFileID: f.SharedFileId,
 		})
 	}
 	return files
 }
 
-func FileToPBFile(files []File) []*pb.File {
+// FileToPBFile resolves the content of every file from the blob store. Text
+// files are returned in content and binary ones in data, files larger than
+// MaxInlineFileSize are returned without content.
+func FileToPBFile(ctx context.Context, blobs BlobReader, files []File) ([]*pb.File, error) {
 	var pbFiles []*pb.File
 	for _, f := range files {
-		pbFiles = append(pbFiles, &pb.File{
-			Name:    f.Name,
-			Content: f.Content,
-		})
+		pbFile := &pb.File{
+			Name:   f.Name,
+			Mode:   f.Mode,
+			Size:   f.Size,
+			Sha256: f.SHA256,
+
+			SharedFileId: f.SharedFileID,
+		}
+		pbFiles = append(pbFiles, pbFile)
+
+		if f.IsReference() && f.Size > MaxInlineFileSize {
+			continue
+		}
+
+		data, err := f.Load(ctx, blobs)
+		if err != nil {
+			return nil, err
+		}
+
+		sum := sha256.Sum256(data)
+		pbFile.Sha256 = hex.EncodeToString(sum[:])
+		pbFile.Size = int64(len(data))
+		if utf8.Valid(data) {
+			pbFile.Content = string(data)
+		} else {
+			pbFile.Data = data
+		}
 	}
-	return pbFiles
+	return pbFiles, nil
 }
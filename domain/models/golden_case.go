@@ -0,0 +1,78 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+)
+
+type Verdict string
+
+const (
+	VerdictUnspecified Verdict = ""
+	VerdictAccepted    Verdict = "accepted"
+	VerdictWrongAnswer Verdict = "wrong_answer"
+)
+
+// GoldenCase is a known input to a compare script together with the verdict
+// the script is expected to produce for it.
+type GoldenCase struct {
+	Name            string  `bson:"name"`
+	ExpectedOutput  string  `bson:"expected_output"`
+	ActualOutput    string  `bson:"actual_output"`
+	ExpectedVerdict Verdict `bson:"expected_verdict"`
+}
+
+func VerdictToPBVerdict(v Verdict) pb.CompareVerdict {
+	switch v {
+	case VerdictAccepted:
+		return pb.CompareVerdict_COMPARE_VERDICT_ACCEPTED
+	case VerdictWrongAnswer:
+		return pb.CompareVerdict_COMPARE_VERDICT_WRONG_ANSWER
+	}
+	return pb.CompareVerdict_COMPARE_VERDICT_UNSPECIFIED
+}
+
+func PBVerdictToVerdict(v pb.CompareVerdict) Verdict {
+	switch v {
+	case pb.CompareVerdict_COMPARE_VERDICT_ACCEPTED:
+		return VerdictAccepted
+	case pb.CompareVerdict_COMPARE_VERDICT_WRONG_ANSWER:
+		return VerdictWrongAnswer
+	}
+	return VerdictUnspecified
+}
+
+// PBGoldenCaseToGoldenCase fails when a golden case doesn't tell the verdict
+// it expects.
+func PBGoldenCaseToGoldenCase(pb []*pb.GoldenCase) ([]GoldenCase, error) {
+	var cases []GoldenCase
+	for _, c := range pb {
+		verdict := PBVerdictToVerdict(c.ExpectedVerdict)
+		if verdict == VerdictUnspecified {
+			return nil, fmt.Errorf("%w: golden case %q has no expected verdict", cerrors.New(cerrors.INVALID_DATA), c.Name)
+		}
+
+		cases = append(cases, GoldenCase{
+			Name:            c.Name,
+			ExpectedOutput:  c.ExpectedOutput,
+			ActualOutput:    c.ActualOutput,
+			ExpectedVerdict: verdict,
+		})
+	}
+	return cases, nil
+}
+
+func GoldenCaseToPBGoldenCase(cases []GoldenCase) []*pb.GoldenCase {
+	var pbCases []*pb.GoldenCase
+	for _, c := range cases {
+		pbCases = append(pbCases, &pb.GoldenCase{
+			Name:            c.Name,
+			ExpectedOutput:  c.ExpectedOutput,
+			ActualOutput:    c.ActualOutput,
+			ExpectedVerdict: VerdictToPBVerdict(c.ExpectedVerdict),
+		})
+	}
+	return pbCases
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// IdempotencyKey remembers the result of a create request, so that a retry
+// with the same key gets it back instead of creating again.
+type IdempotencyKey struct {
+	Key string `bson:"_id"`
+	// RequestHash is the SHA-256 of the request the key was first used with.
+	RequestHash string `bson:"request_hash"`
+	// ResultID is the ID of the created resource, empty while the request is
+	// running.
+	ResultID  string    `bson:"result_id,omitempty"`
+	ExpiresAt time.Time `bson:"expires_at"`
+}
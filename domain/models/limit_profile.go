@@ -0,0 +1,95 @@
+package models
+
+import pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+
+type Limit struct {
+	CPUTime      float32 `bson:"cpu_time"`
+	CPUExtraTime float32 `bson:"cpu_extra_time"`
+	WallTime     float32 `bson:"wall_time"`
+	Memory       int32   `bson:"memory"`
+	Stack        int32   `bson:"stack"`
+	MaxOpenFiles int32   `bson:"max_open_files"`
+	MaxFileSize  float32 `bson:"max_file_size"`
+	NetworkAllow bool    `bson:"network_allow"`
+}
+
+type LimitProfile struct {
+	ID          string `bson:"_id"`
+	Name        string `bson:"name"`
+	Description string `bson:"description"`
+	Limit       Limit  `bson:"limit"`
+}
+
+// LimitMultipliers scale a limit profile for a runner, e.g. a JVM needing
+// twice the CPU time. No multiplier is negative, 0 and 1 leave the limit
+// unchanged.
+type LimitMultipliers struct {
+	CPUTime  float32 `bson:"cpu_time"`
+	WallTime float32 `bson:"wall_time"`
+	Memory   float32 `bson:"memory"`
+}
+
+func (m *LimitMultipliers) Apply(limit Limit) Limit {
+	if m == nil {
+		return limit
+	}
+	if m.CPUTime > 0 {
+		limit.CPUTime *= m.CPUTime
+		limit.CPUExtraTime *= m.CPUTime
+	}
+	if m.WallTime > 0 {
+		limit.WallTime *= m.WallTime
+	}
+	if m.Memory > 0 {
+		limit.Memory = int32(float32(limit.Memory) * m.Memory)
+	}
+	return limit
+}
+
+func PBLimitToLimit(l *pb.Limit) Limit {
+	return Limit{
+		CPUTime:      l.GetCpuTime(),
+		CPUExtraTime: l.GetCpuExtraTime(),
+		WallTime:     l.GetWallTime(),
+		Memory:       l.GetMemory(),
+		Stack:        l.GetStack(),
+		MaxOpenFiles: l.GetMaxOpenFiles(),
+		MaxFileSize:  l.GetMaxFileSize(),
+		NetworkAllow: l.GetNetworkAllow(),
+	}
+}
+
+func LimitToPBLimit(l Limit) *pb.Limit {
+	return &pb.Limit{
+		CpuTime:      l.CPUTime,
+		CpuExtraTime: l.CPUExtraTime,
+		WallTime:     l.WallTime,
+		Memory:       l.Memory,
+		Stack:        l.Stack,
+		MaxOpenFiles: l.MaxOpenFiles,
+		MaxFileSize:  l.MaxFileSize,
+		NetworkAllow: l.NetworkAllow,
+	}
+}
+
+func PBLimitMultipliersToLimitMultipliers(m *pb.LimitMultipliers) *LimitMultipliers {
+	if m == nil {
+		return nil
+	}
+	return &LimitMultipliers{
+		CPUTime:  m.GetCpuTime(),
+		WallTime: m.GetWallTime(),
+		Memory:   m.GetMemory(),
+	}
+}
+
+func LimitMultipliersToPBLimitMultipliers(m *LimitMultipliers) *pb.LimitMultipliers {
+	if m == nil {
+		return nil
+	}
+	return &pb.LimitMultipliers{
+		CpuTime:  m.CPUTime,
+		WallTime: m.WallTime,
+		Memory:   m.Memory,
+	}
+}
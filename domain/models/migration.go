@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+// AppliedMigration is a schema migration applied to the storage.
+type AppliedMigration struct {
+	Version     int       `bson:"_id"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"applied_at"`
+}
@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+type ChangeKind string
+
+const (
+	ChangeRunner  ChangeKind = "runner"
+	ChangeCompare ChangeKind = "compare"
+)
+
+type ChangeAction string
+
+const (
+	ChangeCreated ChangeAction = "created"
+	ChangeUpdated ChangeAction = "updated"
+	ChangeDeleted ChangeAction = "deleted"
+)
+
+// Change tells that the effective configuration of a runner or compare
+// changed. Namespace is the one of the runner or compare, changes are only
+// read from the namespaces it is visible from. A move is a deletion from the
+// previous namespace and a creation in the new one.
+type Change struct {
+	Kind      ChangeKind   `bson:"kind"`
+	Action    ChangeAction `bson:"action"`
+	ID        string       `bson:"id"`
+	Namespace string       `bson:"namespace"`
+}
+
+// Revision is a numbered set of changes made by one mutation. Numbers start
+// at 1 and increase by one with every revision.
+type Revision struct {
+	Number    int64     `bson:"_id"`
+	Changes   []Change  `bson:"changes"`
+	CreatedAt time.Time `bson:"created_at"`
+}
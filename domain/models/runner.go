@@ -1,10 +1,32 @@
 package models
 
 type Runner struct {
-	ID           string `bson:"_id"`
-	Name         string `bson:"name"`
-	Description  string `bson:"description"`
-	BuildScript  string `bson:"build_script"`
-	RunScript    string `bson:"run_script"`
-	InitialFiles []File `bson:"initial_files"`
+	ID           string            `bson:"_id"`
+	Name         string            `bson:"name"`
+	Description  string            `bson:"description"`
+	BuildScript  string            `bson:"build_script"`
+	RunScript    string            `bson:"run_script"`
+	InitialFiles []File            `bson:"initial_files"`
+	ParentID     string            `bson:"parent_id,omitempty"`
+	Variables    map[string]string `bson:"variables,omitempty"`
+	Env          []EnvVar          `bson:"env,omitempty"`
+
+	DefaultLimitProfileID string            `bson:"default_limit_profile_id,omitempty"`
+	LimitMultipliers      *LimitMultipliers `bson:"limit_multipliers,omitempty"`
+	Metadata              *RunnerMetadata   `bson:"metadata,omitempty"`
+
+	// StarterTemplate is the main file handed out for a new task, saved as
+	// EntryPoint.
+	StarterTemplate string `bson:"starter_template,omitempty"`
+	EntryPoint      string `bson:"entry_point,omitempty"`
+
+	// Namespace is the course or organization owning the runner, empty for the
+	// global namespace shared with all of them.
+	Namespace string `bson:"namespace"`
+
+	Slug string `bson:"slug,omitempty"`
+	// Aliases are the previous slugs, still resolving to the runner.
+	Aliases []string `bson:"aliases,omitempty"`
+
+	Audit `bson:",inline"`
 }
@@ -0,0 +1,44 @@
+package models
+
+import pb "github.com/CSKU-Lab/config-server/genproto/config/v1"
+
+// RunnerMetadata describes the language of a runner, so that pickers can
+// group runners and editors can highlight their files.
+type RunnerMetadata struct {
+	// Language is the language family, e.g. c++ for every C++ standard.
+	Language string `bson:"language,omitempty"`
+	Version  string `bson:"version,omitempty"`
+	// Extensions are the file extensions with their dot, e.g. .cpp.
+	Extensions []string `bson:"extensions,omitempty"`
+	EditorMode string   `bson:"editor_mode,omitempty"`
+	Icon       string   `bson:"icon,omitempty"`
+	Tags       []string `bson:"tags,omitempty"`
+}
+
+func PBRunnerMetadataToRunnerMetadata(m *pb.RunnerMetadata) *RunnerMetadata {
+	if m == nil {
+		return nil
+	}
+	return &RunnerMetadata{
+		Language:   m.GetLanguage(),
+		Version:    m.GetVersion(),
+		Extensions: m.GetExtensions(),
+		EditorMode: m.GetEditorMode(),
+		Icon:       m.GetIcon(),
+		Tags:       m.GetTags(),
+	}
+}
+
+func RunnerMetadataToPBRunnerMetadata(m *RunnerMetadata) *pb.RunnerMetadata {
+	if m == nil {
+		return nil
+	}
+	return &pb.RunnerMetadata{
+		Language:   m.Language,
+		Version:    m.Version,
+		Extensions: m.Extensions,
+		EditorMode: m.EditorMode,
+		Icon:       m.Icon,
+		Tags:       m.Tags,
+	}
+}
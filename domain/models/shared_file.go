@@ -0,0 +1,26 @@
+package models
+
+// SharedFile is a file maintained once and linked into any number of runners
+// and compares. File always references a blob.
+type SharedFile struct {
+	ID          string `bson:"_id"`
+	Name        string `bson:"name"`
+	Description string `bson:"description"`
+	File        File   `bson:"file"`
+}
+
+type FileUsageKind string
+
+const (
+	FileUsageRunner  FileUsageKind = "runner"
+	FileUsageCompare FileUsageKind = "compare"
+)
+
+// FileUsage is a runner or compare file with a given content or shared file.
+type FileUsage struct {
+	Kind         FileUsageKind
+	ID           string
+	Name         string
+	FileName     string
+	SharedFileID string
+}
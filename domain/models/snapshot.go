@@ -0,0 +1,80 @@
+package models
+
+import "time"
+
+const SnapshotVersion = 1
+
+// Snapshot is the whole configuration, independent of the storage backend.
+// File contents are kept aside as blobs keyed by their digest.
+type Snapshot struct {
+	Version   int       `bson:"version"`
+	CreatedAt time.Time `bson:"created_at"`
+
+	LimitProfiles []LimitProfile `bson:"limit_profiles"`
+	SharedFiles   []SharedFile   `bson:"shared_files"`
+	Runners       []Runner       `bson:"runners"`
+	Compares      []Compare      `bson:"compares"`
+}
+
+// Digests returns the digest of every blob referenced by the snapshot.
+func (s *Snapshot) Digests() []string {
+	seen := map[string]bool{}
+	var digests []string
+	add := func(files ...File) {
+		for _, f := range files {
+			if f.SHA256 != "" && !seen[f.SHA256] {
+				seen[f.SHA256] = true
+				digests = append(digests, f.SHA256)
+			}
+		}
+	}
+
+	for _, shared := range s.SharedFiles {
+		add(shared.File)
+	}
+	for _, runner := range s.Runners {
+		add(runner.InitialFiles...)
+	}
+	for _, compare := range s.Compares {
+		add(compare.Files...)
+	}
+	return digests
+}
+
+type RestoreMode string
+
+const (
+	// RestoreMerge creates or overwrites the documents of the snapshot and
+	// keeps everything else.
+	RestoreMerge RestoreMode = "merge"
+	// RestoreReplace also deletes every document missing from the snapshot.
+	RestoreReplace RestoreMode = "replace"
+)
+
+type SnapshotKind string
+
+const (
+	SnapshotLimitProfile SnapshotKind = "limit_profile"
+	SnapshotSharedFile   SnapshotKind = "shared_file"
+	SnapshotRunner       SnapshotKind = "runner"
+	SnapshotCompare      SnapshotKind = "compare"
+)
+
+type SnapshotAction string
+
+const (
+	SnapshotCreate    SnapshotAction = "create"
+	SnapshotUpdate    SnapshotAction = "update"
+	SnapshotDelete    SnapshotAction = "delete"
+	SnapshotUnchanged SnapshotAction = "unchanged"
+)
+
+// SnapshotChange is what a restore does to one document. SnapshotID is the
+// ID the document has in the snapshot, which differs from ID when remapped.
+type SnapshotChange struct {
+	Action     SnapshotAction
+	Kind       SnapshotKind
+	ID         string
+	Name       string
+	SnapshotID string
+}
@@ -0,0 +1,80 @@
+package namespaces
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+)
+
+// Global is the namespace of the runners and compares shared with every
+// course and organization.
+const Global = ""
+
+const maxLength = 63
+
+var pattern = regexp.MustCompile(`^[a-z0-9]+(?:[-._][a-z0-9]+)*$`)
+
+type namespaceKey struct{}
+
+// With scopes ctx to a namespace. Repositories then only read the documents
+// of the namespace and of the global one, and only change the former.
+// Contexts without a namespace see and change every document.
+func With(ctx context.Context, namespace string) context.Context {
+	return context.WithValue(ctx, namespaceKey{}, namespace)
+}
+
+// Unscoped returns ctx without its namespace, for the checks spanning every
+// namespace.
+func Unscoped(ctx context.Context) context.Context {
+	return context.WithValue(ctx, namespaceKey{}, nil)
+}
+
+// FromContext returns the namespace ctx is scoped to, false when it is not
+// scoped.
+func FromContext(ctx context.Context) (string, bool) {
+	namespace, ok := ctx.Value(namespaceKey{}).(string)
+	return namespace, ok
+}
+
+// Visible returns the namespaces whose documents can be read from namespace.
+func Visible(namespace string) []string {
+	if namespace == Global {
+		return []string{Global}
+	}
+	return []string{namespace, Global}
+}
+
+// IsVisible reports whether a document of namespace can be read from ctx.
+func IsVisible(ctx context.Context, namespace string) bool {
+	scope, ok := FromContext(ctx)
+	return !ok || namespace == scope || namespace == Global
+}
+
+// IsOwned reports whether a document of namespace can be changed from ctx.
+func IsOwned(ctx context.Context, namespace string) bool {
+	scope, ok := FromContext(ctx)
+	return !ok || namespace == scope
+}
+
+// Validate checks that namespace is lowercase letters and digits separated by
+// single dashes, dots or underscores, e.g. cs101-2025. The global namespace
+// is valid.
+func Validate(namespace string) error {
+	if namespace == Global {
+		return nil
+	}
+	if len(namespace) > maxLength || !pattern.MatchString(namespace) {
+		return fmt.Errorf("%w: invalid namespace %q, expected lowercase letters and digits separated by -, . or _", cerrors.New(cerrors.INVALID_DATA), namespace)
+	}
+	return nil
+}
+
+// Name returns the name of namespace in messages.
+func Name(namespace string) string {
+	if namespace == Global {
+		return "global"
+	}
+	return namespace
+}
@@ -0,0 +1,79 @@
+#!/usr/bin/env python3
+"""Built-in compare checker generated by the config server.
+
+Usage: compare.py [options] EXPECTED_FILE ACTUAL_FILE
+
+Exits with 0 when the outputs match and 1 when they don't.
+"""
+import argparse
+import math
+import sys
+
+
+def read_lines(path, ignore_case):
+    with open(path, encoding="utf-8", errors="replace") as f:
+        text = f.read()
+    if ignore_case:
+        text = text.lower()
+    lines = text.splitlines()
+    while lines and lines[-1].strip() == "":
+        lines.pop()
+    return lines
+
+
+def floats_match(expected, actual, epsilon):
+    try:
+        e, a = float(expected), float(actual)
+    except ValueError:
+        return expected == actual
+    if math.isnan(e) or math.isnan(a):
+        return math.isnan(e) and math.isnan(a)
+    return abs(e - a) <= epsilon or abs(e - a) <= epsilon * abs(e)
+
+
+def tokens_match(expected, actual, epsilon=None):
+    if len(expected) != len(actual):
+        return False
+    for e, a in zip(expected, actual):
+        if epsilon is None:
+            if e != a:
+                return False
+        elif not floats_match(e, a, epsilon):
+            return False
+    return True
+
+
+def main():
+    parser = argparse.ArgumentParser()
+    parser.add_argument("--mode", choices=["exact", "whitespace", "token", "float"], default="exact")
+    parser.add_argument("--epsilon", type=float, default=1e-6)
+    parser.add_argument("--ignore-case", action="store_true")
+    parser.add_argument("--ignore-line-order", action="store_true")
+    parser.add_argument("expected")
+    parser.add_argument("actual")
+    args = parser.parse_args()
+
+    expected = read_lines(args.expected, args.ignore_case)
+    actual = read_lines(args.actual, args.ignore_case)
+
+    if args.mode == "exact":
+        if args.ignore_line_order:
+            expected, actual = sorted(expected), sorted(actual)
+        return 0 if expected == actual else 1
+
+    expected = [line.split() for line in expected]
+    actual = [line.split() for line in actual]
+    if args.ignore_line_order:
+        expected, actual = sorted(expected), sorted(actual)
+
+    if args.mode == "whitespace":
+        return 0 if expected == actual else 1
+
+    epsilon = args.epsilon if args.mode == "float" else None
+    expected = [tok for line in expected for tok in line]
+    actual = [tok for line in actual for tok in line]
+    return 0 if tokens_match(expected, actual, epsilon) else 1
+
+
+if __name__ == "__main__":
+    sys.exit(main())
@@ -0,0 +1,180 @@
+package presets
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/CSKU-Lab/config-server/domain/models"
+)
+
+//go:embed compare.py
+var checkerScript string
+
+const checkerName = "compare.py"
+
+var (
+	ErrUnknownPreset = errors.New("unknown compare preset")
+	ErrInvalidParam  = errors.New("invalid compare preset parameter")
+	// ErrNotPresetBacked is returned when upgrading a compare that isn't
+	// created from a preset.
+	ErrNotPresetBacked = errors.New("compare is not created from a preset")
+)
+
+type ParamType string
+
+const (
+	ParamBool  ParamType = "bool"
+	ParamFloat ParamType = "float"
+)
+
+type Param struct {
+	Name        string
+	Description string
+	Type        ParamType
+	Default     string
+}
+
+// Preset is a built-in checker that the config server materializes into a
+// regular compare, so graders don't need to know presets exist.
+type Preset struct {
+	ID          string
+	Name        string
+	Description string
+	// Version is bumped whenever the generated scripts change, so compares
+	// created from an older version can be upgraded in place.
+	Version int
+	Params  []Param
+	mode    string
+}
+
+var commonParams = []Param{
+	{Name: "case_sensitive", Description: "Compare letters case-sensitively", Type: ParamBool, Default: "true"},
+	{Name: "ignore_line_order", Description: "Treat the output as an unordered set of lines", Type: ParamBool, Default: "false"},
+}
+
+var presets = []Preset{
+	{
+		ID:          "exact",
+		Name:        "Exact match",
+		Description: "Outputs must match line by line, ignoring trailing blank lines",
+		Version:     1,
+		Params:      commonParams,
+		mode:        "exact",
+	},
+	{
+		ID:          "whitespace",
+		Name:        "Whitespace insensitive",
+		Description: "Lines must match after collapsing runs of whitespace",
+		Version:     1,
+		Params:      commonParams,
+		mode:        "whitespace",
+	},
+	{
+		ID:          "token",
+		Name:        "Token match",
+		Description: "Outputs must contain the same whitespace-separated tokens, regardless of line breaks",
+		Version:     1,
+		Params:      commonParams,
+		mode:        "token",
+	},
+	{
+		ID:          "float",
+		Name:        "Float tolerance",
+		Description: "Tokens must match, numeric tokens within an absolute or relative epsilon",
+		Version:     1,
+		Params: append(slices.Clone(commonParams), Param{
+			Name: "epsilon", Description: "Allowed absolute or relative error", Type: ParamFloat, Default: "1e-6",
+		}),
+		mode: "float",
+	},
+}
+
+func List() []Preset {
+	return slices.Clone(presets)
+}
+
+func Get(ID string) (*Preset, error) {
+	for i := range presets {
+		if presets[i].ID == ID {
+			return &presets[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownPreset, ID)
+}
+
+// Resolve validates params against the preset and fills in defaults for the
+// ones that were left out.
+func (p *Preset) Resolve(params map[string]string) (map[string]string, error) {
+	resolved := make(map[string]string, len(p.Params))
+	for name := range params {
+		if !slices.ContainsFunc(p.Params, func(param Param) bool { return param.Name == name }) {
+			return nil, fmt.Errorf("%w: %q is not a parameter of %q", ErrInvalidParam, name, p.ID)
+		}
+	}
+
+	for _, param := range p.Params {
+		value, ok := params[param.Name]
+		if !ok {
+			value = param.Default
+		}
+
+		var err error
+		switch param.Type {
+		case ParamBool:
+			_, err = strconv.ParseBool(value)
+		case ParamFloat:
+			var f float64
+			f, err = strconv.ParseFloat(value, 64)
+			switch {
+			case err != nil:
+			case math.IsNaN(f) || math.IsInf(f, 0):
+				err = errors.New("must be a finite number")
+			case f < 0:
+				err = errors.New("must not be negative")
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s=%q: %v", ErrInvalidParam, param.Name, value, err)
+		}
+
+		resolved[param.Name] = value
+	}
+
+	return resolved, nil
+}
+
+// Materialize generates the compare scripts and files for the given params.
+func (p *Preset) Materialize(params map[string]string) (*models.Compare, error) {
+	resolved, err := p.Resolve(params)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{"--mode=" + p.mode}
+	if caseSensitive, _ := strconv.ParseBool(resolved["case_sensitive"]); !caseSensitive {
+		args = append(args, "--ignore-case")
+	}
+	if ignoreOrder, _ := strconv.ParseBool(resolved["ignore_line_order"]); ignoreOrder {
+		args = append(args, "--ignore-line-order")
+	}
+	if epsilon, ok := resolved["epsilon"]; ok {
+		args = append(args, "--epsilon="+epsilon)
+	}
+
+	return &models.Compare{
+		Files: []models.File{
+			{Name: checkerName, Content: checkerScript},
+		},
+		BuildScript:   "#!/bin/bash\n",
+		RunScript:     fmt.Sprintf("#!/bin/bash\n\npython3 %s %s \"$@\"\n", checkerName, strings.Join(args, " ")),
+		RunName:       checkerName,
+		PresetID:      p.ID,
+		PresetVersion: p.Version,
+		PresetParams:  resolved,
+	}, nil
+}
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+	"io"
+)
+
+// BlobRepository stores file contents addressed by their SHA-256 digest.
+type BlobRepository interface {
+	// Put stores data under the given digest, doing nothing if it's already
+	// stored.
+	Put(ctx context.Context, sha256 string, data []byte) error
+	Open(ctx context.Context, sha256 string) (io.ReadCloser, int64, error)
+}
@@ -7,12 +7,24 @@ import (
 	"github.com/CSKU-Lab/config-server/domain/requests"
 )
 
+// CompareRepository stores the compares. With a context scoped to a
+// namespace, reads only see the compares of the namespace and of the global
+// one, and writes only change the former.
 type CompareRepository interface {
 	Create(ctx context.Context, ID string, body *requests.CreateCompare) error
 	GetAll(ctx context.Context) ([]models.Compare, error)
 	GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.Compare, error)
-	Count(ctx context.Context) (int, error)
+	// Count returns the number of compares matching the filters of req,
+	// ignoring its page.
+	Count(ctx context.Context, req *requests.GetPagination) (int, error)
 	GetByID(ctx context.Context, ID string) (*models.Compare, error)
+	// GetBySlug returns the compare having the slug, or having had it as one of
+	// its aliases.
+	GetBySlug(ctx context.Context, slug string) (*models.Compare, error)
+	// GetByFile returns the compares of every namespace having a file with the
+	// given content or linked to the given shared file. Empty values match
+	// nothing.
+	GetByFile(ctx context.Context, sha256 string, sharedFileID string) ([]models.Compare, error)
 	UpdateByID(ctx context.Context, ID string, body *requests.UpdateCompare) error
 	DeleteByID(ctx context.Context, ID string) error
 }
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/CSKU-Lab/config-server/domain/models"
+)
+
+type IdempotencyRepository interface {
+	// Reserve stores the key, failing with a duplicate data error when it
+	// exists and has not expired.
+	Reserve(ctx context.Context, key *models.IdempotencyKey) error
+	// Get returns the key unless it expired.
+	Get(ctx context.Context, key string) (*models.IdempotencyKey, error)
+	Complete(ctx context.Context, key string, resultID string, expiresAt time.Time) error
+	Delete(ctx context.Context, key string) error
+}
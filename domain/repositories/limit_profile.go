@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+)
+
+type LimitProfileRepository interface {
+	Create(ctx context.Context, ID string, body *requests.CreateLimitProfile) error
+	GetAll(ctx context.Context) ([]models.LimitProfile, error)
+	GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.LimitProfile, error)
+	// Count returns the number of limit profiles matching the search of req,
+	// ignoring its page.
+	Count(ctx context.Context, req *requests.GetPagination) (int, error)
+	GetByID(ctx context.Context, ID string) (*models.LimitProfile, error)
+	UpdateByID(ctx context.Context, ID string, body *requests.UpdateLimitProfile) error
+	DeleteByID(ctx context.Context, ID string) error
+}
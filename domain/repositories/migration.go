@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/CSKU-Lab/config-server/domain/models"
+)
+
+type MigrationRepository interface {
+	// Applied returns the applied migrations, lowest version first.
+	Applied(ctx context.Context) ([]models.AppliedMigration, error)
+	Add(ctx context.Context, migration *models.AppliedMigration) error
+	Remove(ctx context.Context, version int) error
+	// Lock takes or extends the migration lock for owner until expiresAt,
+	// failing with a data in use error while another owner holds it.
+	Lock(ctx context.Context, owner string, expiresAt time.Time) error
+	Unlock(ctx context.Context, owner string) error
+}
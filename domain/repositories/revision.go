@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/CSKU-Lab/config-server/domain/models"
+)
+
+type RevisionRepository interface {
+	// Create stores the revision, failing with a duplicate data error when its
+	// number is already taken.
+	Create(ctx context.Context, revision *models.Revision) error
+	// Latest returns the number of the last revision, or 0 without any.
+	Latest(ctx context.Context) (int64, error)
+	// GetSince returns up to limit revisions numbered after since, oldest
+	// first.
+	GetSince(ctx context.Context, since int64, limit int) ([]models.Revision, error)
+	// Wait returns once a revision numbered after since may exist, or when ctx
+	// is done.
+	Wait(ctx context.Context, since int64) error
+}
@@ -7,12 +7,26 @@ import (
 	"github.com/CSKU-Lab/config-server/domain/requests"
 )
 
+// RunnerRepository stores the runners. With a context scoped to a namespace,
+// reads only see the runners of the namespace and of the global one, and
+// writes only change the former.
 type RunnerRepository interface {
 	Create(ctx context.Context, ID string, body *requests.CreateRunner) error
 	GetAll(ctx context.Context) ([]models.Runner, error)
 	GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.Runner, error)
-	Count(ctx context.Context) (int, error)
+	// Count returns the number of runners matching the filters of req,
+	// ignoring its page.
+	Count(ctx context.Context, req *requests.GetPagination) (int, error)
 	GetByID(ctx context.Context, ID string) (*models.Runner, error)
+	// GetBySlug returns the runner having the slug, or having had it as one of
+	// its aliases.
+	GetBySlug(ctx context.Context, slug string) (*models.Runner, error)
+	// GetByParentID returns the children of a runner in every namespace.
+	GetByParentID(ctx context.Context, parentID string) ([]models.Runner, error)
+	// GetByFile returns the runners of every namespace having a file with the
+	// given content or linked to the given shared file. Empty values match
+	// nothing.
+	GetByFile(ctx context.Context, sha256 string, sharedFileID string) ([]models.Runner, error)
 	UpdateByID(ctx context.Context, ID string, body *requests.UpdateRunner) error
 	DeleteByID(ctx context.Context, ID string) error
 }
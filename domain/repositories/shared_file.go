@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+)
+
+type SharedFileRepository interface {
+	Create(ctx context.Context, ID string, body *requests.CreateSharedFile) error
+	GetAll(ctx context.Context) ([]models.SharedFile, error)
+	GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.SharedFile, error)
+	Count(ctx context.Context) (int, error)
+	GetByID(ctx context.Context, ID string) (*models.SharedFile, error)
+	UpdateByID(ctx context.Context, ID string, body *requests.UpdateSharedFile) error
+	DeleteByID(ctx context.Context, ID string) error
+}
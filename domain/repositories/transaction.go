@@ -0,0 +1,10 @@
+package repositories
+
+import "context"
+
+type Transactor interface {
+	// WithTransaction runs fn in a transaction, committed when fn returns nil
+	// and aborted otherwise. Repositories called with the context given to fn
+	// take part in the transaction. fn may run more than once.
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -1,21 +1,56 @@
 package requests
 
-import "github.com/CSKU-Lab/config-server/domain/models"
+import (
+	"time"
+
+	"github.com/CSKU-Lab/config-server/domain/models"
+)
 
 type CreateCompare struct {
 	Name        string
+	Slug        string
+	Aliases     []string
 	Files       []models.File
 	BuildScript string
 	RunScript   string
 	RunName     string
 	Description string
+	GoldenCases []models.GoldenCase
+
+	PresetID      string
+	PresetVersion int
+	PresetParams  map[string]string
+
+	Namespace string
+	Audit     models.Audit
+}
+
+type CreateCompareFromPreset struct {
+	PresetID    string
+	Name        string
+	Slug        string
+	Description string
+	Params      map[string]string
 }
 
 type UpdateCompare struct {
-	Name        *string       `bson:"name"`
-	Files       []models.File `bson:"files"`
-	BuildScript *string       `bson:"build_script"`
-	RunScript   *string       `bson:"run_script"`
-	RunName     *string       `bson:"run_name"`
-	Description *string       `bson:"description"`
+	Name        *string             `bson:"name"`
+	Files       []models.File       `bson:"files"`
+	BuildScript *string             `bson:"build_script"`
+	RunScript   *string             `bson:"run_script"`
+	RunName     *string             `bson:"run_name"`
+	Description *string             `bson:"description"`
+	GoldenCases []models.GoldenCase `bson:"golden_cases"`
+
+	PresetVersion *int              `bson:"preset_version"`
+	PresetParams  map[string]string `bson:"preset_params"`
+
+	// Slug and Aliases are only changed by renaming the slug.
+	Slug    *string  `bson:"slug"`
+	Aliases []string `bson:"aliases"`
+	// Namespace is only changed by moving the compare.
+	Namespace *string `bson:"namespace"`
+
+	UpdatedAt *time.Time `bson:"updated_at"`
+	UpdatedBy *string    `bson:"updated_by"`
 }
@@ -0,0 +1,15 @@
+package requests
+
+import "github.com/CSKU-Lab/config-server/domain/models"
+
+type CreateLimitProfile struct {
+	Name        string
+	Description string
+	Limit       models.Limit
+}
+
+type UpdateLimitProfile struct {
+	Name        *string       `bson:"name"`
+	Description *string       `bson:"description"`
+	Limit       *models.Limit `bson:"limit"`
+}
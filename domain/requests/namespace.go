@@ -0,0 +1,10 @@
+package requests
+
+// CopyToNamespace describes the copy of a runner or compare in another
+// namespace. Name defaults to the name of the original, the copy has no slug
+// unless one is given.
+type CopyToNamespace struct {
+	Namespace string
+	Name      string
+	Slug      string
+}
@@ -1,8 +1,32 @@
 package requests
 
+import "time"
+
+const (
+	SortByName      = "name"
+	SortByCreatedAt = "created_at"
+	SortByUpdatedAt = "updated_at"
+)
+
 type GetPagination struct {
 	Page      int
 	PageSize  int
 	SortOrder string
 	Search    string
+	// SortBy is one of the SortBy constants, SortByName when empty.
+	SortBy string
+
+	// The filters below only apply to runners and compares, zero values
+	// match everything.
+	CreatedBy     string
+	UpdatedBy     string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	UpdatedAfter  time.Time
+	UpdatedBefore time.Time
+
+	// Runners only, matching their metadata. A runner matches every tag.
+	Language  string
+	Tags      []string
+	Extension string
 }
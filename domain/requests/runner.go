@@ -1,16 +1,61 @@
 package requests
 
-import "github.com/CSKU-Lab/config-server/domain/models"
+import (
+	"time"
+
+	"github.com/CSKU-Lab/config-server/domain/models"
+)
 
 type CreateRunner struct {
 	Name        string
+	Slug        string
+	Aliases     []string
 	Description string
+	ParentID    string
+	Variables   map[string]string
+	Env         []models.EnvVar
+
+	DefaultLimitProfileID string
+	LimitMultipliers      *models.LimitMultipliers
+	Metadata              *models.RunnerMetadata
+
+	StarterTemplate string
+	EntryPoint      string
+
+	Namespace string
+	Audit     models.Audit
 }
 
 type UpdateRunner struct {
-	Name         *string       `bson:"name"`
-	Description  *string       `bson:"description"`
-	BuildScript  *string       `bson:"build_script"`
-	RunScript    *string       `bson:"run_script"`
-	InitialFiles []models.File `bson:"initial_files"`
+	Name         *string           `bson:"name"`
+	Description  *string           `bson:"description"`
+	BuildScript  *string           `bson:"build_script"`
+	RunScript    *string           `bson:"run_script"`
+	InitialFiles []models.File     `bson:"initial_files"`
+	ParentID     *string           `bson:"parent_id"`
+	Variables    map[string]string `bson:"variables"`
+	Env          []models.EnvVar   `bson:"env"`
+
+	DefaultLimitProfileID *string                  `bson:"default_limit_profile_id"`
+	LimitMultipliers      *models.LimitMultipliers `bson:"limit_multipliers"`
+	Metadata              *models.RunnerMetadata   `bson:"metadata"`
+
+	StarterTemplate *string `bson:"starter_template"`
+	EntryPoint      *string `bson:"entry_point"`
+
+	// Slug and Aliases are only changed by renaming the slug.
+	Slug    *string  `bson:"slug"`
+	Aliases []string `bson:"aliases"`
+	// Namespace is only changed by moving the runner.
+	Namespace *string `bson:"namespace"`
+
+	UpdatedAt *time.Time `bson:"updated_at"`
+	UpdatedBy *string    `bson:"updated_by"`
+}
+
+// RenderStarter describes the task a starter template is rendered for.
+type RenderStarter struct {
+	TaskName string
+	IOStyle  string
+	Params   map[string]string
 }
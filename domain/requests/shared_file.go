@@ -0,0 +1,18 @@
+package requests
+
+import "github.com/CSKU-Lab/config-server/domain/models"
+
+type CreateSharedFile struct {
+	Name        string
+	Description string
+	File        models.File
+	// LinkExisting replaces every runner and compare file with the same
+	// content by a link to the new shared file.
+	LinkExisting bool
+}
+
+type UpdateSharedFile struct {
+	Name        *string      `bson:"name"`
+	Description *string      `bson:"description"`
+	File        *models.File `bson:"file"`
+}
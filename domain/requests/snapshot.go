@@ -0,0 +1,13 @@
+package requests
+
+import "github.com/CSKU-Lab/config-server/domain/models"
+
+type ImportSnapshot struct {
+	Mode   models.RestoreMode
+	DryRun bool
+	// RemapIDs gives every document the ID of the stored document with the
+	// same name, or a new one, instead of keeping the ID of the snapshot.
+	RemapIDs bool
+	// Blobs holds the file contents of the snapshot keyed by their digest.
+	Blobs map[string][]byte
+}
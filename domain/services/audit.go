@@ -0,0 +1,33 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/CSKU-Lab/config-server/domain/models"
+)
+
+type authorKey struct{}
+
+// WithAuthor returns a context in which the runners and compares created or
+// updated are attributed to author.
+func WithAuthor(ctx context.Context, author string) context.Context {
+	return context.WithValue(ctx, authorKey{}, author)
+}
+
+// stamp returns the time and author of a change made within ctx. The time is
+// truncated to what the storage keeps.
+func stamp(ctx context.Context) (time.Time, string) {
+	author, _ := ctx.Value(authorKey{}).(string)
+	return time.Now().UTC().Truncate(time.Millisecond), author
+}
+
+func newAudit(ctx context.Context) models.Audit {
+	now, author := stamp(ctx)
+	return models.Audit{
+		CreatedAt: now,
+		UpdatedAt: now,
+		CreatedBy: author,
+		UpdatedBy: author,
+	}
+}
@@ -2,15 +2,29 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"maps"
 
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
 	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/namespaces"
+	"github.com/CSKU-Lab/config-server/domain/presets"
 	"github.com/CSKU-Lab/config-server/domain/repositories"
 	"github.com/CSKU-Lab/config-server/domain/requests"
 	"github.com/google/uuid"
 )
 
 type compareService struct {
-	repo repositories.CompareRepository
+	repo        repositories.CompareRepository
+	files       FileService
+	revisions   RevisionService
+	goldenCases GoldenCaseVerifier
+}
+
+// GoldenCaseVerifier runs the golden cases of a compare and fails when any of
+// them doesn't produce its expected verdict.
+type GoldenCaseVerifier interface {
+	Verify(ctx context.Context, compare *models.Compare) error
 }
 
 type CompareService interface {
@@ -18,13 +32,30 @@ type CompareService interface {
 	GetAll(ctx context.Context) ([]models.Compare, error)
 	GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.Compare, int, error)
 	GetByID(ctx context.Context, ID string) (*models.Compare, error)
+	// ResolveID returns the ID of the compare referenced by its ID, slug or
+	// alias.
+	ResolveID(ctx context.Context, ref string) (string, error)
 	UpdateByID(ctx context.Context, ID string, body *requests.UpdateCompare) error
 	DeleteByID(ctx context.Context, ID string) error
+	CreateFromPreset(ctx context.Context, body *requests.CreateCompareFromPreset) (string, error)
+	UpgradePreset(ctx context.Context, ID string, params map[string]string) error
+	// RenameSlug gives the compare a new slug, keeping the previous one as an
+	// alias.
+	RenameSlug(ctx context.Context, ID string, slug string) error
+	// MoveToNamespace moves the compare to another namespace, the global one
+	// to share it with every namespace.
+	MoveToNamespace(ctx context.Context, ID string, namespace string) error
+	// CopyToNamespace copies the compare into another namespace and returns
+	// the ID of the copy.
+	CopyToNamespace(ctx context.Context, ID string, body *requests.CopyToNamespace) (string, error)
 }
 
-func NewCompareService(repo repositories.CompareRepository) CompareService {
+func NewCompareService(repo repositories.CompareRepository, files FileService, revisions RevisionService, goldenCases GoldenCaseVerifier) CompareService {
 	return &compareService{
-		repo: repo,
+		repo:        repo,
+		files:       files,
+		revisions:   revisions,
+		goldenCases: goldenCases,
 	}
 }
 
@@ -34,11 +65,18 @@ func (c *compareService) GetPagination(ctx context.Context, req *requests.GetPag
 		return nil, 0, err
 	}
 
-	count, err := c.repo.Count(ctx)
+	count, err := c.repo.Count(ctx, req)
 	if err != nil {
 		return nil, 0, err
 	}
 
+	for i := range pagination {
+		pagination[i].Files, err = c.files.Resolve(ctx, pagination[i].Files)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
 	return pagination, count, nil
 }
 
@@ -47,26 +85,266 @@ func (c *compareService) Create(ctx context.Context, body *requests.CreateCompar
 	if err != nil {
 		return "", err
 	}
+
+	if namespace, ok := namespaces.FromContext(ctx); ok {
+		body.Namespace = namespace
+	}
+	err = namespaces.Validate(body.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	if body.Slug != "" {
+		err = c.checkSlug(ctx, id.String(), body.Slug)
+		if err != nil {
+			return "", err
+		}
+	}
+	body.Aliases = nil
+
+	err = c.goldenCases.Verify(ctx, &models.Compare{
+		Files:       body.Files,
+		BuildScript: body.BuildScript,
+		RunScript:   body.RunScript,
+		RunName:     body.RunName,
+		GoldenCases: body.GoldenCases,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	body.Files, err = c.files.Store(ctx, body.Files)
+	if err != nil {
+		return "", err
+	}
+
+	body.Audit = newAudit(ctx)
 	err = c.repo.Create(ctx, id.String(), body)
 	if err != nil {
 		return "", err
 	}
 
+	_, err = c.revisions.Record(ctx, models.Change{Kind: models.ChangeCompare, Action: models.ChangeCreated, ID: id.String(), Namespace: body.Namespace})
+	if err != nil {
+		return "", err
+	}
+
 	return id.String(), nil
 }
 
 func (c *compareService) GetAll(ctx context.Context) ([]models.Compare, error) {
-	return c.repo.GetAll(ctx)
+	compares, err := c.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range compares {
+		compares[i].Files, err = c.files.Resolve(ctx, compares[i].Files)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return compares, nil
 }
 
 func (c *compareService) GetByID(ctx context.Context, ID string) (*models.Compare, error) {
-	return c.repo.GetByID(ctx, ID)
+	compare, err := c.repo.GetByID(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	compare.Files, err = c.files.Resolve(ctx, compare.Files)
+	if err != nil {
+		return nil, err
+	}
+
+	return compare, nil
+}
+
+func (c *compareService) ResolveID(ctx context.Context, ref string) (string, error) {
+	if isID(ref) {
+		return ref, nil
+	}
+
+	compare, err := c.repo.GetBySlug(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+	return compare.ID, nil
+}
+
+func (c *compareService) RenameSlug(ctx context.Context, ID string, slug string) error {
+	compare, err := c.repo.GetByID(ctx, ID)
+	if err != nil {
+		return err
+	}
+	if compare.Slug == slug {
+		return nil
+	}
+
+	err = c.checkSlug(ctx, ID, slug)
+	if err != nil {
+		return err
+	}
+
+	return c.UpdateByID(ctx, ID, &requests.UpdateCompare{
+		Slug:    &slug,
+		Aliases: renamedAliases(compare.Aliases, compare.Slug, slug),
+	})
+}
+
+func (c *compareService) checkSlug(ctx context.Context, ID string, slug string) error {
+	err := validateSlug(slug)
+	if err != nil {
+		return err
+	}
+
+	return checkSlugFree(ctx, "compare", ID, slug, func(ctx context.Context, slug string) (string, string, error) {
+		compare, err := c.repo.GetBySlug(ctx, slug)
+		if err != nil {
+			return "", "", err
+		}
+		return compare.ID, compare.Namespace, nil
+	})
 }
 
 func (c *compareService) UpdateByID(ctx context.Context, ID string, body *requests.UpdateCompare) error {
-	return c.repo.UpdateByID(ctx, ID, body)
+	return c.update(ctx, ID, body, false)
+}
+
+// update changes the compare. The scripts and files of a preset compare are
+// generated, so only an upgrade of its preset may change them.
+func (c *compareService) update(ctx context.Context, ID string, body *requests.UpdateCompare, upgrade bool) error {
+	compare, err := c.getOwned(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	if compare.IsReadOnly() && !upgrade && (body.Files != nil || body.BuildScript != nil || body.RunScript != nil || body.RunName != nil) {
+		return fmt.Errorf("%w: compare %s is generated from preset %q, use UpgradeComparePreset instead", cerrors.New(cerrors.READ_ONLY), ID, compare.PresetID)
+	}
+
+	// The golden cases only need to run again when the compare behaves
+	// differently or expects something else.
+	if body.Files != nil || body.BuildScript != nil || body.RunScript != nil || body.RunName != nil || body.GoldenCases != nil {
+		err = c.goldenCases.Verify(ctx, applyCompareUpdate(compare, body))
+		if err != nil {
+			return err
+		}
+	}
+
+	body.Files, err = c.files.Store(ctx, body.Files)
+	if err != nil {
+		return err
+	}
+
+	now, author := stamp(ctx)
+	body.UpdatedAt = &now
+	body.UpdatedBy = &author
+
+	err = c.repo.UpdateByID(ctx, ID, body)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.revisions.Record(ctx, models.Change{Kind: models.ChangeCompare, Action: models.ChangeUpdated, ID: ID, Namespace: compare.Namespace})
+	return err
 }
 
 func (c *compareService) DeleteByID(ctx context.Context, ID string) error {
-	return c.repo.DeleteByID(ctx, ID)
+	compare, err := c.getOwned(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	err = c.repo.DeleteByID(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.revisions.Record(ctx, models.Change{Kind: models.ChangeCompare, Action: models.ChangeDeleted, ID: ID, Namespace: compare.Namespace})
+	return err
+}
+
+func (c *compareService) CreateFromPreset(ctx context.Context, body *requests.CreateCompareFromPreset) (string, error) {
+	preset, err := presets.Get(body.PresetID)
+	if err != nil {
+		return "", err
+	}
+
+	compare, err := preset.Materialize(body.Params)
+	if err != nil {
+		return "", err
+	}
+
+	return c.Create(ctx, &requests.CreateCompare{
+		Name:          body.Name,
+		Slug:          body.Slug,
+		Description:   body.Description,
+		Files:         compare.Files,
+		BuildScript:   compare.BuildScript,
+		RunScript:     compare.RunScript,
+		RunName:       compare.RunName,
+		PresetID:      compare.PresetID,
+		PresetVersion: compare.PresetVersion,
+		PresetParams:  compare.PresetParams,
+	})
+}
+
+// UpgradePreset regenerates a preset-backed compare from the latest version of
+// its preset. The given params are applied over the existing ones.
+func (c *compareService) UpgradePreset(ctx context.Context, ID string, params map[string]string) error {
+	existing, err := c.repo.GetByID(ctx, ID)
+	if err != nil {
+		return err
+	}
+	if !existing.IsReadOnly() {
+		return fmt.Errorf("%w: compare %s", presets.ErrNotPresetBacked, ID)
+	}
+
+	preset, err := presets.Get(existing.PresetID)
+	if err != nil {
+		return err
+	}
+
+	merged := maps.Clone(existing.PresetParams)
+	if merged == nil {
+		merged = map[string]string{}
+	}
+	maps.Copy(merged, params)
+
+	compare, err := preset.Materialize(merged)
+	if err != nil {
+		return err
+	}
+
+	return c.update(ctx, ID, &requests.UpdateCompare{
+		Files:         compare.Files,
+		BuildScript:   &compare.BuildScript,
+		RunScript:     &compare.RunScript,
+		RunName:       &compare.RunName,
+		PresetVersion: &compare.PresetVersion,
+		PresetParams:  compare.PresetParams,
+	}, true)
+}
+
+func applyCompareUpdate(compare *models.Compare, body *requests.UpdateCompare) *models.Compare {
+	updated := *compare
+	if body.Files != nil {
+		updated.Files = body.Files
+	}
+	if body.BuildScript != nil {
+		updated.BuildScript = *body.BuildScript
+	}
+	if body.RunScript != nil {
+		updated.RunScript = *body.RunScript
+	}
+	if body.RunName != nil {
+		updated.RunName = *body.RunName
+	}
+	if body.GoldenCases != nil {
+		updated.GoldenCases = body.GoldenCases
+	}
+	return &updated
 }
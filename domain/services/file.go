@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+)
+
+type fileService struct {
+	blobs       repositories.BlobRepository
+	sharedFiles repositories.SharedFileRepository
+}
+
+type FileService interface {
+	// Store moves the content of the files into the blob store and returns
+	// them as references.
+	Store(ctx context.Context, files []models.File) ([]models.File, error)
+	// Resolve fills in the current content reference of files linked to a
+	// shared file.
+	Resolve(ctx context.Context, files []models.File) ([]models.File, error)
+	Get(ctx context.Context, sha256 string) ([]byte, error)
+	Open(ctx context.Context, sha256 string) (io.ReadCloser, int64, error)
+}
+
+func NewFileService(blobs repositories.BlobRepository, sharedFiles repositories.SharedFileRepository) FileService {
+	return &fileService{
+		blobs:       blobs,
+		sharedFiles: sharedFiles,
+	}
+}
+
+func (f *fileService) Store(ctx context.Context, files []models.File) ([]models.File, error) {
+	if files == nil {
+		return nil, nil
+	}
+
+	stored := make([]models.File, len(files))
+	for i, file := range files {
+		if file.Name == "" {
+			return nil, fmt.Errorf("%w: file name is required", cerrors.New(cerrors.INVALID_DATA))
+		}
+
+		if file.SharedFileID != "" {
+			_, err := f.sharedFiles.GetByID(ctx, file.SharedFileID)
+			if err != nil {
+				return nil, fmt.Errorf("%w: file %s links an unknown shared file %s", cerrors.New(cerrors.INVALID_DATA), file.Name, file.SharedFileID)
+			}
+
+			stored[i] = models.File{Name: file.Name, Mode: file.Mode, SharedFileID: file.SharedFileID}
+			continue
+		}
+
+		// Clients may send back a reference they got earlier instead of the
+		// whole content.
+		if file.IsReference() {
+			reader, size, err := f.blobs.Open(ctx, file.SHA256)
+			if err != nil {
+				return nil, fmt.Errorf("%w: file %s references an unknown blob %s", cerrors.New(cerrors.INVALID_DATA), file.Name, file.SHA256)
+			}
+			reader.Close()
+
+			stored[i] = models.File{Name: file.Name, SHA256: file.SHA256, Size: size, Mode: file.Mode}
+			continue
+		}
+
+		data := file.Data
+		if data == nil {
+			data = []byte(file.Content)
+		}
+
+		sum := sha256.Sum256(data)
+		digest := hex.EncodeToString(sum[:])
+		if file.SHA256 != "" && file.SHA256 != digest {
+			return nil, fmt.Errorf("%w: file %s doesn't match its sha256", cerrors.New(cerrors.INVALID_DATA), file.Name)
+		}
+
+		err := f.blobs.Put(ctx, digest, data)
+		if err != nil {
+			return nil, err
+		}
+
+		stored[i] = models.File{Name: file.Name, SHA256: digest, Size: int64(len(data)), Mode: file.Mode}
+	}
+
+	return stored, nil
+}
+
+func (f *fileService) Resolve(ctx context.Context, files []models.File) ([]models.File, error) {
+	if !slices.ContainsFunc(files, func(file models.File) bool { return file.SharedFileID != "" }) {
+		return files, nil
+	}
+
+	shared := map[string]*models.SharedFile{}
+	resolved := make([]models.File, len(files))
+	for i, file := range files {
+		resolved[i] = file
+		if file.SharedFileID == "" {
+			continue
+		}
+
+		sharedFile, ok := shared[file.SharedFileID]
+		if !ok {
+			var err error
+			sharedFile, err = f.sharedFiles.GetByID(ctx, file.SharedFileID)
+			if err != nil {
+				return nil, fmt.Errorf("Cannot resolve shared file %s of %s : %w", file.SharedFileID, file.Name, err)
+			}
+			shared[file.SharedFileID] = sharedFile
+		}
+
+		resolved[i].SHA256 = sharedFile.File.SHA256
+		resolved[i].Size = sharedFile.File.Size
+		if resolved[i].Mode == 0 {
+			resolved[i].Mode = sharedFile.File.Mode
+		}
+	}
+
+	return resolved, nil
+}
+
+func (f *fileService) Get(ctx context.Context, sha256 string) ([]byte, error) {
+	reader, _, err := f.blobs.Open(ctx, sha256)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
+
+func (f *fileService) Open(ctx context.Context, sha256 string) (io.ReadCloser, int64, error) {
+	return f.blobs.Open(ctx, sha256)
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+)
+
+const (
+	// idempotencyLease is how long a key stays reserved by a request that
+	// never completes, e.g. because the server stopped.
+	idempotencyLease      = time.Minute
+	defaultIdempotencyTTL = 24 * time.Hour
+)
+
+type idempotencyService struct {
+	repo repositories.IdempotencyRepository
+	ttl  time.Duration
+}
+
+type IdempotencyService interface {
+	// Do runs create once per key within scope and returns the created ID.
+	// Retrying with the same key and request returns the ID of the first run,
+	// while reusing the key for a different request fails.
+	Do(ctx context.Context, scope string, key string, request []byte, create func() (string, error)) (string, error)
+}
+
+// NewIdempotencyService creates the idempotency service, remembering keys
+// for ttl after their request completed, a day when zero.
+func NewIdempotencyService(repo repositories.IdempotencyRepository, ttl time.Duration) IdempotencyService {
+	if ttl <= 0 {
+		ttl = defaultIdempotencyTTL
+	}
+	return &idempotencyService{
+		repo: repo,
+		ttl:  ttl,
+	}
+}
+
+func (s *idempotencyService) Do(ctx context.Context, scope string, key string, request []byte, create func() (string, error)) (string, error) {
+	sum := sha256.Sum256(request)
+	hash := hex.EncodeToString(sum[:])
+	stored := scope + " " + key
+
+	err := s.repo.Reserve(ctx, &models.IdempotencyKey{
+		Key:         stored,
+		RequestHash: hash,
+		ExpiresAt:   time.Now().UTC().Add(idempotencyLease),
+	})
+	if errors.Is(err, cerrors.DUPLICATE_DATA) {
+		return s.replay(ctx, stored, key, hash)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	ID, err := create()
+	if err != nil {
+		// Let the client retry with the same key.
+		if deleteErr := s.repo.Delete(ctx, stored); deleteErr != nil {
+			return "", errors.Join(err, deleteErr)
+		}
+		return "", err
+	}
+
+	// The resource exists already, failing here would only make the client
+	// retry, so the key is at worst released when its lease expires.
+	_ = s.repo.Complete(ctx, stored, ID, time.Now().UTC().Add(s.ttl))
+	return ID, nil
+}
+
+// replay returns the result of the request which first used the key.
+func (s *idempotencyService) replay(ctx context.Context, stored string, key string, hash string) (string, error) {
+	existing, err := s.repo.Get(ctx, stored)
+	if err != nil {
+		return "", err
+	}
+
+	if existing.RequestHash != hash {
+		return "", fmt.Errorf("%w: idempotency key %s was used for a different request", cerrors.New(cerrors.INVALID_DATA), key)
+	}
+	if existing.ResultID == "" {
+		return "", fmt.Errorf("%w: a request with idempotency key %s is still running", cerrors.New(cerrors.DATA_IN_USE), key)
+	}
+	return existing.ResultID, nil
+}
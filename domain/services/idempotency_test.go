@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/internal/adapters/memory"
+)
+
+// counter returns a create func handing out a new ID on every call.
+func counter() (func() (string, error), *int) {
+	calls := 0
+	return func() (string, error) {
+		calls++
+		return fmt.Sprintf("id-%d", calls), nil
+	}, &calls
+}
+
+func TestIdempotencyDoReplays(t *testing.T) {
+	ctx := context.Background()
+	s := NewIdempotencyService(memory.NewIdempotencyRepo(), 0)
+	create, calls := counter()
+
+	first, err := s.Do(ctx, "CreateRunner", "key", []byte("request"), create)
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	again, err := s.Do(ctx, "CreateRunner", "key", []byte("request"), create)
+	if err != nil {
+		t.Fatalf("Do again: %v", err)
+	}
+	if again != first || *calls != 1 {
+		t.Errorf("retry returned %s after %d creates, want %s after 1", again, *calls, first)
+	}
+
+	// Keys are only shared within a scope.
+	other, err := s.Do(ctx, "CreateCompare", "key", []byte("request"), create)
+	if err != nil {
+		t.Fatalf("Do in another scope: %v", err)
+	}
+	if other == first || *calls != 2 {
+		t.Errorf("another scope returned %s after %d creates, want a new ID after 2", other, *calls)
+	}
+}
+
+func TestIdempotencyDoRejectsAnotherRequest(t *testing.T) {
+	ctx := context.Background()
+	s := NewIdempotencyService(memory.NewIdempotencyRepo(), 0)
+	create, calls := counter()
+
+	_, err := s.Do(ctx, "CreateRunner", "key", []byte("request"), create)
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	_, err = s.Do(ctx, "CreateRunner", "key", []byte("another request"), create)
+	if !errors.Is(err, cerrors.INVALID_DATA) {
+		t.Fatalf("Do with another request error = %v, want %v", err, cerrors.INVALID_DATA)
+	}
+	if *calls != 1 {
+		t.Errorf("create called %d times, want 1", *calls)
+	}
+}
+
+func TestIdempotencyDoWhileRunning(t *testing.T) {
+	ctx := context.Background()
+	s := NewIdempotencyService(memory.NewIdempotencyRepo(), 0)
+
+	started, release := make(chan struct{}), make(chan struct{})
+	done := make(chan error)
+	go func() {
+		_, err := s.Do(ctx, "CreateRunner", "key", []byte("request"), func() (string, error) {
+			close(started)
+			<-release
+			return "id", nil
+		})
+		done <- err
+	}()
+
+	<-started
+	_, err := s.Do(ctx, "CreateRunner", "key", []byte("request"), func() (string, error) {
+		t.Error("create ran while the first request is running")
+		return "", nil
+	})
+	if !errors.Is(err, cerrors.DATA_IN_USE) {
+		t.Errorf("Do while running error = %v, want %v", err, cerrors.DATA_IN_USE)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("first Do: %v", err)
+	}
+}
+
+func TestIdempotencyDoReleasesFailedKeys(t *testing.T) {
+	ctx := context.Background()
+	s := NewIdempotencyService(memory.NewIdempotencyRepo(), 0)
+
+	failure := errors.New("cannot create")
+	_, err := s.Do(ctx, "CreateRunner", "key", []byte("request"), func() (string, error) {
+		return "", failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("Do error = %v, want %v", err, failure)
+	}
+
+	ID, err := s.Do(ctx, "CreateRunner", "key", []byte("request"), func() (string, error) {
+		return "id", nil
+	})
+	if err != nil || ID != "id" {
+		t.Fatalf("retry after a failure = %q, %v, want id", ID, err)
+	}
+}
+
+func TestIdempotencyDoForgetsExpiredKeys(t *testing.T) {
+	ctx := context.Background()
+	s := NewIdempotencyService(memory.NewIdempotencyRepo(), time.Nanosecond)
+	create, calls := counter()
+
+	_, err := s.Do(ctx, "CreateRunner", "key", []byte("request"), create)
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	time.Sleep(time.Millisecond)
+
+	// An expired key may even be reused for another request.
+	ID, err := s.Do(ctx, "CreateRunner", "key", []byte("another request"), create)
+	if err != nil {
+		t.Fatalf("Do after expiry: %v", err)
+	}
+	if ID != "id-2" || *calls != 2 {
+		t.Errorf("Do after expiry returned %s after %d creates, want id-2 after 2", ID, *calls)
+	}
+}
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+	"github.com/google/uuid"
+)
+
+type limitProfileService struct {
+	repo          repositories.LimitProfileRepository
+	runnerService RunnerService
+}
+
+type LimitProfileService interface {
+	Create(ctx context.Context, body *requests.CreateLimitProfile) (string, error)
+	GetAll(ctx context.Context) ([]models.LimitProfile, error)
+	GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.LimitProfile, int, error)
+	GetByID(ctx context.Context, ID string) (*models.LimitProfile, error)
+	UpdateByID(ctx context.Context, ID string, body *requests.UpdateLimitProfile) error
+	DeleteByID(ctx context.Context, ID string) error
+	Resolve(ctx context.Context, profileID string, runnerID string) (string, models.Limit, error)
+}
+
+func NewLimitProfileService(repo repositories.LimitProfileRepository, runnerService RunnerService) LimitProfileService {
+	return &limitProfileService{
+		repo:          repo,
+		runnerService: runnerService,
+	}
+}
+
+func (l *limitProfileService) GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.LimitProfile, int, error) {
+	pagination, err := l.repo.GetPagination(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := l.repo.Count(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return pagination, count, nil
+}
+
+func (l *limitProfileService) Create(ctx context.Context, body *requests.CreateLimitProfile) (string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	err = l.repo.Create(ctx, id.String(), body)
+	if err != nil {
+		return "", err
+	}
+
+	return id.String(), nil
+}
+
+func (l *limitProfileService) GetAll(ctx context.Context) ([]models.LimitProfile, error) {
+	return l.repo.GetAll(ctx)
+}
+
+func (l *limitProfileService) GetByID(ctx context.Context, ID string) (*models.LimitProfile, error) {
+	return l.repo.GetByID(ctx, ID)
+}
+
+func (l *limitProfileService) UpdateByID(ctx context.Context, ID string, body *requests.UpdateLimitProfile) error {
+	return l.repo.UpdateByID(ctx, ID, body)
+}
+
+func (l *limitProfileService) DeleteByID(ctx context.Context, ID string) error {
+	runners, err := l.runnerService.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, runner := range runners {
+		if runner.DefaultLimitProfileID == ID {
+			return fmt.Errorf("%w: limit profile %s is the default of runner %s", cerrors.New(cerrors.DATA_IN_USE), ID, runner.ID)
+		}
+	}
+
+	return l.repo.DeleteByID(ctx, ID)
+}
+
+// Resolve returns the effective limit for a task. When no profile is given the
+// runner's default profile is used, and the runner's multipliers are applied
+// on top of it.
+func (l *limitProfileService) Resolve(ctx context.Context, profileID string, runnerID string) (string, models.Limit, error) {
+	var runner *models.Runner
+	if runnerID != "" {
+		var err error
+		runner, err = l.runnerService.GetByID(ctx, runnerID)
+		if err != nil {
+			return "", models.Limit{}, err
+		}
+
+		if profileID == "" {
+			profileID = runner.DefaultLimitProfileID
+		}
+	}
+
+	if profileID == "" {
+		return "", models.Limit{}, fmt.Errorf("%w: no limit profile given and runner %q has no default", cerrors.New(cerrors.INVALID_DATA), runnerID)
+	}
+
+	profile, err := l.repo.GetByID(ctx, profileID)
+	if err != nil {
+		return "", models.Limit{}, err
+	}
+
+	limit := profile.Limit
+	if runner != nil {
+		limit = runner.LimitMultipliers.Apply(limit)
+	}
+
+	return profile.ID, limit, nil
+}
@@ -0,0 +1,198 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/namespaces"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+)
+
+// checkOwned fails when a document of namespace cannot be changed from ctx,
+// because it is shared from the global namespace.
+func checkOwned(ctx context.Context, kind string, ID string, namespace string) error {
+	if namespaces.IsOwned(ctx, namespace) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s %s is shared from the %s namespace and cannot be changed", cerrors.New(cerrors.FORBIDDEN), kind, ID, namespaces.Name(namespace))
+}
+
+// getOwned returns the runner, failing when it cannot be changed from ctx.
+func (l *runnerService) getOwned(ctx context.Context, ID string) (*models.Runner, error) {
+	runner, err := l.repo.GetByID(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+	return runner, checkOwned(ctx, "runner", ID, runner.Namespace)
+}
+
+// movedChanges tells the namespace moved from that the runner or compare is
+// gone, and the one moved to that it is new.
+func movedChanges(kind models.ChangeKind, ID string, from string, to string) []models.Change {
+	return []models.Change{
+		{Kind: kind, Action: models.ChangeDeleted, ID: ID, Namespace: from},
+		{Kind: kind, Action: models.ChangeCreated, ID: ID, Namespace: to},
+	}
+}
+
+func (l *runnerService) MoveToNamespace(ctx context.Context, ID string, namespace string) error {
+	err := namespaces.Validate(namespace)
+	if err != nil {
+		return err
+	}
+
+	runner, err := l.repo.GetByID(ctx, ID)
+	if err != nil {
+		return err
+	}
+	if runner.Namespace == namespace {
+		return nil
+	}
+
+	if runner.ParentID != "" {
+		parent, err := l.repo.GetByID(namespaces.Unscoped(ctx), runner.ParentID)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(namespaces.Visible(namespace), parent.Namespace) {
+			return fmt.Errorf("%w: runner %s inherits from runner %s of the %s namespace", cerrors.New(cerrors.INVALID_DATA), ID, parent.ID, namespaces.Name(parent.Namespace))
+		}
+	}
+
+	children, err := l.repo.GetByParentID(ctx, ID)
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		if !slices.Contains(namespaces.Visible(child.Namespace), namespace) {
+			return fmt.Errorf("%w: runner %s of the %s namespace inherits from runner %s", cerrors.New(cerrors.DATA_IN_USE), child.ID, namespaces.Name(child.Namespace), ID)
+		}
+	}
+
+	now, author := stamp(ctx)
+	err = l.repo.UpdateByID(ctx, ID, &requests.UpdateRunner{
+		Namespace: &namespace,
+		UpdatedAt: &now,
+		UpdatedBy: &author,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = l.revisions.Record(ctx, movedChanges(models.ChangeRunner, ID, runner.Namespace, namespace)...)
+	return err
+}
+
+func (l *runnerService) CopyToNamespace(ctx context.Context, ID string, body *requests.CopyToNamespace) (string, error) {
+	err := namespaces.Validate(body.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	runner, err := l.repo.GetByID(ctx, ID)
+	if err != nil {
+		return "", err
+	}
+
+	copyID, err := l.Create(ctx, &requests.CreateRunner{
+		Name:        cmp.Or(body.Name, runner.Name),
+		Slug:        body.Slug,
+		Description: runner.Description,
+		ParentID:    runner.ParentID,
+		Variables:   runner.Variables,
+		Env:         runner.Env,
+
+		DefaultLimitProfileID: runner.DefaultLimitProfileID,
+		LimitMultipliers:      runner.LimitMultipliers,
+		Metadata:              runner.Metadata,
+
+		Namespace: body.Namespace,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	err = l.UpdateByID(ctx, copyID, &requests.UpdateRunner{
+		BuildScript:  &runner.BuildScript,
+		RunScript:    &runner.RunScript,
+		InitialFiles: runner.InitialFiles,
+
+		StarterTemplate: &runner.StarterTemplate,
+		EntryPoint:      &runner.EntryPoint,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return copyID, nil
+}
+
+// getOwned returns the compare, failing when it cannot be changed from ctx.
+func (c *compareService) getOwned(ctx context.Context, ID string) (*models.Compare, error) {
+	compare, err := c.repo.GetByID(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+	return compare, checkOwned(ctx, "compare", ID, compare.Namespace)
+}
+
+func (c *compareService) MoveToNamespace(ctx context.Context, ID string, namespace string) error {
+	err := namespaces.Validate(namespace)
+	if err != nil {
+		return err
+	}
+
+	compare, err := c.repo.GetByID(ctx, ID)
+	if err != nil {
+		return err
+	}
+	if compare.Namespace == namespace {
+		return nil
+	}
+
+	now, author := stamp(ctx)
+	err = c.repo.UpdateByID(ctx, ID, &requests.UpdateCompare{
+		Namespace: &namespace,
+		UpdatedAt: &now,
+		UpdatedBy: &author,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.revisions.Record(ctx, movedChanges(models.ChangeCompare, ID, compare.Namespace, namespace)...)
+	return err
+}
+
+func (c *compareService) CopyToNamespace(ctx context.Context, ID string, body *requests.CopyToNamespace) (string, error) {
+	err := namespaces.Validate(body.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	compare, err := c.repo.GetByID(ctx, ID)
+	if err != nil {
+		return "", err
+	}
+
+	return c.Create(ctx, &requests.CreateCompare{
+		Name:        cmp.Or(body.Name, compare.Name),
+		Slug:        body.Slug,
+		Files:       compare.Files,
+		BuildScript: compare.BuildScript,
+		RunScript:   compare.RunScript,
+		RunName:     compare.RunName,
+		Description: compare.Description,
+		GoldenCases: compare.GoldenCases,
+
+		PresetID:      compare.PresetID,
+		PresetVersion: compare.PresetVersion,
+		PresetParams:  compare.PresetParams,
+
+		Namespace: body.Namespace,
+	})
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/namespaces"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+)
+
+const maxRevisionAttempts = 16
+
+type revisionService struct {
+	repo        repositories.RevisionRepository
+	runnerRepo  repositories.RunnerRepository
+	compareRepo repositories.CompareRepository
+	// mu orders the revisions recorded by this server, other servers sharing
+	// the database are caught by the unique revision number.
+	mu sync.Mutex
+}
+
+type RevisionService interface {
+	// Record stores the changes as a new revision and returns its number. A
+	// change of a runner also changes every runner inheriting from it.
+	// Without changes, nothing is stored and the latest number is returned.
+	// Within a context given by WithChangeSet, changes are only collected
+	// and 0 is returned.
+	Record(ctx context.Context, changes ...models.Change) (int64, error)
+	// SharedFileChanges returns an update of every runner and compare linking
+	// the shared file.
+	SharedFileChanges(ctx context.Context, sharedFileID string) ([]models.Change, error)
+	Latest(ctx context.Context) (int64, error)
+	// GetSince returns the revisions after since, keeping only the changes
+	// visible from the namespace of ctx.
+	GetSince(ctx context.Context, since int64, limit int) ([]models.Revision, error)
+	// GetChanges returns the last change of every runner and compare changed
+	// after since and visible from the namespace of ctx, along with the
+	// number of the last revision.
+	GetChanges(ctx context.Context, since int64) ([]models.Change, int64, error)
+	Wait(ctx context.Context, since int64) error
+}
+
+// ChangeSet collects changes to record them later as a single revision, e.g.
+// once a transaction is committed.
+type ChangeSet struct {
+	mu      sync.Mutex
+	changes []models.Change
+}
+
+type changeSetKey struct{}
+
+// WithChangeSet returns a context in which Record adds the changes to set
+// instead of storing them.
+func WithChangeSet(ctx context.Context, set *ChangeSet) context.Context {
+	return context.WithValue(ctx, changeSetKey{}, set)
+}
+
+// Changes returns the collected changes.
+func (s *ChangeSet) Changes() []models.Change {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.changes)
+}
+
+func NewRevisionService(repo repositories.RevisionRepository, runnerRepo repositories.RunnerRepository, compareRepo repositories.CompareRepository) RevisionService {
+	return &revisionService{
+		repo:        repo,
+		runnerRepo:  runnerRepo,
+		compareRepo: compareRepo,
+	}
+}
+
+func (s *revisionService) Record(ctx context.Context, changes ...models.Change) (int64, error) {
+	if set, ok := ctx.Value(changeSetKey{}).(*ChangeSet); ok {
+		set.mu.Lock()
+		set.changes = append(set.changes, changes...)
+		set.mu.Unlock()
+		return 0, nil
+	}
+
+	changes, err := s.withDescendants(ctx, changes)
+	if err != nil {
+		return 0, err
+	}
+	if len(changes) == 0 {
+		return s.repo.Latest(ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for range maxRevisionAttempts {
+		latest, err := s.repo.Latest(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		revision := &models.Revision{
+			Number:    latest + 1,
+			Changes:   changes,
+			CreatedAt: time.Now().UTC(),
+		}
+		err = s.repo.Create(ctx, revision)
+		if errors.Is(err, cerrors.DUPLICATE_DATA) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		return revision.Number, nil
+	}
+
+	return 0, fmt.Errorf("%w: cannot allocate a revision number", cerrors.New(cerrors.UNKNOWN_ERROR))
+}
+
+// withDescendants removes duplicated changes and adds an update of every
+// runner inheriting from a changed runner.
+func (s *revisionService) withDescendants(ctx context.Context, changes []models.Change) ([]models.Change, error) {
+	seen := map[string]bool{}
+	var result []models.Change
+	add := func(change models.Change) bool {
+		key := changeKey(change)
+		if seen[key] {
+			return false
+		}
+		seen[key] = true
+		result = append(result, change)
+		return true
+	}
+
+	var parents []string
+	for _, change := range changes {
+		if add(change) && change.Kind == models.ChangeRunner && change.Action != models.ChangeDeleted {
+			parents = append(parents, change.ID)
+		}
+	}
+
+	for depth := 0; len(parents) > 0 && depth < maxRunnerDepth; depth++ {
+		var next []string
+		for _, ID := range parents {
+			children, err := s.runnerRepo.GetByParentID(ctx, ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, child := range children {
+				if add(models.Change{Kind: models.ChangeRunner, Action: models.ChangeUpdated, ID: child.ID, Namespace: child.Namespace}) {
+					next = append(next, child.ID)
+				}
+			}
+		}
+		parents = next
+	}
+
+	return result, nil
+}
+
+func (s *revisionService) SharedFileChanges(ctx context.Context, sharedFileID string) ([]models.Change, error) {
+	runners, err := s.runnerRepo.GetByFile(ctx, "", sharedFileID)
+	if err != nil {
+		return nil, err
+	}
+
+	compares, err := s.compareRepo.GetByFile(ctx, "", sharedFileID)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []models.Change
+	for _, runner := range runners {
+		changes = append(changes, models.Change{Kind: models.ChangeRunner, Action: models.ChangeUpdated, ID: runner.ID, Namespace: runner.Namespace})
+	}
+	for _, compare := range compares {
+		changes = append(changes, models.Change{Kind: models.ChangeCompare, Action: models.ChangeUpdated, ID: compare.ID, Namespace: compare.Namespace})
+	}
+	return changes, nil
+}
+
+func (s *revisionService) Latest(ctx context.Context) (int64, error) {
+	return s.repo.Latest(ctx)
+}
+
+// changeKey identifies the runner or compare of a change within a namespace,
+// so that a move keeps both its deletion and its creation.
+func changeKey(change models.Change) string {
+	return string(change.Kind) + "/" + change.Namespace + "/" + change.ID
+}
+
+func (s *revisionService) GetSince(ctx context.Context, since int64, limit int) ([]models.Revision, error) {
+	revisions, err := s.repo.GetSince(ctx, since, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Revisions without visible changes are kept, so that the caller still
+	// moves past them.
+	for i := range revisions {
+		revisions[i].Changes = slices.DeleteFunc(revisions[i].Changes, func(change models.Change) bool {
+			return !namespaces.IsVisible(ctx, change.Namespace)
+		})
+	}
+	return revisions, nil
+}
+
+func (s *revisionService) GetChanges(ctx context.Context, since int64) ([]models.Change, int64, error) {
+	revisions, err := s.GetSince(ctx, since, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	last := map[string]int{}
+	var changes []models.Change
+	for _, revision := range revisions {
+		for _, change := range revision.Changes {
+			key := changeKey(change)
+			i, ok := last[key]
+			if !ok {
+				last[key] = len(changes)
+				changes = append(changes, change)
+				continue
+			}
+
+			// A runner or compare created then updated is still new.
+			if changes[i].Action == models.ChangeCreated && change.Action == models.ChangeUpdated {
+				continue
+			}
+			changes[i] = change
+		}
+		since = revision.Number
+	}
+
+	return changes, since, nil
+}
+
+func (s *revisionService) Wait(ctx context.Context, since int64) error {
+	return s.repo.Wait(ctx, since)
+}
@@ -2,15 +2,37 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
 	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/namespaces"
 	"github.com/CSKU-Lab/config-server/domain/repositories"
 	"github.com/CSKU-Lab/config-server/domain/requests"
+	"github.com/CSKU-Lab/config-server/domain/templates"
 	"github.com/google/uuid"
 )
 
+const maxRunnerDepth = 16
+
 type runnerService struct {
-	repo repositories.RunnerRepository
+	repo          repositories.RunnerRepository
+	limitProfiles repositories.LimitProfileRepository
+	files         FileService
+	box           SecretBox
+	revisions     RevisionService
+}
+
+// SecretBox seals secret environment variables before they are stored.
+type SecretBox interface {
+	Seal(plaintext string) (string, error)
+	Open(sealed string) (string, error)
+	IsSealed(value string) bool
 }
 
 type RunnerService interface {
@@ -18,27 +40,64 @@ type RunnerService interface {
 	GetAll(ctx context.Context) ([]models.Runner, error)
 	GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.Runner, int, error)
 	GetByID(ctx context.Context, ID string) (*models.Runner, error)
+	// ResolveID returns the ID of the runner referenced by its ID, slug or
+	// alias.
+	ResolveID(ctx context.Context, ref string) (string, error)
+	GetRawByID(ctx context.Context, ID string) (*models.Runner, error)
+	GetAllRaw(ctx context.Context) ([]models.Runner, error)
 	UpdateByID(ctx context.Context, ID string, body *requests.UpdateRunner) error
 	DeleteByID(ctx context.Context, ID string) error
+	// RenameSlug gives the runner a new slug, keeping the previous one as an
+	// alias.
+	RenameSlug(ctx context.Context, ID string, slug string) error
+	// RenderStarter returns the entry point of the runner and its starter
+	// template rendered for the task.
+	RenderStarter(ctx context.Context, ID string, task *requests.RenderStarter) (string, string, error)
+	// MoveToNamespace moves the runner to another namespace, the global one
+	// to share it with every namespace.
+	MoveToNamespace(ctx context.Context, ID string, namespace string) error
+	// CopyToNamespace copies the runner as stored into another namespace and
+	// returns the ID of the copy.
+	CopyToNamespace(ctx context.Context, ID string, body *requests.CopyToNamespace) (string, error)
+	RevealSecrets(runner *models.Runner) error
 }
 
-func NewRunnerService(repo repositories.RunnerRepository) *runnerService {
+// NewRunnerService creates the runner service. box may be nil, in which case
+// runners with secret environment variables are refused.
+func NewRunnerService(repo repositories.RunnerRepository, limitProfiles repositories.LimitProfileRepository, files FileService, box SecretBox, revisions RevisionService) *runnerService {
 	return &runnerService{
-		repo: repo,
+		repo:          repo,
+		limitProfiles: limitProfiles,
+		files:         files,
+		box:           box,
+		revisions:     revisions,
 	}
 }
 
 func (l *runnerService) GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.Runner, int, error) {
+	req.Language = normalizeValue(req.Language, "")
+	req.Tags = normalizeList(req.Tags, "")
+	req.Extension = normalizeValue(req.Extension, ".")
+
 	pagination, err := l.repo.GetPagination(ctx, req)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	count, err := l.repo.Count(ctx)
+	count, err := l.repo.Count(ctx, req)
 	if err != nil {
 		return nil, 0, err
 	}
 
+	lookup := l.cachedLookup(ctx, nil)
+	for i := range pagination {
+		resolved, err := resolveRunner(ctx, &pagination[i], lookup, l.files)
+		if err != nil {
+			return nil, 0, err
+		}
+		pagination[i] = *resolved
+	}
+
 	return pagination, count, nil
 }
 
@@ -47,26 +106,527 @@ func (l *runnerService) Create(ctx context.Context, body *requests.CreateRunner)
 	if err != nil {
 		return "", err
 	}
+
+	if namespace, ok := namespaces.FromContext(ctx); ok {
+		body.Namespace = namespace
+	}
+	err = namespaces.Validate(body.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	if body.ParentID != "" {
+		err = l.validateParent(ctx, id.String(), body.Namespace, body.ParentID)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if body.Slug != "" {
+		err = l.checkSlug(ctx, id.String(), body.Slug)
+		if err != nil {
+			return "", err
+		}
+	}
+	body.Aliases = nil
+
+	err = l.validateLimits(ctx, body.DefaultLimitProfileID, body.LimitMultipliers)
+	if err != nil {
+		return "", err
+	}
+
+	err = validateEntryPoint(body.EntryPoint)
+	if err != nil {
+		return "", err
+	}
+
+	body.Env, err = l.sealEnv(body.Env, nil)
+	if err != nil {
+		return "", err
+	}
+
+	body.Metadata = normalizeMetadata(body.Metadata)
+	body.Audit = newAudit(ctx)
 	err = l.repo.Create(ctx, id.String(), body)
 	if err != nil {
 		return "", err
 	}
 
+	_, err = l.revisions.Record(ctx, models.Change{Kind: models.ChangeRunner, Action: models.ChangeCreated, ID: id.String(), Namespace: body.Namespace})
+	if err != nil {
+		return "", err
+	}
+
 	return id.String(), nil
 }
 
 func (l *runnerService) GetAll(ctx context.Context) ([]models.Runner, error) {
-	return l.repo.GetAll(ctx)
+	runners, err := l.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*models.Runner, len(runners))
+	for i := range runners {
+		byID[runners[i].ID] = &runners[i]
+	}
+
+	lookup := l.cachedLookup(ctx, byID)
+	resolved := make([]models.Runner, len(runners))
+	for i := range runners {
+		runner, err := resolveRunner(ctx, &runners[i], lookup, l.files)
+		if err != nil {
+			return nil, err
+		}
+		resolved[i] = *runner
+	}
+
+	return resolved, nil
 }
 
+// GetByID returns the effective runner, with everything inherited from its
+// ancestors merged in and template variables substituted.
 func (l *runnerService) GetByID(ctx context.Context, ID string) (*models.Runner, error) {
+	runner, err := l.repo.GetByID(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveRunner(ctx, runner, l.cachedLookup(ctx, nil), l.files)
+}
+
+func (l *runnerService) ResolveID(ctx context.Context, ref string) (string, error) {
+	if isID(ref) {
+		return ref, nil
+	}
+
+	runner, err := l.repo.GetBySlug(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+	return runner.ID, nil
+}
+
+func (l *runnerService) RenameSlug(ctx context.Context, ID string, slug string) error {
+	runner, err := l.repo.GetByID(ctx, ID)
+	if err != nil {
+		return err
+	}
+	if runner.Slug == slug {
+		return nil
+	}
+
+	err = l.checkSlug(ctx, ID, slug)
+	if err != nil {
+		return err
+	}
+
+	return l.UpdateByID(ctx, ID, &requests.UpdateRunner{
+		Slug:    &slug,
+		Aliases: renamedAliases(runner.Aliases, runner.Slug, slug),
+	})
+}
+
+func (l *runnerService) checkSlug(ctx context.Context, ID string, slug string) error {
+	err := validateSlug(slug)
+	if err != nil {
+		return err
+	}
+
+	return checkSlugFree(ctx, "runner", ID, slug, func(ctx context.Context, slug string) (string, string, error) {
+		runner, err := l.repo.GetBySlug(ctx, slug)
+		if err != nil {
+			return "", "", err
+		}
+		return runner.ID, runner.Namespace, nil
+	})
+}
+
+// GetRawByID returns the runner exactly as it is stored.
+func (l *runnerService) GetRawByID(ctx context.Context, ID string) (*models.Runner, error) {
 	return l.repo.GetByID(ctx, ID)
 }
 
+// GetAllRaw returns every runner exactly as it is stored.
+func (l *runnerService) GetAllRaw(ctx context.Context) ([]models.Runner, error) {
+	return l.repo.GetAll(ctx)
+}
+
 func (l *runnerService) UpdateByID(ctx context.Context, ID string, body *requests.UpdateRunner) error {
-	return l.repo.UpdateByID(ctx, ID, body)
+	runner, err := l.getOwned(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	if body.ParentID != nil && *body.ParentID != "" {
+		err = l.validateParent(ctx, ID, runner.Namespace, *body.ParentID)
+		if err != nil {
+			return err
+		}
+	}
+
+	profileID := ""
+	if body.DefaultLimitProfileID != nil {
+		profileID = *body.DefaultLimitProfileID
+	}
+	err = l.validateLimits(ctx, profileID, body.LimitMultipliers)
+	if err != nil {
+		return err
+	}
+
+	if body.InitialFiles != nil {
+		files, err := l.files.Store(ctx, body.InitialFiles)
+		if err != nil {
+			return err
+		}
+		body.InitialFiles = files
+	}
+
+	if body.Env != nil {
+		body.Env, err = l.sealEnv(body.Env, runner.Env)
+		if err != nil {
+			return err
+		}
+	}
+
+	if body.EntryPoint != nil {
+		err := validateEntryPoint(*body.EntryPoint)
+		if err != nil {
+			return err
+		}
+	}
+
+	body.Metadata = normalizeMetadata(body.Metadata)
+	now, author := stamp(ctx)
+	body.UpdatedAt = &now
+	body.UpdatedBy = &author
+
+	err = l.repo.UpdateByID(ctx, ID, body)
+	if err != nil {
+		return err
+	}
+
+	_, err = l.revisions.Record(ctx, models.Change{Kind: models.ChangeRunner, Action: models.ChangeUpdated, ID: ID, Namespace: runner.Namespace})
+	return err
 }
 
 func (l *runnerService) DeleteByID(ctx context.Context, ID string) error {
-	return l.repo.DeleteByID(ctx, ID)
+	runner, err := l.getOwned(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	children, err := l.repo.GetByParentID(ctx, ID)
+	if err != nil {
+		return err
+	}
+	if len(children) > 0 {
+		return fmt.Errorf("%w: runner %s is the parent of %d runner(s)", cerrors.New(cerrors.DATA_IN_USE), ID, len(children))
+	}
+
+	err = l.repo.DeleteByID(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = l.revisions.Record(ctx, models.Change{Kind: models.ChangeRunner, Action: models.ChangeDeleted, ID: ID, Namespace: runner.Namespace})
+	return err
+}
+
+// RevealSecrets opens the sealed secret values of the runner in place.
+func (l *runnerService) RevealSecrets(runner *models.Runner) error {
+	for i, env := range runner.Env {
+		if !env.Secret || env.Value == "" {
+			continue
+		}
+		if l.box == nil {
+			return fmt.Errorf("%w: secret storage is not configured", cerrors.New(cerrors.INVALID_DATA))
+		}
+
+		value, err := l.box.Open(env.Value)
+		if err != nil {
+			return fmt.Errorf("Cannot open secret %s of runner %s : %v", env.Name, runner.ID, err)
+		}
+		runner.Env[i].Value = value
+	}
+	return nil
+}
+
+// sealEnv seals every secret value. Secrets sent without a value keep their
+// existing sealed value, since clients only ever see them redacted.
+func (l *runnerService) sealEnv(env []models.EnvVar, existing []models.EnvVar) ([]models.EnvVar, error) {
+	sealed := make([]models.EnvVar, len(env))
+	for i, e := range env {
+		if e.Name == "" {
+			return nil, fmt.Errorf("%w: environment variable name is required", cerrors.New(cerrors.INVALID_DATA))
+		}
+
+		sealed[i] = e
+		if !e.Secret || (l.box != nil && l.box.IsSealed(e.Value)) {
+			continue
+		}
+
+		if e.Value == "" {
+			for _, old := range existing {
+				if old.Name == e.Name && old.Secret {
+					sealed[i].Value = old.Value
+				}
+			}
+			continue
+		}
+
+		if l.box == nil {
+			return nil, fmt.Errorf("%w: secret storage is not configured", cerrors.New(cerrors.INVALID_DATA))
+		}
+
+		value, err := l.box.Seal(e.Value)
+		if err != nil {
+			return nil, err
+		}
+		sealed[i].Value = value
+	}
+	return sealed, nil
+}
+
+// validateParent makes sure the parent exists and is visible from the
+// namespace of the runner, and that inheriting from it wouldn't create a
+// cycle.
+func (l *runnerService) validateParent(ctx context.Context, ID string, namespace string, parentID string) error {
+	lookup := l.cachedLookup(ctx, nil)
+
+	current := parentID
+	for depth := 0; current != ""; depth++ {
+		if current == ID {
+			return fmt.Errorf("%w: runner %s cannot inherit from its own descendant", cerrors.New(cerrors.INVALID_DATA), ID)
+		}
+		if depth >= maxRunnerDepth {
+			return fmt.Errorf("%w: runner inheritance is deeper than %d levels", cerrors.New(cerrors.INVALID_DATA), maxRunnerDepth)
+		}
+
+		parent, err := lookup(current)
+		if err != nil {
+			return fmt.Errorf("%w: parent runner %s not found", cerrors.New(cerrors.INVALID_DATA), current)
+		}
+		if current == parentID && !slices.Contains(namespaces.Visible(namespace), parent.Namespace) {
+			return fmt.Errorf("%w: parent runner %s is in the %s namespace", cerrors.New(cerrors.INVALID_DATA), current, namespaces.Name(parent.Namespace))
+		}
+		current = parent.ParentID
+	}
+
+	return nil
+}
+
+// validateLimits makes sure the default limit profile exists and no
+// multiplier is negative or not a number. 0 leaves the limit unchanged.
+func (l *runnerService) validateLimits(ctx context.Context, profileID string, multipliers *models.LimitMultipliers) error {
+	if profileID != "" {
+		_, err := l.limitProfiles.GetByID(ctx, profileID)
+		if err != nil {
+			return fmt.Errorf("%w: limit profile %s not found", cerrors.New(cerrors.INVALID_DATA), profileID)
+		}
+	}
+
+	if multipliers == nil {
+		return nil
+	}
+	for _, m := range []float32{multipliers.CPUTime, multipliers.WallTime, multipliers.Memory} {
+		if m < 0 || math.IsNaN(float64(m)) || math.IsInf(float64(m), 0) {
+			return fmt.Errorf("%w: limit multipliers must be finite and not negative", cerrors.New(cerrors.INVALID_DATA))
+		}
+	}
+	return nil
+}
+
+func (l *runnerService) cachedLookup(ctx context.Context, cache map[string]*models.Runner) func(ID string) (*models.Runner, error) {
+	if cache == nil {
+		cache = map[string]*models.Runner{}
+	}
+
+	return func(ID string) (*models.Runner, error) {
+		if runner, ok := cache[ID]; ok {
+			return runner, nil
+		}
+
+		runner, err := l.repo.GetByID(ctx, ID)
+		if err != nil {
+			return nil, err
+		}
+		cache[ID] = runner
+		return runner, nil
+	}
+}
+
+// resolveRunner merges a runner on top of its ancestors, the closest one
+// winning, then renders its scripts, starter template and text files with the
+// merged variables.
+func resolveRunner(ctx context.Context, runner *models.Runner, lookup func(ID string) (*models.Runner, error), fileService FileService) (*models.Runner, error) {
+	chain := []*models.Runner{runner}
+	seen := map[string]bool{runner.ID: true}
+	for current := runner; current.ParentID != ""; {
+		if seen[current.ParentID] || len(chain) > maxRunnerDepth {
+			return nil, fmt.Errorf("%w: runner %s has a cyclic parent chain", cerrors.New(cerrors.INVALID_DATA), runner.ID)
+		}
+
+		parent, err := lookup(current.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("Cannot resolve parent %s of runner %s : %v", current.ParentID, current.ID, err)
+		}
+		seen[parent.ID] = true
+		chain = append(chain, parent)
+		current = parent
+	}
+
+	effective := models.Runner{
+		ID:          runner.ID,
+		Name:        runner.Name,
+		Description: runner.Description,
+		ParentID:    runner.ParentID,
+		Variables:   map[string]string{},
+		Slug:        runner.Slug,
+		Aliases:     runner.Aliases,
+		Metadata:    runner.Metadata,
+		Namespace:   runner.Namespace,
+		Audit:       runner.Audit,
+	}
+
+	var files []models.File
+	for i := len(chain) - 1; i >= 0; i-- {
+		ancestor := chain[i]
+		if ancestor.BuildScript != "" {
+			effective.BuildScript = ancestor.BuildScript
+		}
+		if ancestor.RunScript != "" {
+			effective.RunScript = ancestor.RunScript
+		}
+		if ancestor.StarterTemplate != "" {
+			effective.StarterTemplate = ancestor.StarterTemplate
+		}
+		if ancestor.EntryPoint != "" {
+			effective.EntryPoint = ancestor.EntryPoint
+		}
+		if ancestor.DefaultLimitProfileID != "" {
+			effective.DefaultLimitProfileID = ancestor.DefaultLimitProfileID
+		}
+		if ancestor.LimitMultipliers != nil {
+			effective.LimitMultipliers = ancestor.LimitMultipliers
+		}
+		files = mergeFiles(files, ancestor.InitialFiles)
+		effective.Env = mergeEnv(effective.Env, ancestor.Env)
+		maps.Copy(effective.Variables, ancestor.Variables)
+	}
+
+	effective.BuildScript = templates.Render(effective.BuildScript, effective.Variables)
+	effective.RunScript = templates.Render(effective.RunScript, effective.Variables)
+	effective.StarterTemplate = templates.Render(effective.StarterTemplate, effective.Variables)
+	files, err := fileService.Resolve(ctx, files)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range files {
+		rendered, err := renderFile(ctx, f, effective.Variables, fileService)
+		if err != nil {
+			return nil, fmt.Errorf("Cannot render file %s of runner %s : %v", f.Name, runner.ID, err)
+		}
+		effective.InitialFiles = append(effective.InitialFiles, rendered)
+	}
+
+	return &effective, nil
+}
+
+// renderFile substitutes the variables into a text file. A rendered file is
+// returned inline since its content no longer matches the stored blob.
+func renderFile(ctx context.Context, f models.File, vars map[string]string, blobs models.BlobReader) (models.File, error) {
+	if len(vars) == 0 || (f.IsReference() && f.Size > models.MaxInlineFileSize) {
+		return f, nil
+	}
+
+	data, err := f.Load(ctx, blobs)
+	if err != nil {
+		return f, err
+	}
+	if !utf8.Valid(data) {
+		return f, nil
+	}
+
+	rendered := templates.Render(string(data), vars)
+	if rendered == string(data) {
+		return f, nil
+	}
+
+	return models.File{
+		Name:    f.Name,
+		Content: rendered,
+		Size:    int64(len(rendered)),
+		Mode:    f.Mode,
+	}, nil
+}
+
+// mergeFiles overrides files with the same name and appends the rest.
+func mergeFiles(base []models.File, overrides []models.File) []models.File {
+	merged := append([]models.File(nil), base...)
+	for _, f := range overrides {
+		replaced := false
+		for i := range merged {
+			if merged[i].Name == f.Name {
+				merged[i] = f
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, f)
+		}
+	}
+	return merged
+}
+
+// mergeEnv overrides variables with the same name and appends the rest.
+func mergeEnv(base []models.EnvVar, overrides []models.EnvVar) []models.EnvVar {
+	merged := append([]models.EnvVar(nil), base...)
+	for _, e := range overrides {
+		i := slices.IndexFunc(merged, func(m models.EnvVar) bool { return m.Name == e.Name })
+		if i >= 0 {
+			merged[i] = e
+		} else {
+			merged = append(merged, e)
+		}
+	}
+	return merged
+}
+
+// normalizeMetadata lowercases the language, tags and extensions so they can
+// be matched exactly, giving every extension its dot.
+func normalizeMetadata(metadata *models.RunnerMetadata) *models.RunnerMetadata {
+	if metadata == nil {
+		return nil
+	}
+
+	normalized := *metadata
+	normalized.Language = normalizeValue(metadata.Language, "")
+	normalized.Extensions = normalizeList(metadata.Extensions, ".")
+	normalized.Tags = normalizeList(metadata.Tags, "")
+	return &normalized
+}
+
+// normalizeValue lowercases and trims value, prefixing it with prefix unless
+// it's empty.
+func normalizeValue(value string, prefix string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value != "" && !strings.HasPrefix(value, prefix) {
+		value = prefix + value
+	}
+	return value
+}
+
+// normalizeList normalizes every value, dropping empty and repeated ones.
+func normalizeList(values []string, prefix string) []string {
+	var normalized []string
+	for _, value := range values {
+		value = normalizeValue(value, prefix)
+		if value != "" && !slices.Contains(normalized, value) {
+			normalized = append(normalized, value)
+		}
+	}
+	return normalized
 }
@@ -0,0 +1,366 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+	"github.com/google/uuid"
+)
+
+type sharedFileService struct {
+	repo        repositories.SharedFileRepository
+	runnerRepo  repositories.RunnerRepository
+	compareRepo repositories.CompareRepository
+	files       FileService
+	revisions   RevisionService
+	goldenCases GoldenCaseVerifier
+	transactor  repositories.Transactor
+}
+
+type SharedFileService interface {
+	// Create returns the ID of the shared file and the number of runners and
+	// compares that got linked to it.
+	Create(ctx context.Context, body *requests.CreateSharedFile) (string, int, error)
+	GetAll(ctx context.Context) ([]models.SharedFile, error)
+	GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.SharedFile, int, error)
+	GetByID(ctx context.Context, ID string) (*models.SharedFile, error)
+	UpdateByID(ctx context.Context, ID string, body *requests.UpdateSharedFile) error
+	DeleteByID(ctx context.Context, ID string) error
+	// GetUsages lists the runner and compare files having the given content or
+	// linked to the given shared file, including files linked to a shared
+	// file having that content.
+	GetUsages(ctx context.Context, sha256 string, sharedFileID string) ([]models.FileUsage, error)
+}
+
+func NewSharedFileService(repo repositories.SharedFileRepository, runnerRepo repositories.RunnerRepository, compareRepo repositories.CompareRepository, files FileService, revisions RevisionService, goldenCases GoldenCaseVerifier, transactor repositories.Transactor) SharedFileService {
+	return &sharedFileService{
+		repo:        repo,
+		runnerRepo:  runnerRepo,
+		compareRepo: compareRepo,
+		files:       files,
+		revisions:   revisions,
+		goldenCases: goldenCases,
+		transactor:  transactor,
+	}
+}
+
+func (s *sharedFileService) Create(ctx context.Context, body *requests.CreateSharedFile) (string, int, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", 0, err
+	}
+
+	body.File, err = s.storeFile(ctx, body.Name, body.File)
+	if err != nil {
+		return "", 0, err
+	}
+
+	// The shared file is only created along with its links, which are
+	// recorded as a single revision once committed.
+	var (
+		linked  int
+		changes *ChangeSet
+	)
+	err = s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		// The transaction may be retried, only the last try counts.
+		linked, changes = 0, &ChangeSet{}
+
+		err := s.repo.Create(ctx, id.String(), body)
+		if err != nil {
+			return err
+		}
+
+		if !body.LinkExisting {
+			return nil
+		}
+
+		linked, err = s.linkExisting(WithChangeSet(ctx, changes), id.String(), body.File.SHA256)
+		return err
+	})
+	if err != nil {
+		return "", 0, err
+	}
+
+	_, err = s.revisions.Record(ctx, changes.Changes()...)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return id.String(), linked, nil
+}
+
+func (s *sharedFileService) GetAll(ctx context.Context) ([]models.SharedFile, error) {
+	return s.repo.GetAll(ctx)
+}
+
+func (s *sharedFileService) GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.SharedFile, int, error) {
+	pagination, err := s.repo.GetPagination(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.repo.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return pagination, count, nil
+}
+
+func (s *sharedFileService) GetByID(ctx context.Context, ID string) (*models.SharedFile, error) {
+	return s.repo.GetByID(ctx, ID)
+}
+
+func (s *sharedFileService) UpdateByID(ctx context.Context, ID string, body *requests.UpdateSharedFile) error {
+	existing, err := s.repo.GetByID(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	if body.File != nil {
+		name := existing.Name
+		if body.Name != nil {
+			name = *body.Name
+		}
+
+		file, err := s.storeFile(ctx, name, *body.File)
+		if err != nil {
+			return err
+		}
+		body.File = &file
+
+		err = s.verifyLinkedCompares(ctx, ID, file)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.repo.UpdateByID(ctx, ID, body)
+	if err != nil {
+		return err
+	}
+
+	changes, err := s.revisions.SharedFileChanges(ctx, ID)
+	if err != nil {
+		return err
+	}
+	_, err = s.revisions.Record(ctx, changes...)
+	return err
+}
+
+func (s *sharedFileService) DeleteByID(ctx context.Context, ID string) error {
+	usages, err := s.GetUsages(ctx, "", ID)
+	if err != nil {
+		return err
+	}
+	if len(usages) > 0 {
+		return fmt.Errorf("%w: shared file %s is linked by %d file(s)", cerrors.New(cerrors.DATA_IN_USE), ID, len(usages))
+	}
+
+	return s.repo.DeleteByID(ctx, ID)
+}
+
+func (s *sharedFileService) GetUsages(ctx context.Context, sha256 string, sharedFileID string) ([]models.FileUsage, error) {
+	sharedIDs := map[string]bool{}
+	if sharedFileID != "" {
+		sharedIDs[sharedFileID] = true
+	}
+
+	if sha256 != "" {
+		sharedFiles, err := s.repo.GetAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, shared := range sharedFiles {
+			if shared.File.SHA256 == sha256 {
+				sharedIDs[shared.ID] = true
+			}
+		}
+	}
+
+	matches := func(f models.File) bool {
+		return (sha256 != "" && f.SHA256 == sha256) || sharedIDs[f.SharedFileID]
+	}
+
+	// Documents can match more than one query, so they are keyed by ID.
+	queries := [][2]string{{sha256, sharedFileID}}
+	for ID := range sharedIDs {
+		if ID != sharedFileID {
+			queries = append(queries, [2]string{"", ID})
+		}
+	}
+
+	runners := map[string]models.Runner{}
+	compares := map[string]models.Compare{}
+	for _, query := range queries {
+		foundRunners, err := s.runnerRepo.GetByFile(ctx, query[0], query[1])
+		if err != nil {
+			return nil, err
+		}
+		for _, runner := range foundRunners {
+			runners[runner.ID] = runner
+		}
+
+		foundCompares, err := s.compareRepo.GetByFile(ctx, query[0], query[1])
+		if err != nil {
+			return nil, err
+		}
+		for _, compare := range foundCompares {
+			compares[compare.ID] = compare
+		}
+	}
+
+	var usages []models.FileUsage
+	for _, runner := range runners {
+		for _, f := range runner.InitialFiles {
+			if matches(f) {
+				usages = append(usages, models.FileUsage{
+					Kind:         models.FileUsageRunner,
+					ID:           runner.ID,
+					Name:         runner.Name,
+					FileName:     f.Name,
+					SharedFileID: f.SharedFileID,
+				})
+			}
+		}
+	}
+	for _, compare := range compares {
+		for _, f := range compare.Files {
+			if matches(f) {
+				usages = append(usages, models.FileUsage{
+					Kind:         models.FileUsageCompare,
+					ID:           compare.ID,
+					Name:         compare.Name,
+					FileName:     f.Name,
+					SharedFileID: f.SharedFileID,
+				})
+			}
+		}
+	}
+
+	return usages, nil
+}
+
+// verifyLinkedCompares runs the golden cases of the compares linking the
+// shared file as if it already had the new content.
+func (s *sharedFileService) verifyLinkedCompares(ctx context.Context, ID string, file models.File) error {
+	compares, err := s.compareRepo.GetByFile(ctx, "", ID)
+	if err != nil {
+		return err
+	}
+
+	for _, compare := range compares {
+		files := slices.Clone(compare.Files)
+		for i, f := range files {
+			if f.SharedFileID == ID {
+				files[i] = models.File{Name: f.Name, Mode: cmp.Or(f.Mode, file.Mode), SHA256: file.SHA256, Size: file.Size}
+			}
+		}
+		compare.Files = files
+
+		err = s.goldenCases.Verify(ctx, &compare)
+		if err != nil {
+			return fmt.Errorf("Cannot update shared file %s linked by compare %q : %w", ID, compare.Name, err)
+		}
+	}
+	return nil
+}
+
+// storeFile moves the content of a shared file into the blob store, naming
+// the file after the shared file when no name is given.
+func (s *sharedFileService) storeFile(ctx context.Context, name string, file models.File) (models.File, error) {
+	if file.SharedFileID != "" {
+		return models.File{}, fmt.Errorf("%w: a shared file cannot link another shared file", cerrors.New(cerrors.INVALID_DATA))
+	}
+	if file.Name == "" {
+		file.Name = name
+	}
+
+	stored, err := s.files.Store(ctx, []models.File{file})
+	if err != nil {
+		return models.File{}, err
+	}
+	return stored[0], nil
+}
+
+// linkExisting replaces the runner and compare files having the given
+// content with links to the shared file. Preset compares are left alone since
+// their files are generated.
+func (s *sharedFileService) linkExisting(ctx context.Context, ID string, sha256 string) (int, error) {
+	link := func(files []models.File) ([]models.File, bool) {
+		linked := false
+		for i, f := range files {
+			if f.SharedFileID == "" && f.SHA256 == sha256 {
+				files[i] = models.File{Name: f.Name, Mode: f.Mode, SharedFileID: ID}
+				linked = true
+			}
+		}
+		return files, linked
+	}
+
+	now, author := stamp(ctx)
+	count := 0
+	runners, err := s.runnerRepo.GetByFile(ctx, sha256, "")
+	if err != nil {
+		return 0, err
+	}
+	for _, runner := range runners {
+		files, linked := link(runner.InitialFiles)
+		if !linked {
+			continue
+		}
+
+		err = s.runnerRepo.UpdateByID(ctx, runner.ID, &requests.UpdateRunner{
+			InitialFiles: files,
+			UpdatedAt:    &now,
+			UpdatedBy:    &author,
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		_, err = s.revisions.Record(ctx, models.Change{Kind: models.ChangeRunner, Action: models.ChangeUpdated, ID: runner.ID, Namespace: runner.Namespace})
+		if err != nil {
+			return 0, err
+		}
+		count++
+	}
+
+	compares, err := s.compareRepo.GetByFile(ctx, sha256, "")
+	if err != nil {
+		return 0, err
+	}
+	for _, compare := range compares {
+		if compare.IsReadOnly() {
+			continue
+		}
+
+		files, linked := link(compare.Files)
+		if !linked {
+			continue
+		}
+
+		err = s.compareRepo.UpdateByID(ctx, compare.ID, &requests.UpdateCompare{
+			Files:     files,
+			UpdatedAt: &now,
+			UpdatedBy: &author,
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		_, err = s.revisions.Record(ctx, models.Change{Kind: models.ChangeCompare, Action: models.ChangeUpdated, ID: compare.ID, Namespace: compare.Namespace})
+		if err != nil {
+			return 0, err
+		}
+		count++
+	}
+
+	return count, nil
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/namespaces"
+	"github.com/google/uuid"
+)
+
+const maxSlugLength = 63
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(?:[-._][a-z0-9]+)*$`)
+
+// validateSlug checks that slug is lowercase letters and digits separated by
+// single dashes, dots or underscores, e.g. python-3.12. Slugs never look like
+// IDs, so a reference to either is never ambiguous.
+func validateSlug(slug string) error {
+	if len(slug) > maxSlugLength || !slugPattern.MatchString(slug) {
+		return fmt.Errorf("%w: invalid slug %q, expected lowercase letters and digits separated by -, . or _", cerrors.New(cerrors.INVALID_DATA), slug)
+	}
+	if _, err := uuid.Parse(slug); err == nil {
+		return fmt.Errorf("%w: slug %q must not be an ID", cerrors.New(cerrors.INVALID_DATA), slug)
+	}
+	return nil
+}
+
+// isID reports whether ref is an ID rather than a slug.
+func isID(ref string) bool {
+	_, err := uuid.Parse(ref)
+	return err == nil
+}
+
+// checkSlugFree fails when slug is the slug or an alias of another document
+// than ID, found by getBySlug with the ID and namespace of the document.
+// Slugs are unique across every namespace, but the ID of a document the
+// caller cannot see is left out of the conflict.
+func checkSlugFree(ctx context.Context, kind string, ID string, slug string, getBySlug func(ctx context.Context, slug string) (string, string, error)) error {
+	existingID, namespace, err := getBySlug(namespaces.Unscoped(ctx), slug)
+	if errors.Is(err, cerrors.CANNOT_GET_DATA) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if existingID != ID {
+		conflict := &cerrors.ConflictError{Kind: kind, Name: slug}
+		if namespaces.IsVisible(ctx, namespace) {
+			conflict.ID = existingID
+		}
+		return conflict
+	}
+	return nil
+}
+
+// renamedAliases returns the aliases of a document renamed from slug to
+// newSlug. The previous slug becomes an alias, so references to it keep
+// working, and newSlug stops being one.
+func renamedAliases(aliases []string, slug string, newSlug string) []string {
+	aliases = slices.DeleteFunc(slices.Clone(aliases), func(alias string) bool {
+		return alias == newSlug
+	})
+	if slug != "" && slug != newSlug && !slices.Contains(aliases, slug) {
+		aliases = append(aliases, slug)
+	}
+	return aliases
+}
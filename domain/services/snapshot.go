@@ -0,0 +1,587 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+	"github.com/google/uuid"
+)
+
+type snapshotService struct {
+	runnerRepo       repositories.RunnerRepository
+	compareRepo      repositories.CompareRepository
+	limitProfileRepo repositories.LimitProfileRepository
+	sharedFileRepo   repositories.SharedFileRepository
+	blobs            repositories.BlobRepository
+	revisions        RevisionService
+	goldenCases      GoldenCaseVerifier
+}
+
+type SnapshotService interface {
+	Export(ctx context.Context) (*models.Snapshot, error)
+	// Import restores a snapshot and returns what it changed, or would change
+	// with DryRun.
+	Import(ctx context.Context, snapshot *models.Snapshot, opts *requests.ImportSnapshot) ([]models.SnapshotChange, error)
+}
+
+func NewSnapshotService(runnerRepo repositories.RunnerRepository, compareRepo repositories.CompareRepository, limitProfileRepo repositories.LimitProfileRepository, sharedFileRepo repositories.SharedFileRepository, blobs repositories.BlobRepository, revisions RevisionService, goldenCases GoldenCaseVerifier) SnapshotService {
+	return &snapshotService{
+		runnerRepo:       runnerRepo,
+		compareRepo:      compareRepo,
+		limitProfileRepo: limitProfileRepo,
+		sharedFileRepo:   sharedFileRepo,
+		blobs:            blobs,
+		revisions:        revisions,
+		goldenCases:      goldenCases,
+	}
+}
+
+func limitProfileKey(l *models.LimitProfile) (string, string) { return l.ID, l.Name }
+func sharedFileKey(f *models.SharedFile) (string, string)     { return f.ID, f.Name }
+func runnerKey(r *models.Runner) (string, string)             { return r.ID, r.Name }
+func compareKey(c *models.Compare) (string, string)           { return c.ID, c.Name }
+
+// Runner and compare names are only unique within a namespace, so they are
+// matched by both.
+func runnerNameKey(r *models.Runner) (string, string)   { return r.ID, r.Namespace + "/" + r.Name }
+func compareNameKey(c *models.Compare) (string, string) { return c.ID, c.Namespace + "/" + c.Name }
+
+// stored is the configuration as currently stored.
+type stored struct {
+	limitProfiles []models.LimitProfile
+	sharedFiles   []models.SharedFile
+	runners       []models.Runner
+	compares      []models.Compare
+}
+
+func (s *snapshotService) load(ctx context.Context) (*stored, error) {
+	var (
+		st  stored
+		err error
+	)
+	st.limitProfiles, err = s.limitProfileRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	st.sharedFiles, err = s.sharedFileRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	st.runners, err = s.runnerRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	st.compares, err = s.compareRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &st, nil
+}
+
+func (s *snapshotService) Export(ctx context.Context) (*models.Snapshot, error) {
+	st, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Snapshot{
+		Version:       models.SnapshotVersion,
+		CreatedAt:     time.Now().UTC(),
+		LimitProfiles: st.limitProfiles,
+		SharedFiles:   st.sharedFiles,
+		Runners:       parentsFirst(st.runners),
+		Compares:      st.compares,
+	}, nil
+}
+
+func (s *snapshotService) Import(ctx context.Context, snapshot *models.Snapshot, opts *requests.ImportSnapshot) ([]models.SnapshotChange, error) {
+	if snapshot.Version < 1 || snapshot.Version > models.SnapshotVersion {
+		return nil, fmt.Errorf("%w: unsupported snapshot version %d", cerrors.New(cerrors.INVALID_DATA), snapshot.Version)
+	}
+
+	mode := opts.Mode
+	if mode == "" {
+		mode = models.RestoreMerge
+	}
+	if mode != models.RestoreMerge && mode != models.RestoreReplace {
+		return nil, fmt.Errorf("%w: unknown restore mode %q", cerrors.New(cerrors.INVALID_DATA), mode)
+	}
+
+	err := s.checkBlobs(ctx, snapshot, opts.Blobs)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotIDs, err := remap(snapshot, st, opts.RemapIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		changes []models.SnapshotChange
+		deletes []models.SnapshotChange
+	)
+	add := func(kind models.SnapshotKind, c []models.SnapshotChange, d []models.SnapshotChange) {
+		for i := range c {
+			c[i].Kind = kind
+			c[i].SnapshotID = snapshotIDs[c[i].ID]
+		}
+		for i := range d {
+			d[i].Kind = kind
+		}
+		changes = append(changes, c...)
+		deletes = append(deletes, d...)
+	}
+
+	// Deletes happen first so that documents of the snapshot can take their
+	// names. Reversing them puts compares before runners and children before
+	// their parents.
+	c, d := diffDocs(snapshot.LimitProfiles, st.limitProfiles, limitProfileKey, mode)
+	add(models.SnapshotLimitProfile, c, d)
+	c, d = diffDocs(snapshot.SharedFiles, st.sharedFiles, sharedFileKey, mode)
+	add(models.SnapshotSharedFile, c, d)
+	snapshot.Runners = parentsFirst(snapshot.Runners)
+	c, d = diffDocs(snapshot.Runners, parentsFirst(st.runners), runnerKey, mode)
+	add(models.SnapshotRunner, c, d)
+	c, d = diffDocs(snapshot.Compares, st.compares, compareKey, mode)
+	add(models.SnapshotCompare, c, d)
+	slices.Reverse(deletes)
+
+	changes = append(deletes, changes...)
+	if opts.DryRun {
+		return changes, nil
+	}
+
+	for digest, data := range opts.Blobs {
+		err := s.blobs.Put(ctx, digest, data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	limitProfiles := index(snapshot.LimitProfiles, limitProfileKey)
+	sharedFiles := index(snapshot.SharedFiles, sharedFileKey)
+	runners := index(snapshot.Runners, runnerKey)
+	compares := index(snapshot.Compares, compareKey)
+	before := namespacesByID(st.runners, st.compares)
+	after := namespacesByID(snapshot.Runners, snapshot.Compares)
+	var (
+		applied            []models.Change
+		updatedSharedFiles []string
+	)
+	for _, change := range changes {
+		if change.Action == models.SnapshotUnchanged {
+			continue
+		}
+
+		var err error
+		switch change.Kind {
+		case models.SnapshotLimitProfile:
+			err = s.putLimitProfile(ctx, change, limitProfiles[change.ID])
+		case models.SnapshotSharedFile:
+			err = s.putSharedFile(ctx, change, sharedFiles[change.ID])
+		case models.SnapshotRunner:
+			err = s.putRunner(ctx, change, runners[change.ID])
+		case models.SnapshotCompare:
+			err = s.putCompare(ctx, change, compares[change.ID])
+		}
+		if err != nil {
+			// What got applied is still recorded.
+			s.record(ctx, applied, updatedSharedFiles)
+			return nil, fmt.Errorf("Cannot %s %s %q : %w", change.Action, change.Kind, change.Name, err)
+		}
+
+		switch change.Kind {
+		case models.SnapshotSharedFile:
+			if change.Action == models.SnapshotUpdate {
+				updatedSharedFiles = append(updatedSharedFiles, change.ID)
+			}
+		case models.SnapshotRunner:
+			applied = append(applied, appliedChanges(models.ChangeRunner, change, before[change.ID], after[change.ID])...)
+		case models.SnapshotCompare:
+			applied = append(applied, appliedChanges(models.ChangeCompare, change, before[change.ID], after[change.ID])...)
+		}
+	}
+
+	err = s.record(ctx, applied, updatedSharedFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// record stores the applied changes as a revision, along with the runners and
+// compares linking an updated shared file.
+func (s *snapshotService) record(ctx context.Context, applied []models.Change, sharedFiles []string) error {
+	for _, ID := range sharedFiles {
+		changes, err := s.revisions.SharedFileChanges(ctx, ID)
+		if err != nil {
+			return err
+		}
+		applied = append(applied, changes...)
+	}
+
+	_, err := s.revisions.Record(ctx, applied...)
+	return err
+}
+
+// appliedChanges returns the changes of a runner or compare of the snapshot,
+// stored in the from namespace before and in the to namespace after.
+func appliedChanges(kind models.ChangeKind, change models.SnapshotChange, from string, to string) []models.Change {
+	switch change.Action {
+	case models.SnapshotCreate:
+		return []models.Change{{Kind: kind, Action: models.ChangeCreated, ID: change.ID, Namespace: to}}
+	case models.SnapshotDelete:
+		return []models.Change{{Kind: kind, Action: models.ChangeDeleted, ID: change.ID, Namespace: from}}
+	}
+	if from != to {
+		return movedChanges(kind, change.ID, from, to)
+	}
+	return []models.Change{{Kind: kind, Action: models.ChangeUpdated, ID: change.ID, Namespace: to}}
+}
+
+// namespacesByID maps the ID of every runner and compare to its namespace.
+func namespacesByID(runners []models.Runner, compares []models.Compare) map[string]string {
+	byID := make(map[string]string, len(runners)+len(compares))
+	for _, runner := range runners {
+		byID[runner.ID] = runner.Namespace
+	}
+	for _, compare := range compares {
+		byID[compare.ID] = compare.Namespace
+	}
+	return byID
+}
+
+// checkBlobs makes sure every file of the snapshot has its content, either
+// sent along or already stored.
+func (s *snapshotService) checkBlobs(ctx context.Context, snapshot *models.Snapshot, blobs map[string][]byte) error {
+	for digest, data := range blobs {
+		sum := sha256.Sum256(data)
+		if hex.EncodeToString(sum[:]) != digest {
+			return fmt.Errorf("%w: blob %s doesn't match its sha256", cerrors.New(cerrors.INVALID_DATA), digest)
+		}
+	}
+
+	for _, digest := range snapshot.Digests() {
+		if _, ok := blobs[digest]; ok {
+			continue
+		}
+
+		reader, _, err := s.blobs.Open(ctx, digest)
+		if err != nil {
+			return fmt.Errorf("%w: blob %s is missing from the snapshot", cerrors.New(cerrors.INVALID_DATA), digest)
+		}
+		reader.Close()
+	}
+	return nil
+}
+
+// remap rewrites the IDs of the snapshot, and every reference to them, and
+// returns the snapshot ID of every new ID. Without remapIDs the IDs are kept.
+func remap(snapshot *models.Snapshot, st *stored, remapIDs bool) (map[string]string, error) {
+	ids := map[string]string{}
+	err := assignIDs(snapshot.LimitProfiles, st.limitProfiles, limitProfileKey, remapIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	err = assignIDs(snapshot.SharedFiles, st.sharedFiles, sharedFileKey, remapIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	err = assignIDs(snapshot.Runners, st.runners, runnerNameKey, remapIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	err = assignIDs(snapshot.Compares, st.compares, compareNameKey, remapIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// References to documents missing from the snapshot are kept as is.
+	rewrite := func(ID string) string {
+		if newID, ok := ids[ID]; ok {
+			return newID
+		}
+		return ID
+	}
+	rewriteFiles := func(files []models.File) {
+		for i := range files {
+			if files[i].SharedFileID != "" {
+				files[i].SharedFileID = rewrite(files[i].SharedFileID)
+			}
+		}
+	}
+
+	for i := range snapshot.LimitProfiles {
+		snapshot.LimitProfiles[i].ID = rewrite(snapshot.LimitProfiles[i].ID)
+	}
+	for i := range snapshot.SharedFiles {
+		snapshot.SharedFiles[i].ID = rewrite(snapshot.SharedFiles[i].ID)
+	}
+	for i := range snapshot.Runners {
+		runner := &snapshot.Runners[i]
+		runner.ID = rewrite(runner.ID)
+		if runner.ParentID != "" {
+			runner.ParentID = rewrite(runner.ParentID)
+		}
+		if runner.DefaultLimitProfileID != "" {
+			runner.DefaultLimitProfileID = rewrite(runner.DefaultLimitProfileID)
+		}
+		rewriteFiles(runner.InitialFiles)
+	}
+	for i := range snapshot.Compares {
+		snapshot.Compares[i].ID = rewrite(snapshot.Compares[i].ID)
+		rewriteFiles(snapshot.Compares[i].Files)
+	}
+
+	snapshotIDs := make(map[string]string, len(ids))
+	for ID, newID := range ids {
+		snapshotIDs[newID] = ID
+	}
+	return snapshotIDs, nil
+}
+
+// assignIDs picks the new ID of every document, which is the ID of the
+// stored document with the same key name when remapping.
+func assignIDs[T any](docs []T, current []T, key func(doc *T) (string, string), remapIDs bool, ids map[string]string) error {
+	storedByName := make(map[string]string, len(current))
+	for i := range current {
+		ID, name := key(&current[i])
+		storedByName[name] = ID
+	}
+
+	for i := range docs {
+		ID, name := key(&docs[i])
+		if !remapIDs {
+			ids[ID] = ID
+			continue
+		}
+
+		newID, ok := storedByName[name]
+		if !ok {
+			id, err := uuid.NewV7()
+			if err != nil {
+				return err
+			}
+			newID = id.String()
+		}
+		ids[ID] = newID
+	}
+	return nil
+}
+
+// diffDocs compares the documents of a snapshot with the stored ones, by ID.
+// Stored documents missing from the snapshot are only deleted in replace
+// mode.
+func diffDocs[T any](desired []T, current []T, key func(doc *T) (string, string), mode models.RestoreMode) ([]models.SnapshotChange, []models.SnapshotChange) {
+	currentByID := index(current, key)
+
+	var changes []models.SnapshotChange
+	inSnapshot := map[string]bool{}
+	for i := range desired {
+		ID, name := key(&desired[i])
+		inSnapshot[ID] = true
+
+		change := models.SnapshotChange{Action: models.SnapshotCreate, ID: ID, Name: name}
+		if existing, ok := currentByID[ID]; ok {
+			change.Action = models.SnapshotUpdate
+			if reflect.DeepEqual(existing, &desired[i]) {
+				change.Action = models.SnapshotUnchanged
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	var deletes []models.SnapshotChange
+	if mode == models.RestoreReplace {
+		for i := range current {
+			ID, name := key(&current[i])
+			if !inSnapshot[ID] {
+				deletes = append(deletes, models.SnapshotChange{Action: models.SnapshotDelete, ID: ID, Name: name})
+			}
+		}
+	}
+
+	return changes, deletes
+}
+
+func index[T any](docs []T, key func(doc *T) (string, string)) map[string]*T {
+	byID := make(map[string]*T, len(docs))
+	for i := range docs {
+		ID, _ := key(&docs[i])
+		byID[ID] = &docs[i]
+	}
+	return byID
+}
+
+// parentsFirst orders runners so that every parent comes before its
+// children.
+func parentsFirst(runners []models.Runner) []models.Runner {
+	byID := make(map[string]bool, len(runners))
+	for _, runner := range runners {
+		byID[runner.ID] = true
+	}
+
+	sorted := make([]models.Runner, 0, len(runners))
+	added := map[string]bool{}
+	for len(sorted) < len(runners) {
+		progress := false
+		for _, runner := range runners {
+			if added[runner.ID] {
+				continue
+			}
+			if byID[runner.ParentID] && !added[runner.ParentID] {
+				continue
+			}
+			sorted = append(sorted, runner)
+			added[runner.ID] = true
+			progress = true
+		}
+
+		// A cycle can't be ordered, so the rest is kept as is.
+		if !progress {
+			for _, runner := range runners {
+				if !added[runner.ID] {
+					sorted = append(sorted, runner)
+				}
+			}
+			break
+		}
+	}
+	return sorted
+}
+
+// Documents are overwritten by deleting and creating them again, so that
+// every field is restored exactly.
+
+func (s *snapshotService) putLimitProfile(ctx context.Context, change models.SnapshotChange, profile *models.LimitProfile) error {
+	switch change.Action {
+	case models.SnapshotDelete:
+		return s.limitProfileRepo.DeleteByID(ctx, change.ID)
+	case models.SnapshotUpdate:
+		err := s.limitProfileRepo.DeleteByID(ctx, change.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.limitProfileRepo.Create(ctx, profile.ID, &requests.CreateLimitProfile{
+		Name:        profile.Name,
+		Description: profile.Description,
+		Limit:       profile.Limit,
+	})
+}
+
+func (s *snapshotService) putSharedFile(ctx context.Context, change models.SnapshotChange, sharedFile *models.SharedFile) error {
+	switch change.Action {
+	case models.SnapshotDelete:
+		return s.sharedFileRepo.DeleteByID(ctx, change.ID)
+	case models.SnapshotUpdate:
+		err := s.sharedFileRepo.DeleteByID(ctx, change.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.sharedFileRepo.Create(ctx, sharedFile.ID, &requests.CreateSharedFile{
+		Name:        sharedFile.Name,
+		Description: sharedFile.Description,
+		File:        sharedFile.File,
+	})
+}
+
+func (s *snapshotService) putRunner(ctx context.Context, change models.SnapshotChange, runner *models.Runner) error {
+	switch change.Action {
+	case models.SnapshotDelete:
+		return s.runnerRepo.DeleteByID(ctx, change.ID)
+	case models.SnapshotUpdate:
+		err := s.runnerRepo.DeleteByID(ctx, change.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Secrets are restored sealed, as they were exported.
+	err := s.runnerRepo.Create(ctx, runner.ID, &requests.CreateRunner{
+		Name:        runner.Name,
+		Slug:        runner.Slug,
+		Aliases:     runner.Aliases,
+		Description: runner.Description,
+		ParentID:    runner.ParentID,
+		Variables:   runner.Variables,
+		Env:         runner.Env,
+
+		DefaultLimitProfileID: runner.DefaultLimitProfileID,
+		LimitMultipliers:      runner.LimitMultipliers,
+		Metadata:              runner.Metadata,
+
+		Namespace: runner.Namespace,
+		Audit:     runner.Audit,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.runnerRepo.UpdateByID(ctx, runner.ID, &requests.UpdateRunner{
+		BuildScript:  &runner.BuildScript,
+		RunScript:    &runner.RunScript,
+		InitialFiles: runner.InitialFiles,
+
+		StarterTemplate: &runner.StarterTemplate,
+		EntryPoint:      &runner.EntryPoint,
+	})
+}
+
+// Compares are put last, once the shared files and blobs they use are stored,
+// so that their golden cases can run.
+func (s *snapshotService) putCompare(ctx context.Context, change models.SnapshotChange, compare *models.Compare) error {
+	if change.Action != models.SnapshotDelete {
+		err := s.goldenCases.Verify(ctx, compare)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch change.Action {
+	case models.SnapshotDelete:
+		return s.compareRepo.DeleteByID(ctx, change.ID)
+	case models.SnapshotUpdate:
+		err := s.compareRepo.DeleteByID(ctx, change.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.compareRepo.Create(ctx, compare.ID, &requests.CreateCompare{
+		Name:          compare.Name,
+		Slug:          compare.Slug,
+		Aliases:       compare.Aliases,
+		Files:         compare.Files,
+		BuildScript:   compare.BuildScript,
+		RunScript:     compare.RunScript,
+		RunName:       compare.RunName,
+		Description:   compare.Description,
+		GoldenCases:   compare.GoldenCases,
+		PresetID:      compare.PresetID,
+		PresetVersion: compare.PresetVersion,
+		PresetParams:  compare.PresetParams,
+
+		Namespace: compare.Namespace,
+		Audit:     compare.Audit,
+	})
+}
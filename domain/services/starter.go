@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path"
+	"strings"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+	"github.com/CSKU-Lab/config-server/domain/templates"
+)
+
+const defaultEntryPoint = "main"
+
+func (l *runnerService) RenderStarter(ctx context.Context, ID string, task *requests.RenderStarter) (string, string, error) {
+	runner, err := l.GetByID(ctx, ID)
+	if err != nil {
+		return "", "", err
+	}
+	if runner.StarterTemplate == "" {
+		return "", "", fmt.Errorf("%w: runner %s has no starter template", cerrors.New(cerrors.CANNOT_GET_DATA), ID)
+	}
+
+	vars := maps.Clone(task.Params)
+	if vars == nil {
+		vars = map[string]string{}
+	}
+	vars["task.name"] = task.TaskName
+	vars["task.io_style"] = task.IOStyle
+
+	return entryPoint(runner), templates.Render(runner.StarterTemplate, vars), nil
+}
+
+// entryPoint returns the name of the runner's main file, "main" with the
+// first extension of its language when not set.
+func entryPoint(runner *models.Runner) string {
+	if runner.EntryPoint != "" {
+		return runner.EntryPoint
+	}
+	if runner.Metadata != nil && len(runner.Metadata.Extensions) > 0 {
+		return defaultEntryPoint + runner.Metadata.Extensions[0]
+	}
+	return defaultEntryPoint
+}
+
+// validateEntryPoint checks that name is a plain file name.
+func validateEntryPoint(name string) error {
+	if name == "" {
+		return nil
+	}
+	if strings.ContainsAny(name, `/\`) || name != path.Clean(name) || name == "." || name == ".." {
+		return fmt.Errorf("%w: invalid entry point %q, expected a file name", cerrors.New(cerrors.INVALID_DATA), name)
+	}
+	return nil
+}
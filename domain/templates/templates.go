@@ -0,0 +1,24 @@
+package templates
+
+import (
+	"regexp"
+	"strings"
+)
+
+var placeholder = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_.-]*)\s*\}\}`)
+
+// Render replaces every {{name}} placeholder with its value from vars.
+// Placeholders without a value are left untouched so they stay visible.
+func Render(s string, vars map[string]string) string {
+	if len(vars) == 0 || !strings.Contains(s, "{{") {
+		return s
+	}
+
+	return placeholder.ReplaceAllStringFunc(s, func(match string) string {
+		name := placeholder.FindStringSubmatch(match)[1]
+		if value, ok := vars[name]; ok {
+			return value
+		}
+		return match
+	})
+}
@@ -0,0 +1,41 @@
+package templates
+
+import "testing"
+
+func TestRender(t *testing.T) {
+	vars := map[string]string{
+		"compiler":     "g++",
+		"flags.std":    "-std=c++20",
+		"empty":        "",
+		"nested":       "{{compiler}}",
+		"with_newline": "a\nb",
+	}
+
+	tests := []struct {
+		name string
+		in   string
+		vars map[string]string
+		want string
+	}{
+		{name: "no placeholder", in: "make all", vars: vars, want: "make all"},
+		{name: "placeholder", in: "{{compiler}} main.cpp", vars: vars, want: "g++ main.cpp"},
+		{name: "spaces inside braces", in: "{{ compiler }} {{  flags.std}}", vars: vars, want: "g++ -std=c++20"},
+		{name: "repeated placeholder", in: "{{compiler}} && {{compiler}}", vars: vars, want: "g++ && g++"},
+		{name: "empty value", in: "[{{empty}}]", vars: vars, want: "[]"},
+		{name: "missing value stays visible", in: "{{compiler}} {{missing}}", vars: vars, want: "g++ {{missing}}"},
+		{name: "values are not rendered again", in: "{{nested}}", vars: vars, want: "{{compiler}}"},
+		{name: "multiline value", in: "{{with_newline}}", vars: vars, want: "a\nb"},
+		{name: "invalid name", in: "{{1st}} {{a b}}", vars: map[string]string{"1st": "x", "a b": "y"}, want: "{{1st}} {{a b}}"},
+		{name: "single braces", in: "{compiler}", vars: vars, want: "{compiler}"},
+		{name: "no vars", in: "{{compiler}}", vars: nil, want: "{{compiler}}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Render(tt.in, tt.vars)
+			if got != tt.want {
+				t.Errorf("Render(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,343 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: config/v1/batches.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BeginBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginBatchRequest) Reset() {
+	*x = BeginBatchRequest{}
+	mi := &file_config_v1_batches_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginBatchRequest) ProtoMessage() {}
+
+func (x *BeginBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_batches_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginBatchRequest.ProtoReflect.Descriptor instead.
+func (*BeginBatchRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_batches_proto_rawDescGZIP(), []int{0}
+}
+
+type BeginBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginBatchResponse) Reset() {
+	*x = BeginBatchResponse{}
+	mi := &file_config_v1_batches_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginBatchResponse) ProtoMessage() {}
+
+func (x *BeginBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_batches_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginBatchResponse.ProtoReflect.Descriptor instead.
+func (*BeginBatchResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_batches_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BeginBatchResponse) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *BeginBatchResponse) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type CommitBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitBatchRequest) Reset() {
+	*x = CommitBatchRequest{}
+	mi := &file_config_v1_batches_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitBatchRequest) ProtoMessage() {}
+
+func (x *CommitBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_batches_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitBatchRequest.ProtoReflect.Descriptor instead.
+func (*CommitBatchRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_batches_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CommitBatchRequest) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+type BatchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchResult) Reset() {
+	*x = BatchResult{}
+	mi := &file_config_v1_batches_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResult) ProtoMessage() {}
+
+func (x *BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_batches_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResult.ProtoReflect.Descriptor instead.
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return file_config_v1_batches_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatchResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BatchMutationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Results       []*BatchResult         `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchMutationResponse) Reset() {
+	*x = BatchMutationResponse{}
+	mi := &file_config_v1_batches_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchMutationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMutationResponse) ProtoMessage() {}
+
+func (x *BatchMutationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_batches_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMutationResponse.ProtoReflect.Descriptor instead.
+func (*BatchMutationResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_batches_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchMutationResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *BatchMutationResponse) GetResults() []*BatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_config_v1_batches_proto protoreflect.FileDescriptor
+
+const file_config_v1_batches_proto_rawDesc = "" +
+	"\n" +
+	"\x17config/v1/batches.proto\x12\tconfig.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x13\n" +
+	"\x11BeginBatchRequest\"l\n" +
+	"\x12BeginBatchResponse\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x12;\n" +
+	"\vexpire_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"/\n" +
+	"\x12CommitBatchRequest\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\"G\n" +
+	"\vBatchResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"e\n" +
+	"\x15BatchMutationResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\x120\n" +
+	"\aresults\x18\x02 \x03(\v2\x16.config.v1.BatchResultR\aresultsB\x87\x01\n" +
+	"\rcom.config.v1B\fBatchesProtoP\x01Z0github.com/CSKU-Lab/config-server/grpc/config/v1\xa2\x02\x03CXX\xaa\x02\tConfig.V1\xca\x02\tConfig\\V1\xe2\x02\x15Config\\V1\\GPBMetadatab\x06proto3"
+
+var (
+	file_config_v1_batches_proto_rawDescOnce sync.Once
+	file_config_v1_batches_proto_rawDescData []byte
+)
+
+func file_config_v1_batches_proto_rawDescGZIP() []byte {
+	file_config_v1_batches_proto_rawDescOnce.Do(func() {
+		file_config_v1_batches_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_config_v1_batches_proto_rawDesc), len(file_config_v1_batches_proto_rawDesc)))
+	})
+	return file_config_v1_batches_proto_rawDescData
+}
+
+var file_config_v1_batches_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_config_v1_batches_proto_goTypes = []any{
+	(*BeginBatchRequest)(nil),     // 0: config.v1.BeginBatchRequest
+	(*BeginBatchResponse)(nil),    // 1: config.v1.BeginBatchResponse
+	(*CommitBatchRequest)(nil),    // 2: config.v1.CommitBatchRequest
+	(*BatchResult)(nil),           // 3: config.v1.BatchResult
+	(*BatchMutationResponse)(nil), // 4: config.v1.BatchMutationResponse
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_config_v1_batches_proto_depIdxs = []int32{
+	5, // 0: config.v1.BeginBatchResponse.expire_time:type_name -> google.protobuf.Timestamp
+	3, // 1: config.v1.BatchMutationResponse.results:type_name -> config.v1.BatchResult
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_config_v1_batches_proto_init() }
+func file_config_v1_batches_proto_init() {
+	if File_config_v1_batches_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_config_v1_batches_proto_rawDesc), len(file_config_v1_batches_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_v1_batches_proto_goTypes,
+		DependencyIndexes: file_config_v1_batches_proto_depIdxs,
+		MessageInfos:      file_config_v1_batches_proto_msgTypes,
+	}.Build()
+	File_config_v1_batches_proto = out.File
+	file_config_v1_batches_proto_goTypes = nil
+	file_config_v1_batches_proto_depIdxs = nil
+}
@@ -9,6 +9,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +22,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CompareVerdict int32
+
+const (
+	CompareVerdict_COMPARE_VERDICT_UNSPECIFIED  CompareVerdict = 0
+	CompareVerdict_COMPARE_VERDICT_ACCEPTED     CompareVerdict = 1
+	CompareVerdict_COMPARE_VERDICT_WRONG_ANSWER CompareVerdict = 2
+)
+
+// Enum value maps for CompareVerdict.
+var (
+	CompareVerdict_name = map[int32]string{
+		0: "COMPARE_VERDICT_UNSPECIFIED",
+		1: "COMPARE_VERDICT_ACCEPTED",
+		2: "COMPARE_VERDICT_WRONG_ANSWER",
+	}
+	CompareVerdict_value = map[string]int32{
+		"COMPARE_VERDICT_UNSPECIFIED":  0,
+		"COMPARE_VERDICT_ACCEPTED":     1,
+		"COMPARE_VERDICT_WRONG_ANSWER": 2,
+	}
+)
+
+func (x CompareVerdict) Enum() *CompareVerdict {
+	p := new(CompareVerdict)
+	*p = x
+	return p
+}
+
+func (x CompareVerdict) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CompareVerdict) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_v1_compares_proto_enumTypes[0].Descriptor()
+}
+
+func (CompareVerdict) Type() protoreflect.EnumType {
+	return &file_config_v1_compares_proto_enumTypes[0]
+}
+
+func (x CompareVerdict) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CompareVerdict.Descriptor instead.
+func (CompareVerdict) EnumDescriptor() ([]byte, []int) {
+	return file_config_v1_compares_proto_rawDescGZIP(), []int{0}
+}
+
 type CompareResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -30,6 +80,18 @@ type CompareResponse struct {
 	RunName       string                 `protobuf:"bytes,7,opt,name=run_name,json=runName,proto3" json:"run_name,omitempty"`
 	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
 	Files         []*File                `protobuf:"bytes,10,rep,name=files,proto3" json:"files,omitempty"`
+	GoldenCases   []*GoldenCase          `protobuf:"bytes,11,rep,name=golden_cases,json=goldenCases,proto3" json:"golden_cases,omitempty"`
+	PresetId      string                 `protobuf:"bytes,12,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+	PresetVersion int32                  `protobuf:"varint,13,opt,name=preset_version,json=presetVersion,proto3" json:"preset_version,omitempty"`
+	PresetParams  map[string]string      `protobuf:"bytes,14,rep,name=preset_params,json=presetParams,proto3" json:"preset_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ReadOnly      bool                   `protobuf:"varint,15,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,18,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,19,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Slug          string                 `protobuf:"bytes,20,opt,name=slug,proto3" json:"slug,omitempty"`
+	Aliases       []string               `protobuf:"bytes,21,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	Namespace     string                 `protobuf:"bytes,22,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -113,6 +175,90 @@ func (x *CompareResponse) GetFiles() []*File {
 	return nil
 }
 
+func (x *CompareResponse) GetGoldenCases() []*GoldenCase {
+	if x != nil {
+		return x.GoldenCases
+	}
+	return nil
+}
+
+func (x *CompareResponse) GetPresetId() string {
+	if x != nil {
+		return x.PresetId
+	}
+	return ""
+}
+
+func (x *CompareResponse) GetPresetVersion() int32 {
+	if x != nil {
+		return x.PresetVersion
+	}
+	return 0
+}
+
+func (x *CompareResponse) GetPresetParams() map[string]string {
+	if x != nil {
+		return x.PresetParams
+	}
+	return nil
+}
+
+func (x *CompareResponse) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *CompareResponse) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *CompareResponse) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *CompareResponse) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CompareResponse) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *CompareResponse) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CompareResponse) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *CompareResponse) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
 type GetCompareRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -220,6 +366,7 @@ func (x *GetAllComparesRequest) GetIncludeFiles() bool {
 type GetAllComparesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Compares      []*CompareResponse     `protobuf:"bytes,1,rep,name=compares,proto3" json:"compares,omitempty"`
+	Revision      int64                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -261,6 +408,13 @@ func (x *GetAllComparesResponse) GetCompares() []*CompareResponse {
 	return nil
 }
 
+func (x *GetAllComparesResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
 type CreateCompareRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -270,6 +424,8 @@ type CreateCompareRequest struct {
 	RunName       string                 `protobuf:"bytes,6,opt,name=run_name,json=runName,proto3" json:"run_name,omitempty"`
 	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
 	Files         []*File                `protobuf:"bytes,9,rep,name=files,proto3" json:"files,omitempty"`
+	GoldenCases   []*GoldenCase          `protobuf:"bytes,10,rep,name=golden_cases,json=goldenCases,proto3" json:"golden_cases,omitempty"`
+	Slug          string                 `protobuf:"bytes,11,opt,name=slug,proto3" json:"slug,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -353,6 +509,20 @@ func (x *CreateCompareRequest) GetFiles() []*File {
 	return nil
 }
 
+func (x *CreateCompareRequest) GetGoldenCases() []*GoldenCase {
+	if x != nil {
+		return x.GoldenCases
+	}
+	return nil
+}
+
+func (x *CreateCompareRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
 type CreateCompareResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -407,6 +577,7 @@ type UpdateCompareRequest struct {
 	RunName       *string                `protobuf:"bytes,7,opt,name=run_name,json=runName,proto3,oneof" json:"run_name,omitempty"`
 	Description   *string                `protobuf:"bytes,8,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	Files         []*File                `protobuf:"bytes,10,rep,name=files,proto3" json:"files,omitempty"`
+	GoldenCases   []*GoldenCase          `protobuf:"bytes,11,rep,name=golden_cases,json=goldenCases,proto3" json:"golden_cases,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -497,6 +668,13 @@ func (x *UpdateCompareRequest) GetFiles() []*File {
 	return nil
 }
 
+func (x *UpdateCompareRequest) GetGoldenCases() []*GoldenCase {
+	if x != nil {
+		return x.GoldenCases
+	}
+	return nil
+}
+
 type DeleteCompareRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -637,68 +815,1128 @@ func (x *GetComparesPaginationResponse) GetCount() int32 {
 	return 0
 }
 
-var File_config_v1_compares_proto protoreflect.FileDescriptor
+type GoldenCase struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExpectedOutput  string                 `protobuf:"bytes,2,opt,name=expected_output,json=expectedOutput,proto3" json:"expected_output,omitempty"`
+	ActualOutput    string                 `protobuf:"bytes,3,opt,name=actual_output,json=actualOutput,proto3" json:"actual_output,omitempty"`
+	ExpectedVerdict CompareVerdict         `protobuf:"varint,4,opt,name=expected_verdict,json=expectedVerdict,proto3,enum=config.v1.CompareVerdict" json:"expected_verdict,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
 
-const file_config_v1_compares_proto_rawDesc = "" +
-	"\n" +
-	"\x18config/v1/compares.proto\x12\tconfig.v1\x1a\x1aconfig/v1/pagination.proto\x1a\x14config/v1/file.proto\"\xed\x01\n" +
-	"\x0fCompareResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
-	"\fbuild_script\x18\x04 \x01(\tR\vbuildScript\x12\x1d\n" +
-	"\n" +
-	"run_script\x18\x05 \x01(\tR\trunScript\x12\x19\n" +
-	"\brun_name\x18\a \x01(\tR\arunName\x12 \n" +
-	"\vdescription\x18\b \x01(\tR\vdescription\x12%\n" +
-	"\x05files\x18\n" +
-	" \x03(\v2\x0f.config.v1.FileR\x05filesJ\x04\b\x03\x10\x04J\x04\b\x06\x10\aJ\x04\b\t\x10\n" +
-	"\"#\n" +
-	"\x11GetCompareRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x90\x01\n" +
-	"\x15GetAllComparesRequest\x12)\n" +
-	"\x10include_metadata\x18\x01 \x01(\bR\x0fincludeMetadata\x12'\n" +
-	"\x0finclude_scripts\x18\x02 \x01(\bR\x0eincludeScripts\x12#\n" +
-	"\rinclude_files\x18\x03 \x01(\bR\fincludeFiles\"P\n" +
-	"\x16GetAllComparesResponse\x126\n" +
-	"\bcompares\x18\x01 \x03(\v2\x1a.config.v1.CompareResponseR\bcompares\"\xf4\x01\n" +
-	"\x14CreateCompareRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
-	"\x06script\x18\x02 \x01(\tR\x06script\x12!\n" +
-	"\fbuild_script\x18\x03 \x01(\tR\vbuildScript\x12\x1d\n" +
-	"\n" +
-	"run_script\x18\x04 \x01(\tR\trunScript\x12\x19\n" +
-	"\brun_name\x18\x06 \x01(\tR\arunName\x12 \n" +
-	"\vdescription\x18\a \x01(\tR\vdescription\x12%\n" +
-	"\x05files\x18\t \x03(\v2\x0f.config.v1.FileR\x05filesJ\x04\b\x05\x10\x06J\x04\b\b\x10\t\"'\n" +
-	"\x15CreateCompareResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xf3\x02\n" +
-	"\x14UpdateCompareRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1b\n" +
-	"\x06script\x18\x03 \x01(\tH\x01R\x06script\x88\x01\x01\x12&\n" +
-	"\fbuild_script\x18\x04 \x01(\tH\x02R\vbuildScript\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"run_script\x18\x05 \x01(\tH\x03R\trunScript\x88\x01\x01\x12\x1e\n" +
-	"\brun_name\x18\a \x01(\tH\x04R\arunName\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\b \x01(\tH\x05R\vdescription\x88\x01\x01\x12%\n" +
-	"\x05files\x18\n" +
-	" \x03(\v2\x0f.config.v1.FileR\x05filesB\a\n" +
-	"\x05_nameB\t\n" +
-	"\a_scriptB\x0f\n" +
-	"\r_build_scriptB\r\n" +
-	"\v_run_scriptB\v\n" +
-	"\t_run_nameB\x0e\n" +
-	"\f_descriptionJ\x04\b\x06\x10\aJ\x04\b\t\x10\n" +
-	"\"&\n" +
-	"\x14DeleteCompareRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\\\n" +
-	"\x1cGetComparesPaginationRequest\x12<\n" +
-	"\n" +
-	"pagination\x18\x01 \x01(\v2\x1c.config.v1.PaginationRequestR\n" +
-	"pagination\"m\n" +
-	"\x1dGetComparesPaginationResponse\x126\n" +
-	"\bcompares\x18\x01 \x03(\v2\x1a.config.v1.CompareResponseR\bcompares\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05countB\x95\x01\n" +
+func (x *GoldenCase) Reset() {
+	*x = GoldenCase{}
+	mi := &file_config_v1_compares_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoldenCase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoldenCase) ProtoMessage() {}
+
+func (x *GoldenCase) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_compares_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoldenCase.ProtoReflect.Descriptor instead.
+func (*GoldenCase) Descriptor() ([]byte, []int) {
+	return file_config_v1_compares_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GoldenCase) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GoldenCase) GetExpectedOutput() string {
+	if x != nil {
+		return x.ExpectedOutput
+	}
+	return ""
+}
+
+func (x *GoldenCase) GetActualOutput() string {
+	if x != nil {
+		return x.ActualOutput
+	}
+	return ""
+}
+
+func (x *GoldenCase) GetExpectedVerdict() CompareVerdict {
+	if x != nil {
+		return x.ExpectedVerdict
+	}
+	return CompareVerdict_COMPARE_VERDICT_UNSPECIFIED
+}
+
+type GoldenCaseResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExpectedVerdict CompareVerdict         `protobuf:"varint,2,opt,name=expected_verdict,json=expectedVerdict,proto3,enum=config.v1.CompareVerdict" json:"expected_verdict,omitempty"`
+	ActualVerdict   CompareVerdict         `protobuf:"varint,3,opt,name=actual_verdict,json=actualVerdict,proto3,enum=config.v1.CompareVerdict" json:"actual_verdict,omitempty"`
+	Passed          bool                   `protobuf:"varint,4,opt,name=passed,proto3" json:"passed,omitempty"`
+	Message         string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GoldenCaseResult) Reset() {
+	*x = GoldenCaseResult{}
+	mi := &file_config_v1_compares_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoldenCaseResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoldenCaseResult) ProtoMessage() {}
+
+func (x *GoldenCaseResult) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_compares_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoldenCaseResult.ProtoReflect.Descriptor instead.
+func (*GoldenCaseResult) Descriptor() ([]byte, []int) {
+	return file_config_v1_compares_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GoldenCaseResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GoldenCaseResult) GetExpectedVerdict() CompareVerdict {
+	if x != nil {
+		return x.ExpectedVerdict
+	}
+	return CompareVerdict_COMPARE_VERDICT_UNSPECIFIED
+}
+
+func (x *GoldenCaseResult) GetActualVerdict() CompareVerdict {
+	if x != nil {
+		return x.ActualVerdict
+	}
+	return CompareVerdict_COMPARE_VERDICT_UNSPECIFIED
+}
+
+func (x *GoldenCaseResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *GoldenCaseResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type TestCompareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestCompareRequest) Reset() {
+	*x = TestCompareRequest{}
+	mi := &file_config_v1_compares_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestCompareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCompareRequest) ProtoMessage() {}
+
+func (x *TestCompareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_compares_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCompareRequest.ProtoReflect.Descriptor instead.
+func (*TestCompareRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_compares_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TestCompareRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type TestCompareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Passed        bool                   `protobuf:"varint,1,opt,name=passed,proto3" json:"passed,omitempty"`
+	Results       []*GoldenCaseResult    `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestCompareResponse) Reset() {
+	*x = TestCompareResponse{}
+	mi := &file_config_v1_compares_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestCompareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCompareResponse) ProtoMessage() {}
+
+func (x *TestCompareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_compares_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCompareResponse.ProtoReflect.Descriptor instead.
+func (*TestCompareResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_compares_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TestCompareResponse) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *TestCompareResponse) GetResults() []*GoldenCaseResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ComparePresetParam struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	DefaultValue  string                 `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComparePresetParam) Reset() {
+	*x = ComparePresetParam{}
+	mi := &file_config_v1_compares_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComparePresetParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparePresetParam) ProtoMessage() {}
+
+func (x *ComparePresetParam) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_compares_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparePresetParam.ProtoReflect.Descriptor instead.
+func (*ComparePresetParam) Descriptor() ([]byte, []int) {
+	return file_config_v1_compares_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ComparePresetParam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ComparePresetParam) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ComparePresetParam) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ComparePresetParam) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+type ComparePreset struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Version       int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	Params        []*ComparePresetParam  `protobuf:"bytes,5,rep,name=params,proto3" json:"params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComparePreset) Reset() {
+	*x = ComparePreset{}
+	mi := &file_config_v1_compares_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComparePreset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparePreset) ProtoMessage() {}
+
+func (x *ComparePreset) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_compares_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparePreset.ProtoReflect.Descriptor instead.
+func (*ComparePreset) Descriptor() ([]byte, []int) {
+	return file_config_v1_compares_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ComparePreset) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ComparePreset) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ComparePreset) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ComparePreset) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ComparePreset) GetParams() []*ComparePresetParam {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type GetComparePresetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetComparePresetsRequest) Reset() {
+	*x = GetComparePresetsRequest{}
+	mi := &file_config_v1_compares_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComparePresetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComparePresetsRequest) ProtoMessage() {}
+
+func (x *GetComparePresetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_compares_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComparePresetsRequest.ProtoReflect.Descriptor instead.
+func (*GetComparePresetsRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_compares_proto_rawDescGZIP(), []int{16}
+}
+
+type GetComparePresetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Presets       []*ComparePreset       `protobuf:"bytes,1,rep,name=presets,proto3" json:"presets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetComparePresetsResponse) Reset() {
+	*x = GetComparePresetsResponse{}
+	mi := &file_config_v1_compares_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComparePresetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComparePresetsResponse) ProtoMessage() {}
+
+func (x *GetComparePresetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_compares_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComparePresetsResponse.ProtoReflect.Descriptor instead.
+func (*GetComparePresetsResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_compares_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetComparePresetsResponse) GetPresets() []*ComparePreset {
+	if x != nil {
+		return x.Presets
+	}
+	return nil
+}
+
+type CreateCompareFromPresetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PresetId      string                 `protobuf:"bytes,1,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Params        map[string]string      `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Slug          string                 `protobuf:"bytes,5,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompareFromPresetRequest) Reset() {
+	*x = CreateCompareFromPresetRequest{}
+	mi := &file_config_v1_compares_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompareFromPresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompareFromPresetRequest) ProtoMessage() {}
+
+func (x *CreateCompareFromPresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_compares_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompareFromPresetRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompareFromPresetRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_compares_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateCompareFromPresetRequest) GetPresetId() string {
+	if x != nil {
+		return x.PresetId
+	}
+	return ""
+}
+
+func (x *CreateCompareFromPresetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCompareFromPresetRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCompareFromPresetRequest) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *CreateCompareFromPresetRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type UpgradeComparePresetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Params        map[string]string      `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradeComparePresetRequest) Reset() {
+	*x = UpgradeComparePresetRequest{}
+	mi := &file_config_v1_compares_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeComparePresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeComparePresetRequest) ProtoMessage() {}
+
+func (x *UpgradeComparePresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_compares_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeComparePresetRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeComparePresetRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_compares_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpgradeComparePresetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpgradeComparePresetRequest) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type BatchCreateComparesRequest struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Requests        []*CreateCompareRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	ContinueOnError bool                    `protobuf:"varint,2,opt,name=continue_on_error,json=continueOnError,proto3" json:"continue_on_error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BatchCreateComparesRequest) Reset() {
+	*x = BatchCreateComparesRequest{}
+	mi := &file_config_v1_compares_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateComparesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateComparesRequest) ProtoMessage() {}
+
+func (x *BatchCreateComparesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_compares_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateComparesRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateComparesRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_compares_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BatchCreateComparesRequest) GetRequests() []*CreateCompareRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *BatchCreateComparesRequest) GetContinueOnError() bool {
+	if x != nil {
+		return x.ContinueOnError
+	}
+	return false
+}
+
+type BatchUpdateComparesRequest struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Requests        []*UpdateCompareRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	ContinueOnError bool                    `protobuf:"varint,2,opt,name=continue_on_error,json=continueOnError,proto3" json:"continue_on_error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BatchUpdateComparesRequest) Reset() {
+	*x = BatchUpdateComparesRequest{}
+	mi := &file_config_v1_compares_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateComparesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateComparesRequest) ProtoMessage() {}
+
+func (x *BatchUpdateComparesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_compares_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateComparesRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateComparesRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_compares_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BatchUpdateComparesRequest) GetRequests() []*UpdateCompareRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *BatchUpdateComparesRequest) GetContinueOnError() bool {
+	if x != nil {
+		return x.ContinueOnError
+	}
+	return false
+}
+
+type BatchDeleteComparesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Ids             []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	ContinueOnError bool                   `protobuf:"varint,2,opt,name=continue_on_error,json=continueOnError,proto3" json:"continue_on_error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BatchDeleteComparesRequest) Reset() {
+	*x = BatchDeleteComparesRequest{}
+	mi := &file_config_v1_compares_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteComparesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteComparesRequest) ProtoMessage() {}
+
+func (x *BatchDeleteComparesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_compares_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteComparesRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteComparesRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_compares_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BatchDeleteComparesRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchDeleteComparesRequest) GetContinueOnError() bool {
+	if x != nil {
+		return x.ContinueOnError
+	}
+	return false
+}
+
+type RenameCompareSlugRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCompareSlugRequest) Reset() {
+	*x = RenameCompareSlugRequest{}
+	mi := &file_config_v1_compares_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCompareSlugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCompareSlugRequest) ProtoMessage() {}
+
+func (x *RenameCompareSlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_compares_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCompareSlugRequest.ProtoReflect.Descriptor instead.
+func (*RenameCompareSlugRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_compares_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RenameCompareSlugRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RenameCompareSlugRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type MoveCompareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCompareRequest) Reset() {
+	*x = MoveCompareRequest{}
+	mi := &file_config_v1_compares_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCompareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCompareRequest) ProtoMessage() {}
+
+func (x *MoveCompareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_compares_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCompareRequest.ProtoReflect.Descriptor instead.
+func (*MoveCompareRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_compares_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MoveCompareRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveCompareRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type CopyCompareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyCompareRequest) Reset() {
+	*x = CopyCompareRequest{}
+	mi := &file_config_v1_compares_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyCompareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyCompareRequest) ProtoMessage() {}
+
+func (x *CopyCompareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_compares_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyCompareRequest.ProtoReflect.Descriptor instead.
+func (*CopyCompareRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_compares_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CopyCompareRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CopyCompareRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CopyCompareRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CopyCompareRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type CopyCompareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyCompareResponse) Reset() {
+	*x = CopyCompareResponse{}
+	mi := &file_config_v1_compares_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyCompareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyCompareResponse) ProtoMessage() {}
+
+func (x *CopyCompareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_compares_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyCompareResponse.ProtoReflect.Descriptor instead.
+func (*CopyCompareResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_compares_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CopyCompareResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_config_v1_compares_proto protoreflect.FileDescriptor
+
+const file_config_v1_compares_proto_rawDesc = "" +
+	"\n" +
+	"\x18config/v1/compares.proto\x12\tconfig.v1\x1a\x1aconfig/v1/pagination.proto\x1a\x14config/v1/file.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa0\x06\n" +
+	"\x0fCompareResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\fbuild_script\x18\x04 \x01(\tR\vbuildScript\x12\x1d\n" +
+	"\n" +
+	"run_script\x18\x05 \x01(\tR\trunScript\x12\x19\n" +
+	"\brun_name\x18\a \x01(\tR\arunName\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12%\n" +
+	"\x05files\x18\n" +
+	" \x03(\v2\x0f.config.v1.FileR\x05files\x128\n" +
+	"\fgolden_cases\x18\v \x03(\v2\x15.config.v1.GoldenCaseR\vgoldenCases\x12\x1b\n" +
+	"\tpreset_id\x18\f \x01(\tR\bpresetId\x12%\n" +
+	"\x0epreset_version\x18\r \x01(\x05R\rpresetVersion\x12Q\n" +
+	"\rpreset_params\x18\x0e \x03(\v2,.config.v1.CompareResponse.PresetParamsEntryR\fpresetParams\x12\x1b\n" +
+	"\tread_only\x18\x0f \x01(\bR\breadOnly\x12;\n" +
+	"\vcreate_time\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x12 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x13 \x01(\tR\tupdatedBy\x12\x12\n" +
+	"\x04slug\x18\x14 \x01(\tR\x04slug\x12\x18\n" +
+	"\aaliases\x18\x15 \x03(\tR\aaliases\x12\x1c\n" +
+	"\tnamespace\x18\x16 \x01(\tR\tnamespace\x1a?\n" +
+	"\x11PresetParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x03\x10\x04J\x04\b\x06\x10\aJ\x04\b\t\x10\n" +
+	"\"#\n" +
+	"\x11GetCompareRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x90\x01\n" +
+	"\x15GetAllComparesRequest\x12)\n" +
+	"\x10include_metadata\x18\x01 \x01(\bR\x0fincludeMetadata\x12'\n" +
+	"\x0finclude_scripts\x18\x02 \x01(\bR\x0eincludeScripts\x12#\n" +
+	"\rinclude_files\x18\x03 \x01(\bR\fincludeFiles\"l\n" +
+	"\x16GetAllComparesResponse\x126\n" +
+	"\bcompares\x18\x01 \x03(\v2\x1a.config.v1.CompareResponseR\bcompares\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\"\xc2\x02\n" +
+	"\x14CreateCompareRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06script\x18\x02 \x01(\tR\x06script\x12!\n" +
+	"\fbuild_script\x18\x03 \x01(\tR\vbuildScript\x12\x1d\n" +
+	"\n" +
+	"run_script\x18\x04 \x01(\tR\trunScript\x12\x19\n" +
+	"\brun_name\x18\x06 \x01(\tR\arunName\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12%\n" +
+	"\x05files\x18\t \x03(\v2\x0f.config.v1.FileR\x05files\x128\n" +
+	"\fgolden_cases\x18\n" +
+	" \x03(\v2\x15.config.v1.GoldenCaseR\vgoldenCases\x12\x12\n" +
+	"\x04slug\x18\v \x01(\tR\x04slugJ\x04\b\x05\x10\x06J\x04\b\b\x10\t\"'\n" +
+	"\x15CreateCompareResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xad\x03\n" +
+	"\x14UpdateCompareRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1b\n" +
+	"\x06script\x18\x03 \x01(\tH\x01R\x06script\x88\x01\x01\x12&\n" +
+	"\fbuild_script\x18\x04 \x01(\tH\x02R\vbuildScript\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"run_script\x18\x05 \x01(\tH\x03R\trunScript\x88\x01\x01\x12\x1e\n" +
+	"\brun_name\x18\a \x01(\tH\x04R\arunName\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\b \x01(\tH\x05R\vdescription\x88\x01\x01\x12%\n" +
+	"\x05files\x18\n" +
+	" \x03(\v2\x0f.config.v1.FileR\x05files\x128\n" +
+	"\fgolden_cases\x18\v \x03(\v2\x15.config.v1.GoldenCaseR\vgoldenCasesB\a\n" +
+	"\x05_nameB\t\n" +
+	"\a_scriptB\x0f\n" +
+	"\r_build_scriptB\r\n" +
+	"\v_run_scriptB\v\n" +
+	"\t_run_nameB\x0e\n" +
+	"\f_descriptionJ\x04\b\x06\x10\aJ\x04\b\t\x10\n" +
+	"\"&\n" +
+	"\x14DeleteCompareRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\\\n" +
+	"\x1cGetComparesPaginationRequest\x12<\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1c.config.v1.PaginationRequestR\n" +
+	"pagination\"m\n" +
+	"\x1dGetComparesPaginationResponse\x126\n" +
+	"\bcompares\x18\x01 \x03(\v2\x1a.config.v1.CompareResponseR\bcompares\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xb4\x01\n" +
+	"\n" +
+	"GoldenCase\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12'\n" +
+	"\x0fexpected_output\x18\x02 \x01(\tR\x0eexpectedOutput\x12#\n" +
+	"\ractual_output\x18\x03 \x01(\tR\factualOutput\x12D\n" +
+	"\x10expected_verdict\x18\x04 \x01(\x0e2\x19.config.v1.CompareVerdictR\x0fexpectedVerdict\"\xe0\x01\n" +
+	"\x10GoldenCaseResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12D\n" +
+	"\x10expected_verdict\x18\x02 \x01(\x0e2\x19.config.v1.CompareVerdictR\x0fexpectedVerdict\x12@\n" +
+	"\x0eactual_verdict\x18\x03 \x01(\x0e2\x19.config.v1.CompareVerdictR\ractualVerdict\x12\x16\n" +
+	"\x06passed\x18\x04 \x01(\bR\x06passed\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"$\n" +
+	"\x12TestCompareRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"d\n" +
+	"\x13TestCompareResponse\x12\x16\n" +
+	"\x06passed\x18\x01 \x01(\bR\x06passed\x125\n" +
+	"\aresults\x18\x02 \x03(\v2\x1b.config.v1.GoldenCaseResultR\aresults\"\x83\x01\n" +
+	"\x12ComparePresetParam\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12#\n" +
+	"\rdefault_value\x18\x04 \x01(\tR\fdefaultValue\"\xa6\x01\n" +
+	"\rComparePreset\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\x125\n" +
+	"\x06params\x18\x05 \x03(\v2\x1d.config.v1.ComparePresetParamR\x06params\"\x1a\n" +
+	"\x18GetComparePresetsRequest\"O\n" +
+	"\x19GetComparePresetsResponse\x122\n" +
+	"\apresets\x18\x01 \x03(\v2\x18.config.v1.ComparePresetR\apresets\"\x91\x02\n" +
+	"\x1eCreateCompareFromPresetRequest\x12\x1b\n" +
+	"\tpreset_id\x18\x01 \x01(\tR\bpresetId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12M\n" +
+	"\x06params\x18\x04 \x03(\v25.config.v1.CreateCompareFromPresetRequest.ParamsEntryR\x06params\x12\x12\n" +
+	"\x04slug\x18\x05 \x01(\tR\x04slug\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb4\x01\n" +
+	"\x1bUpgradeComparePresetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12J\n" +
+	"\x06params\x18\x02 \x03(\v22.config.v1.UpgradeComparePresetRequest.ParamsEntryR\x06params\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x85\x01\n" +
+	"\x1aBatchCreateComparesRequest\x12;\n" +
+	"\brequests\x18\x01 \x03(\v2\x1f.config.v1.CreateCompareRequestR\brequests\x12*\n" +
+	"\x11continue_on_error\x18\x02 \x01(\bR\x0fcontinueOnError\"\x85\x01\n" +
+	"\x1aBatchUpdateComparesRequest\x12;\n" +
+	"\brequests\x18\x01 \x03(\v2\x1f.config.v1.UpdateCompareRequestR\brequests\x12*\n" +
+	"\x11continue_on_error\x18\x02 \x01(\bR\x0fcontinueOnError\"Z\n" +
+	"\x1aBatchDeleteComparesRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12*\n" +
+	"\x11continue_on_error\x18\x02 \x01(\bR\x0fcontinueOnError\">\n" +
+	"\x18RenameCompareSlugRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\"B\n" +
+	"\x12MoveCompareRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"j\n" +
+	"\x12CopyCompareRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x04 \x01(\tR\x04slug\"%\n" +
+	"\x13CopyCompareResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id*q\n" +
+	"\x0eCompareVerdict\x12\x1f\n" +
+	"\x1bCOMPARE_VERDICT_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18COMPARE_VERDICT_ACCEPTED\x10\x01\x12 \n" +
+	"\x1cCOMPARE_VERDICT_WRONG_ANSWER\x10\x02B\x95\x01\n" +
 	"\rcom.config.v1B\rComparesProtoP\x01Z0github.com/CSKU-Lab/config-server/grpc/config/v1\xa2\x02\x03CXX\xaa\x02\tConfig.V1\xca\x02\tConfig\\V1\xe2\x02\x15Config\\V1\\GPBMetadata\xea\x02\n" +
 	"Config::V1b\x06proto3"
 
@@ -714,33 +1952,72 @@ func file_config_v1_compares_proto_rawDescGZIP() []byte {
 	return file_config_v1_compares_proto_rawDescData
 }
 
-var file_config_v1_compares_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_config_v1_compares_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_config_v1_compares_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_config_v1_compares_proto_goTypes = []any{
-	(*CompareResponse)(nil),               // 0: config.v1.CompareResponse
-	(*GetCompareRequest)(nil),             // 1: config.v1.GetCompareRequest
-	(*GetAllComparesRequest)(nil),         // 2: config.v1.GetAllComparesRequest
-	(*GetAllComparesResponse)(nil),        // 3: config.v1.GetAllComparesResponse
-	(*CreateCompareRequest)(nil),          // 4: config.v1.CreateCompareRequest
-	(*CreateCompareResponse)(nil),         // 5: config.v1.CreateCompareResponse
-	(*UpdateCompareRequest)(nil),          // 6: config.v1.UpdateCompareRequest
-	(*DeleteCompareRequest)(nil),          // 7: config.v1.DeleteCompareRequest
-	(*GetComparesPaginationRequest)(nil),  // 8: config.v1.GetComparesPaginationRequest
-	(*GetComparesPaginationResponse)(nil), // 9: config.v1.GetComparesPaginationResponse
-	(*File)(nil),                          // 10: config.v1.File
-	(*PaginationRequest)(nil),             // 11: config.v1.PaginationRequest
+	(CompareVerdict)(0),                    // 0: config.v1.CompareVerdict
+	(*CompareResponse)(nil),                // 1: config.v1.CompareResponse
+	(*GetCompareRequest)(nil),              // 2: config.v1.GetCompareRequest
+	(*GetAllComparesRequest)(nil),          // 3: config.v1.GetAllComparesRequest
+	(*GetAllComparesResponse)(nil),         // 4: config.v1.GetAllComparesResponse
+	(*CreateCompareRequest)(nil),           // 5: config.v1.CreateCompareRequest
+	(*CreateCompareResponse)(nil),          // 6: config.v1.CreateCompareResponse
+	(*UpdateCompareRequest)(nil),           // 7: config.v1.UpdateCompareRequest
+	(*DeleteCompareRequest)(nil),           // 8: config.v1.DeleteCompareRequest
+	(*GetComparesPaginationRequest)(nil),   // 9: config.v1.GetComparesPaginationRequest
+	(*GetComparesPaginationResponse)(nil),  // 10: config.v1.GetComparesPaginationResponse
+	(*GoldenCase)(nil),                     // 11: config.v1.GoldenCase
+	(*GoldenCaseResult)(nil),               // 12: config.v1.GoldenCaseResult
+	(*TestCompareRequest)(nil),             // 13: config.v1.TestCompareRequest
+	(*TestCompareResponse)(nil),            // 14: config.v1.TestCompareResponse
+	(*ComparePresetParam)(nil),             // 15: config.v1.ComparePresetParam
+	(*ComparePreset)(nil),                  // 16: config.v1.ComparePreset
+	(*GetComparePresetsRequest)(nil),       // 17: config.v1.GetComparePresetsRequest
+	(*GetComparePresetsResponse)(nil),      // 18: config.v1.GetComparePresetsResponse
+	(*CreateCompareFromPresetRequest)(nil), // 19: config.v1.CreateCompareFromPresetRequest
+	(*UpgradeComparePresetRequest)(nil),    // 20: config.v1.UpgradeComparePresetRequest
+	(*BatchCreateComparesRequest)(nil),     // 21: config.v1.BatchCreateComparesRequest
+	(*BatchUpdateComparesRequest)(nil),     // 22: config.v1.BatchUpdateComparesRequest
+	(*BatchDeleteComparesRequest)(nil),     // 23: config.v1.BatchDeleteComparesRequest
+	(*RenameCompareSlugRequest)(nil),       // 24: config.v1.RenameCompareSlugRequest
+	(*MoveCompareRequest)(nil),             // 25: config.v1.MoveCompareRequest
+	(*CopyCompareRequest)(nil),             // 26: config.v1.CopyCompareRequest
+	(*CopyCompareResponse)(nil),            // 27: config.v1.CopyCompareResponse
+	nil,                                    // 28: config.v1.CompareResponse.PresetParamsEntry
+	nil,                                    // 29: config.v1.CreateCompareFromPresetRequest.ParamsEntry
+	nil,                                    // 30: config.v1.UpgradeComparePresetRequest.ParamsEntry
+	(*File)(nil),                           // 31: config.v1.File
+	(*timestamppb.Timestamp)(nil),          // 32: google.protobuf.Timestamp
+	(*PaginationRequest)(nil),              // 33: config.v1.PaginationRequest
 }
 var file_config_v1_compares_proto_depIdxs = []int32{
-	10, // 0: config.v1.CompareResponse.files:type_name -> config.v1.File
-	0,  // 1: config.v1.GetAllComparesResponse.compares:type_name -> config.v1.CompareResponse
-	10, // 2: config.v1.CreateCompareRequest.files:type_name -> config.v1.File
-	10, // 3: config.v1.UpdateCompareRequest.files:type_name -> config.v1.File
-	11, // 4: config.v1.GetComparesPaginationRequest.pagination:type_name -> config.v1.PaginationRequest
-	0,  // 5: config.v1.GetComparesPaginationResponse.compares:type_name -> config.v1.CompareResponse
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	31, // 0: config.v1.CompareResponse.files:type_name -> config.v1.File
+	11, // 1: config.v1.CompareResponse.golden_cases:type_name -> config.v1.GoldenCase
+	28, // 2: config.v1.CompareResponse.preset_params:type_name -> config.v1.CompareResponse.PresetParamsEntry
+	32, // 3: config.v1.CompareResponse.create_time:type_name -> google.protobuf.Timestamp
+	32, // 4: config.v1.CompareResponse.update_time:type_name -> google.protobuf.Timestamp
+	1,  // 5: config.v1.GetAllComparesResponse.compares:type_name -> config.v1.CompareResponse
+	31, // 6: config.v1.CreateCompareRequest.files:type_name -> config.v1.File
+	11, // 7: config.v1.CreateCompareRequest.golden_cases:type_name -> config.v1.GoldenCase
+	31, // 8: config.v1.UpdateCompareRequest.files:type_name -> config.v1.File
+	11, // 9: config.v1.UpdateCompareRequest.golden_cases:type_name -> config.v1.GoldenCase
+	33, // 10: config.v1.GetComparesPaginationRequest.pagination:type_name -> config.v1.PaginationRequest
+	1,  // 11: config.v1.GetComparesPaginationResponse.compares:type_name -> config.v1.CompareResponse
+	0,  // 12: config.v1.GoldenCase.expected_verdict:type_name -> config.v1.CompareVerdict
+	0,  // 13: config.v1.GoldenCaseResult.expected_verdict:type_name -> config.v1.CompareVerdict
+	0,  // 14: config.v1.GoldenCaseResult.actual_verdict:type_name -> config.v1.CompareVerdict
+	12, // 15: config.v1.TestCompareResponse.results:type_name -> config.v1.GoldenCaseResult
+	15, // 16: config.v1.ComparePreset.params:type_name -> config.v1.ComparePresetParam
+	16, // 17: config.v1.GetComparePresetsResponse.presets:type_name -> config.v1.ComparePreset
+	29, // 18: config.v1.CreateCompareFromPresetRequest.params:type_name -> config.v1.CreateCompareFromPresetRequest.ParamsEntry
+	30, // 19: config.v1.UpgradeComparePresetRequest.params:type_name -> config.v1.UpgradeComparePresetRequest.ParamsEntry
+	5,  // 20: config.v1.BatchCreateComparesRequest.requests:type_name -> config.v1.CreateCompareRequest
+	7,  // 21: config.v1.BatchUpdateComparesRequest.requests:type_name -> config.v1.UpdateCompareRequest
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_config_v1_compares_proto_init() }
@@ -756,13 +2033,14 @@ func file_config_v1_compares_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_config_v1_compares_proto_rawDesc), len(file_config_v1_compares_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      1,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_config_v1_compares_proto_goTypes,
 		DependencyIndexes: file_config_v1_compares_proto_depIdxs,
+		EnumInfos:         file_config_v1_compares_proto_enumTypes,
 		MessageInfos:      file_config_v1_compares_proto_msgTypes,
 	}.Build()
 	File_config_v1_compares_proto = out.File
@@ -0,0 +1,277 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: config/v1/graders.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetGraderStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGraderStatusRequest) Reset() {
+	*x = GetGraderStatusRequest{}
+	mi := &file_config_v1_graders_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGraderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGraderStatusRequest) ProtoMessage() {}
+
+func (x *GetGraderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_graders_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGraderStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetGraderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_graders_proto_rawDescGZIP(), []int{0}
+}
+
+type GraderStatus struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Address         string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AckedRevision   int64                  `protobuf:"varint,2,opt,name=acked_revision,json=ackedRevision,proto3" json:"acked_revision,omitempty"`
+	Lagging         bool                   `protobuf:"varint,3,opt,name=lagging,proto3" json:"lagging,omitempty"`
+	LastAckTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_ack_time,json=lastAckTime,proto3" json:"last_ack_time,omitempty"`
+	LastAttemptTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_attempt_time,json=lastAttemptTime,proto3" json:"last_attempt_time,omitempty"`
+	LastError       string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Failures        int32                  `protobuf:"varint,7,opt,name=failures,proto3" json:"failures,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GraderStatus) Reset() {
+	*x = GraderStatus{}
+	mi := &file_config_v1_graders_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraderStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraderStatus) ProtoMessage() {}
+
+func (x *GraderStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_graders_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraderStatus.ProtoReflect.Descriptor instead.
+func (*GraderStatus) Descriptor() ([]byte, []int) {
+	return file_config_v1_graders_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GraderStatus) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GraderStatus) GetAckedRevision() int64 {
+	if x != nil {
+		return x.AckedRevision
+	}
+	return 0
+}
+
+func (x *GraderStatus) GetLagging() bool {
+	if x != nil {
+		return x.Lagging
+	}
+	return false
+}
+
+func (x *GraderStatus) GetLastAckTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAckTime
+	}
+	return nil
+}
+
+func (x *GraderStatus) GetLastAttemptTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttemptTime
+	}
+	return nil
+}
+
+func (x *GraderStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *GraderStatus) GetFailures() int32 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+type GetGraderStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Graders       []*GraderStatus        `protobuf:"bytes,2,rep,name=graders,proto3" json:"graders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGraderStatusResponse) Reset() {
+	*x = GetGraderStatusResponse{}
+	mi := &file_config_v1_graders_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGraderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGraderStatusResponse) ProtoMessage() {}
+
+func (x *GetGraderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_graders_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGraderStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetGraderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_graders_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetGraderStatusResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *GetGraderStatusResponse) GetGraders() []*GraderStatus {
+	if x != nil {
+		return x.Graders
+	}
+	return nil
+}
+
+var File_config_v1_graders_proto protoreflect.FileDescriptor
+
+const file_config_v1_graders_proto_rawDesc = "" +
+	"\n" +
+	"\x17config/v1/graders.proto\x12\tconfig.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x18\n" +
+	"\x16GetGraderStatusRequest\"\xac\x02\n" +
+	"\fGraderStatus\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12%\n" +
+	"\x0eacked_revision\x18\x02 \x01(\x03R\rackedRevision\x12\x18\n" +
+	"\alagging\x18\x03 \x01(\bR\alagging\x12>\n" +
+	"\rlast_ack_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vlastAckTime\x12F\n" +
+	"\x11last_attempt_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0flastAttemptTime\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x12\x1a\n" +
+	"\bfailures\x18\a \x01(\x05R\bfailures\"h\n" +
+	"\x17GetGraderStatusResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\x121\n" +
+	"\agraders\x18\x02 \x03(\v2\x17.config.v1.GraderStatusR\agradersB\x87\x01\n" +
+	"\rcom.config.v1B\fGradersProtoP\x01Z0github.com/CSKU-Lab/config-server/grpc/config/v1\xa2\x02\x03CXX\xaa\x02\tConfig.V1\xca\x02\tConfig\\V1\xe2\x02\x15Config\\V1\\GPBMetadatab\x06proto3"
+
+var (
+	file_config_v1_graders_proto_rawDescOnce sync.Once
+	file_config_v1_graders_proto_rawDescData []byte
+)
+
+func file_config_v1_graders_proto_rawDescGZIP() []byte {
+	file_config_v1_graders_proto_rawDescOnce.Do(func() {
+		file_config_v1_graders_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_config_v1_graders_proto_rawDesc), len(file_config_v1_graders_proto_rawDesc)))
+	})
+	return file_config_v1_graders_proto_rawDescData
+}
+
+var file_config_v1_graders_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_config_v1_graders_proto_goTypes = []any{
+	(*GetGraderStatusRequest)(nil),  // 0: config.v1.GetGraderStatusRequest
+	(*GraderStatus)(nil),            // 1: config.v1.GraderStatus
+	(*GetGraderStatusResponse)(nil), // 2: config.v1.GetGraderStatusResponse
+	(*timestamppb.Timestamp)(nil),   // 3: google.protobuf.Timestamp
+}
+var file_config_v1_graders_proto_depIdxs = []int32{
+	3, // 0: config.v1.GraderStatus.last_ack_time:type_name -> google.protobuf.Timestamp
+	3, // 1: config.v1.GraderStatus.last_attempt_time:type_name -> google.protobuf.Timestamp
+	1, // 2: config.v1.GetGraderStatusResponse.graders:type_name -> config.v1.GraderStatus
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_config_v1_graders_proto_init() }
+func file_config_v1_graders_proto_init() {
+	if File_config_v1_graders_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_config_v1_graders_proto_rawDesc), len(file_config_v1_graders_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_v1_graders_proto_goTypes,
+		DependencyIndexes: file_config_v1_graders_proto_depIdxs,
+		MessageInfos:      file_config_v1_graders_proto_msgTypes,
+	}.Build()
+	File_config_v1_graders_proto = out.File
+	file_config_v1_graders_proto_goTypes = nil
+	file_config_v1_graders_proto_depIdxs = nil
+}
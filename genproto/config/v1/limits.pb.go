@@ -0,0 +1,920 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: config/v1/limits.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Limit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CpuTime       float32                `protobuf:"fixed32,1,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
+	CpuExtraTime  float32                `protobuf:"fixed32,2,opt,name=cpu_extra_time,json=cpuExtraTime,proto3" json:"cpu_extra_time,omitempty"`
+	WallTime      float32                `protobuf:"fixed32,3,opt,name=wall_time,json=wallTime,proto3" json:"wall_time,omitempty"`
+	Memory        int32                  `protobuf:"varint,4,opt,name=memory,proto3" json:"memory,omitempty"`
+	Stack         int32                  `protobuf:"varint,5,opt,name=stack,proto3" json:"stack,omitempty"`
+	MaxOpenFiles  int32                  `protobuf:"varint,6,opt,name=max_open_files,json=maxOpenFiles,proto3" json:"max_open_files,omitempty"`
+	MaxFileSize   float32                `protobuf:"fixed32,7,opt,name=max_file_size,json=maxFileSize,proto3" json:"max_file_size,omitempty"`
+	NetworkAllow  bool                   `protobuf:"varint,8,opt,name=network_allow,json=networkAllow,proto3" json:"network_allow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Limit) Reset() {
+	*x = Limit{}
+	mi := &file_config_v1_limits_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Limit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Limit) ProtoMessage() {}
+
+func (x *Limit) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_limits_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Limit.ProtoReflect.Descriptor instead.
+func (*Limit) Descriptor() ([]byte, []int) {
+	return file_config_v1_limits_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Limit) GetCpuTime() float32 {
+	if x != nil {
+		return x.CpuTime
+	}
+	return 0
+}
+
+func (x *Limit) GetCpuExtraTime() float32 {
+	if x != nil {
+		return x.CpuExtraTime
+	}
+	return 0
+}
+
+func (x *Limit) GetWallTime() float32 {
+	if x != nil {
+		return x.WallTime
+	}
+	return 0
+}
+
+func (x *Limit) GetMemory() int32 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *Limit) GetStack() int32 {
+	if x != nil {
+		return x.Stack
+	}
+	return 0
+}
+
+func (x *Limit) GetMaxOpenFiles() int32 {
+	if x != nil {
+		return x.MaxOpenFiles
+	}
+	return 0
+}
+
+func (x *Limit) GetMaxFileSize() float32 {
+	if x != nil {
+		return x.MaxFileSize
+	}
+	return 0
+}
+
+func (x *Limit) GetNetworkAllow() bool {
+	if x != nil {
+		return x.NetworkAllow
+	}
+	return false
+}
+
+type LimitMultipliers struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CpuTime       float32                `protobuf:"fixed32,1,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
+	WallTime      float32                `protobuf:"fixed32,2,opt,name=wall_time,json=wallTime,proto3" json:"wall_time,omitempty"`
+	Memory        float32                `protobuf:"fixed32,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LimitMultipliers) Reset() {
+	*x = LimitMultipliers{}
+	mi := &file_config_v1_limits_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LimitMultipliers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitMultipliers) ProtoMessage() {}
+
+func (x *LimitMultipliers) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_limits_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitMultipliers.ProtoReflect.Descriptor instead.
+func (*LimitMultipliers) Descriptor() ([]byte, []int) {
+	return file_config_v1_limits_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LimitMultipliers) GetCpuTime() float32 {
+	if x != nil {
+		return x.CpuTime
+	}
+	return 0
+}
+
+func (x *LimitMultipliers) GetWallTime() float32 {
+	if x != nil {
+		return x.WallTime
+	}
+	return 0
+}
+
+func (x *LimitMultipliers) GetMemory() float32 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+type LimitProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Limit         *Limit                 `protobuf:"bytes,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LimitProfileResponse) Reset() {
+	*x = LimitProfileResponse{}
+	mi := &file_config_v1_limits_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LimitProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitProfileResponse) ProtoMessage() {}
+
+func (x *LimitProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_limits_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitProfileResponse.ProtoReflect.Descriptor instead.
+func (*LimitProfileResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_limits_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LimitProfileResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LimitProfileResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LimitProfileResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LimitProfileResponse) GetLimit() *Limit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+type GetLimitProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLimitProfileRequest) Reset() {
+	*x = GetLimitProfileRequest{}
+	mi := &file_config_v1_limits_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLimitProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLimitProfileRequest) ProtoMessage() {}
+
+func (x *GetLimitProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_limits_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLimitProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetLimitProfileRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_limits_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetLimitProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetAllLimitProfilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllLimitProfilesRequest) Reset() {
+	*x = GetAllLimitProfilesRequest{}
+	mi := &file_config_v1_limits_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllLimitProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllLimitProfilesRequest) ProtoMessage() {}
+
+func (x *GetAllLimitProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_limits_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllLimitProfilesRequest.ProtoReflect.Descriptor instead.
+func (*GetAllLimitProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_limits_proto_rawDescGZIP(), []int{4}
+}
+
+type GetAllLimitProfilesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	LimitProfiles []*LimitProfileResponse `protobuf:"bytes,1,rep,name=limit_profiles,json=limitProfiles,proto3" json:"limit_profiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllLimitProfilesResponse) Reset() {
+	*x = GetAllLimitProfilesResponse{}
+	mi := &file_config_v1_limits_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllLimitProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllLimitProfilesResponse) ProtoMessage() {}
+
+func (x *GetAllLimitProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_limits_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllLimitProfilesResponse.ProtoReflect.Descriptor instead.
+func (*GetAllLimitProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_limits_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetAllLimitProfilesResponse) GetLimitProfiles() []*LimitProfileResponse {
+	if x != nil {
+		return x.LimitProfiles
+	}
+	return nil
+}
+
+type GetLimitProfilesPaginationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLimitProfilesPaginationRequest) Reset() {
+	*x = GetLimitProfilesPaginationRequest{}
+	mi := &file_config_v1_limits_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLimitProfilesPaginationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLimitProfilesPaginationRequest) ProtoMessage() {}
+
+func (x *GetLimitProfilesPaginationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_limits_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLimitProfilesPaginationRequest.ProtoReflect.Descriptor instead.
+func (*GetLimitProfilesPaginationRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_limits_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetLimitProfilesPaginationRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetLimitProfilesPaginationResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	LimitProfiles []*LimitProfileResponse `protobuf:"bytes,1,rep,name=limit_profiles,json=limitProfiles,proto3" json:"limit_profiles,omitempty"`
+	Count         int32                   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLimitProfilesPaginationResponse) Reset() {
+	*x = GetLimitProfilesPaginationResponse{}
+	mi := &file_config_v1_limits_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLimitProfilesPaginationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLimitProfilesPaginationResponse) ProtoMessage() {}
+
+func (x *GetLimitProfilesPaginationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_limits_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLimitProfilesPaginationResponse.ProtoReflect.Descriptor instead.
+func (*GetLimitProfilesPaginationResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_limits_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetLimitProfilesPaginationResponse) GetLimitProfiles() []*LimitProfileResponse {
+	if x != nil {
+		return x.LimitProfiles
+	}
+	return nil
+}
+
+func (x *GetLimitProfilesPaginationResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type CreateLimitProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Limit         *Limit                 `protobuf:"bytes,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLimitProfileRequest) Reset() {
+	*x = CreateLimitProfileRequest{}
+	mi := &file_config_v1_limits_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLimitProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLimitProfileRequest) ProtoMessage() {}
+
+func (x *CreateLimitProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_limits_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLimitProfileRequest.ProtoReflect.Descriptor instead.
+func (*CreateLimitProfileRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_limits_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateLimitProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateLimitProfileRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateLimitProfileRequest) GetLimit() *Limit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+type CreateLimitProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLimitProfileResponse) Reset() {
+	*x = CreateLimitProfileResponse{}
+	mi := &file_config_v1_limits_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLimitProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLimitProfileResponse) ProtoMessage() {}
+
+func (x *CreateLimitProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_limits_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLimitProfileResponse.ProtoReflect.Descriptor instead.
+func (*CreateLimitProfileResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_limits_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateLimitProfileResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateLimitProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Limit         *Limit                 `protobuf:"bytes,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLimitProfileRequest) Reset() {
+	*x = UpdateLimitProfileRequest{}
+	mi := &file_config_v1_limits_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLimitProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLimitProfileRequest) ProtoMessage() {}
+
+func (x *UpdateLimitProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_limits_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLimitProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLimitProfileRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_limits_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateLimitProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateLimitProfileRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateLimitProfileRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateLimitProfileRequest) GetLimit() *Limit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+type DeleteLimitProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLimitProfileRequest) Reset() {
+	*x = DeleteLimitProfileRequest{}
+	mi := &file_config_v1_limits_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLimitProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLimitProfileRequest) ProtoMessage() {}
+
+func (x *DeleteLimitProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_limits_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLimitProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLimitProfileRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_limits_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteLimitProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ResolveLimitRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LimitProfileId string                 `protobuf:"bytes,1,opt,name=limit_profile_id,json=limitProfileId,proto3" json:"limit_profile_id,omitempty"`
+	RunnerId       string                 `protobuf:"bytes,2,opt,name=runner_id,json=runnerId,proto3" json:"runner_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResolveLimitRequest) Reset() {
+	*x = ResolveLimitRequest{}
+	mi := &file_config_v1_limits_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveLimitRequest) ProtoMessage() {}
+
+func (x *ResolveLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_limits_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveLimitRequest.ProtoReflect.Descriptor instead.
+func (*ResolveLimitRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_limits_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResolveLimitRequest) GetLimitProfileId() string {
+	if x != nil {
+		return x.LimitProfileId
+	}
+	return ""
+}
+
+func (x *ResolveLimitRequest) GetRunnerId() string {
+	if x != nil {
+		return x.RunnerId
+	}
+	return ""
+}
+
+type ResolveLimitResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LimitProfileId string                 `protobuf:"bytes,1,opt,name=limit_profile_id,json=limitProfileId,proto3" json:"limit_profile_id,omitempty"`
+	Limit          *Limit                 `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResolveLimitResponse) Reset() {
+	*x = ResolveLimitResponse{}
+	mi := &file_config_v1_limits_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveLimitResponse) ProtoMessage() {}
+
+func (x *ResolveLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_limits_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveLimitResponse.ProtoReflect.Descriptor instead.
+func (*ResolveLimitResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_limits_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ResolveLimitResponse) GetLimitProfileId() string {
+	if x != nil {
+		return x.LimitProfileId
+	}
+	return ""
+}
+
+func (x *ResolveLimitResponse) GetLimit() *Limit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+var File_config_v1_limits_proto protoreflect.FileDescriptor
+
+const file_config_v1_limits_proto_rawDesc = "" +
+	"\n" +
+	"\x16config/v1/limits.proto\x12\tconfig.v1\x1a\x1aconfig/v1/pagination.proto\"\x82\x02\n" +
+	"\x05Limit\x12\x19\n" +
+	"\bcpu_time\x18\x01 \x01(\x02R\acpuTime\x12$\n" +
+	"\x0ecpu_extra_time\x18\x02 \x01(\x02R\fcpuExtraTime\x12\x1b\n" +
+	"\twall_time\x18\x03 \x01(\x02R\bwallTime\x12\x16\n" +
+	"\x06memory\x18\x04 \x01(\x05R\x06memory\x12\x14\n" +
+	"\x05stack\x18\x05 \x01(\x05R\x05stack\x12$\n" +
+	"\x0emax_open_files\x18\x06 \x01(\x05R\fmaxOpenFiles\x12\"\n" +
+	"\rmax_file_size\x18\a \x01(\x02R\vmaxFileSize\x12#\n" +
+	"\rnetwork_allow\x18\b \x01(\bR\fnetworkAllow\"b\n" +
+	"\x10LimitMultipliers\x12\x19\n" +
+	"\bcpu_time\x18\x01 \x01(\x02R\acpuTime\x12\x1b\n" +
+	"\twall_time\x18\x02 \x01(\x02R\bwallTime\x12\x16\n" +
+	"\x06memory\x18\x03 \x01(\x02R\x06memory\"\x84\x01\n" +
+	"\x14LimitProfileResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12&\n" +
+	"\x05limit\x18\x04 \x01(\v2\x10.config.v1.LimitR\x05limit\"(\n" +
+	"\x16GetLimitProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1c\n" +
+	"\x1aGetAllLimitProfilesRequest\"e\n" +
+	"\x1bGetAllLimitProfilesResponse\x12F\n" +
+	"\x0elimit_profiles\x18\x01 \x03(\v2\x1f.config.v1.LimitProfileResponseR\rlimitProfiles\"a\n" +
+	"!GetLimitProfilesPaginationRequest\x12<\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1c.config.v1.PaginationRequestR\n" +
+	"pagination\"\x82\x01\n" +
+	"\"GetLimitProfilesPaginationResponse\x12F\n" +
+	"\x0elimit_profiles\x18\x01 \x03(\v2\x1f.config.v1.LimitProfileResponseR\rlimitProfiles\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"y\n" +
+	"\x19CreateLimitProfileRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12&\n" +
+	"\x05limit\x18\x03 \x01(\v2\x10.config.v1.LimitR\x05limit\",\n" +
+	"\x1aCreateLimitProfileResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xac\x01\n" +
+	"\x19UpdateLimitProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12&\n" +
+	"\x05limit\x18\x04 \x01(\v2\x10.config.v1.LimitR\x05limitB\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_description\"+\n" +
+	"\x19DeleteLimitProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\\\n" +
+	"\x13ResolveLimitRequest\x12(\n" +
+	"\x10limit_profile_id\x18\x01 \x01(\tR\x0elimitProfileId\x12\x1b\n" +
+	"\trunner_id\x18\x02 \x01(\tR\brunnerId\"h\n" +
+	"\x14ResolveLimitResponse\x12(\n" +
+	"\x10limit_profile_id\x18\x01 \x01(\tR\x0elimitProfileId\x12&\n" +
+	"\x05limit\x18\x02 \x01(\v2\x10.config.v1.LimitR\x05limitB\x93\x01\n" +
+	"\rcom.config.v1B\vLimitsProtoP\x01Z0github.com/CSKU-Lab/config-server/grpc/config/v1\xa2\x02\x03CXX\xaa\x02\tConfig.V1\xca\x02\tConfig\\V1\xe2\x02\x15Config\\V1\\GPBMetadata\xea\x02\n" +
+	"Config::V1b\x06proto3"
+
+var (
+	file_config_v1_limits_proto_rawDescOnce sync.Once
+	file_config_v1_limits_proto_rawDescData []byte
+)
+
+func file_config_v1_limits_proto_rawDescGZIP() []byte {
+	file_config_v1_limits_proto_rawDescOnce.Do(func() {
+		file_config_v1_limits_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_config_v1_limits_proto_rawDesc), len(file_config_v1_limits_proto_rawDesc)))
+	})
+	return file_config_v1_limits_proto_rawDescData
+}
+
+var file_config_v1_limits_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_config_v1_limits_proto_goTypes = []any{
+	(*Limit)(nil),                              // 0: config.v1.Limit
+	(*LimitMultipliers)(nil),                   // 1: config.v1.LimitMultipliers
+	(*LimitProfileResponse)(nil),               // 2: config.v1.LimitProfileResponse
+	(*GetLimitProfileRequest)(nil),             // 3: config.v1.GetLimitProfileRequest
+	(*GetAllLimitProfilesRequest)(nil),         // 4: config.v1.GetAllLimitProfilesRequest
+	(*GetAllLimitProfilesResponse)(nil),        // 5: config.v1.GetAllLimitProfilesResponse
+	(*GetLimitProfilesPaginationRequest)(nil),  // 6: config.v1.GetLimitProfilesPaginationRequest
+	(*GetLimitProfilesPaginationResponse)(nil), // 7: config.v1.GetLimitProfilesPaginationResponse
+	(*CreateLimitProfileRequest)(nil),          // 8: config.v1.CreateLimitProfileRequest
+	(*CreateLimitProfileResponse)(nil),         // 9: config.v1.CreateLimitProfileResponse
+	(*UpdateLimitProfileRequest)(nil),          // 10: config.v1.UpdateLimitProfileRequest
+	(*DeleteLimitProfileRequest)(nil),          // 11: config.v1.DeleteLimitProfileRequest
+	(*ResolveLimitRequest)(nil),                // 12: config.v1.ResolveLimitRequest
+	(*ResolveLimitResponse)(nil),               // 13: config.v1.ResolveLimitResponse
+	(*PaginationRequest)(nil),                  // 14: config.v1.PaginationRequest
+}
+var file_config_v1_limits_proto_depIdxs = []int32{
+	0,  // 0: config.v1.LimitProfileResponse.limit:type_name -> config.v1.Limit
+	2,  // 1: config.v1.GetAllLimitProfilesResponse.limit_profiles:type_name -> config.v1.LimitProfileResponse
+	14, // 2: config.v1.GetLimitProfilesPaginationRequest.pagination:type_name -> config.v1.PaginationRequest
+	2,  // 3: config.v1.GetLimitProfilesPaginationResponse.limit_profiles:type_name -> config.v1.LimitProfileResponse
+	0,  // 4: config.v1.CreateLimitProfileRequest.limit:type_name -> config.v1.Limit
+	0,  // 5: config.v1.UpdateLimitProfileRequest.limit:type_name -> config.v1.Limit
+	0,  // 6: config.v1.ResolveLimitResponse.limit:type_name -> config.v1.Limit
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_config_v1_limits_proto_init() }
+func file_config_v1_limits_proto_init() {
+	if File_config_v1_limits_proto != nil {
+		return
+	}
+	file_config_v1_pagination_proto_init()
+	file_config_v1_limits_proto_msgTypes[10].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_config_v1_limits_proto_rawDesc), len(file_config_v1_limits_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_v1_limits_proto_goTypes,
+		DependencyIndexes: file_config_v1_limits_proto_depIdxs,
+		MessageInfos:      file_config_v1_limits_proto_msgTypes,
+	}.Build()
+	File_config_v1_limits_proto = out.File
+	file_config_v1_limits_proto_goTypes = nil
+	file_config_v1_limits_proto_depIdxs = nil
+}
@@ -0,0 +1,9 @@
+package v1
+
+import _ "embed"
+
+// OpenAPI is the OpenAPI document of the HTTP/JSON routes of ConfigService,
+// generated with service.pb.gw.go.
+//
+//go:embed service.swagger.json
+var OpenAPI []byte
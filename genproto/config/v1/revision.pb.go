@@ -0,0 +1,477 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: config/v1/revision.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConfigKind int32
+
+const (
+	ConfigKind_CONFIG_KIND_UNSPECIFIED ConfigKind = 0
+	ConfigKind_CONFIG_KIND_RUNNER      ConfigKind = 1
+	ConfigKind_CONFIG_KIND_COMPARE     ConfigKind = 2
+)
+
+// Enum value maps for ConfigKind.
+var (
+	ConfigKind_name = map[int32]string{
+		0: "CONFIG_KIND_UNSPECIFIED",
+		1: "CONFIG_KIND_RUNNER",
+		2: "CONFIG_KIND_COMPARE",
+	}
+	ConfigKind_value = map[string]int32{
+		"CONFIG_KIND_UNSPECIFIED": 0,
+		"CONFIG_KIND_RUNNER":      1,
+		"CONFIG_KIND_COMPARE":     2,
+	}
+)
+
+func (x ConfigKind) Enum() *ConfigKind {
+	p := new(ConfigKind)
+	*p = x
+	return p
+}
+
+func (x ConfigKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfigKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_v1_revision_proto_enumTypes[0].Descriptor()
+}
+
+func (ConfigKind) Type() protoreflect.EnumType {
+	return &file_config_v1_revision_proto_enumTypes[0]
+}
+
+func (x ConfigKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfigKind.Descriptor instead.
+func (ConfigKind) EnumDescriptor() ([]byte, []int) {
+	return file_config_v1_revision_proto_rawDescGZIP(), []int{0}
+}
+
+type ConfigAction int32
+
+const (
+	ConfigAction_CONFIG_ACTION_UNSPECIFIED ConfigAction = 0
+	ConfigAction_CONFIG_ACTION_CREATED     ConfigAction = 1
+	ConfigAction_CONFIG_ACTION_UPDATED     ConfigAction = 2
+	ConfigAction_CONFIG_ACTION_DELETED     ConfigAction = 3
+)
+
+// Enum value maps for ConfigAction.
+var (
+	ConfigAction_name = map[int32]string{
+		0: "CONFIG_ACTION_UNSPECIFIED",
+		1: "CONFIG_ACTION_CREATED",
+		2: "CONFIG_ACTION_UPDATED",
+		3: "CONFIG_ACTION_DELETED",
+	}
+	ConfigAction_value = map[string]int32{
+		"CONFIG_ACTION_UNSPECIFIED": 0,
+		"CONFIG_ACTION_CREATED":     1,
+		"CONFIG_ACTION_UPDATED":     2,
+		"CONFIG_ACTION_DELETED":     3,
+	}
+)
+
+func (x ConfigAction) Enum() *ConfigAction {
+	p := new(ConfigAction)
+	*p = x
+	return p
+}
+
+func (x ConfigAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfigAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_v1_revision_proto_enumTypes[1].Descriptor()
+}
+
+func (ConfigAction) Type() protoreflect.EnumType {
+	return &file_config_v1_revision_proto_enumTypes[1]
+}
+
+func (x ConfigAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfigAction.Descriptor instead.
+func (ConfigAction) EnumDescriptor() ([]byte, []int) {
+	return file_config_v1_revision_proto_rawDescGZIP(), []int{1}
+}
+
+type WatchConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SinceRevision int64                  `protobuf:"varint,1,opt,name=since_revision,json=sinceRevision,proto3" json:"since_revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchConfigRequest) Reset() {
+	*x = WatchConfigRequest{}
+	mi := &file_config_v1_revision_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchConfigRequest) ProtoMessage() {}
+
+func (x *WatchConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_revision_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchConfigRequest.ProtoReflect.Descriptor instead.
+func (*WatchConfigRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_revision_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchConfigRequest) GetSinceRevision() int64 {
+	if x != nil {
+		return x.SinceRevision
+	}
+	return 0
+}
+
+type ConfigEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Kind          ConfigKind             `protobuf:"varint,2,opt,name=kind,proto3,enum=config.v1.ConfigKind" json:"kind,omitempty"`
+	Action        ConfigAction           `protobuf:"varint,3,opt,name=action,proto3,enum=config.v1.ConfigAction" json:"action,omitempty"`
+	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigEvent) Reset() {
+	*x = ConfigEvent{}
+	mi := &file_config_v1_revision_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigEvent) ProtoMessage() {}
+
+func (x *ConfigEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_revision_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigEvent.ProtoReflect.Descriptor instead.
+func (*ConfigEvent) Descriptor() ([]byte, []int) {
+	return file_config_v1_revision_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConfigEvent) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ConfigEvent) GetKind() ConfigKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ConfigKind_CONFIG_KIND_UNSPECIFIED
+}
+
+func (x *ConfigEvent) GetAction() ConfigAction {
+	if x != nil {
+		return x.Action
+	}
+	return ConfigAction_CONFIG_ACTION_UNSPECIFIED
+}
+
+func (x *ConfigEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetConfigDeltaRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SinceRevision   int64                  `protobuf:"varint,1,opt,name=since_revision,json=sinceRevision,proto3" json:"since_revision,omitempty"`
+	IncludeMetadata bool                   `protobuf:"varint,2,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty"`
+	IncludeScripts  bool                   `protobuf:"varint,3,opt,name=include_scripts,json=includeScripts,proto3" json:"include_scripts,omitempty"`
+	IncludeFiles    bool                   `protobuf:"varint,4,opt,name=include_files,json=includeFiles,proto3" json:"include_files,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetConfigDeltaRequest) Reset() {
+	*x = GetConfigDeltaRequest{}
+	mi := &file_config_v1_revision_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigDeltaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigDeltaRequest) ProtoMessage() {}
+
+func (x *GetConfigDeltaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_revision_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigDeltaRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigDeltaRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_revision_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetConfigDeltaRequest) GetSinceRevision() int64 {
+	if x != nil {
+		return x.SinceRevision
+	}
+	return 0
+}
+
+func (x *GetConfigDeltaRequest) GetIncludeMetadata() bool {
+	if x != nil {
+		return x.IncludeMetadata
+	}
+	return false
+}
+
+func (x *GetConfigDeltaRequest) GetIncludeScripts() bool {
+	if x != nil {
+		return x.IncludeScripts
+	}
+	return false
+}
+
+func (x *GetConfigDeltaRequest) GetIncludeFiles() bool {
+	if x != nil {
+		return x.IncludeFiles
+	}
+	return false
+}
+
+type GetConfigDeltaResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Revision          int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Runners           []*RunnerResponse      `protobuf:"bytes,2,rep,name=runners,proto3" json:"runners,omitempty"`
+	Compares          []*CompareResponse     `protobuf:"bytes,3,rep,name=compares,proto3" json:"compares,omitempty"`
+	DeletedRunnerIds  []string               `protobuf:"bytes,4,rep,name=deleted_runner_ids,json=deletedRunnerIds,proto3" json:"deleted_runner_ids,omitempty"`
+	DeletedCompareIds []string               `protobuf:"bytes,5,rep,name=deleted_compare_ids,json=deletedCompareIds,proto3" json:"deleted_compare_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetConfigDeltaResponse) Reset() {
+	*x = GetConfigDeltaResponse{}
+	mi := &file_config_v1_revision_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigDeltaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigDeltaResponse) ProtoMessage() {}
+
+func (x *GetConfigDeltaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_revision_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigDeltaResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigDeltaResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_revision_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetConfigDeltaResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *GetConfigDeltaResponse) GetRunners() []*RunnerResponse {
+	if x != nil {
+		return x.Runners
+	}
+	return nil
+}
+
+func (x *GetConfigDeltaResponse) GetCompares() []*CompareResponse {
+	if x != nil {
+		return x.Compares
+	}
+	return nil
+}
+
+func (x *GetConfigDeltaResponse) GetDeletedRunnerIds() []string {
+	if x != nil {
+		return x.DeletedRunnerIds
+	}
+	return nil
+}
+
+func (x *GetConfigDeltaResponse) GetDeletedCompareIds() []string {
+	if x != nil {
+		return x.DeletedCompareIds
+	}
+	return nil
+}
+
+var File_config_v1_revision_proto protoreflect.FileDescriptor
+
+const file_config_v1_revision_proto_rawDesc = "" +
+	"\n" +
+	"\x18config/v1/revision.proto\x12\tconfig.v1\x1a\x17config/v1/runners.proto\x1a\x18config/v1/compares.proto\";\n" +
+	"\x12WatchConfigRequest\x12%\n" +
+	"\x0esince_revision\x18\x01 \x01(\x03R\rsinceRevision\"\x95\x01\n" +
+	"\vConfigEvent\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\x12)\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x15.config.v1.ConfigKindR\x04kind\x12/\n" +
+	"\x06action\x18\x03 \x01(\x0e2\x17.config.v1.ConfigActionR\x06action\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\tR\x02id\"\xb7\x01\n" +
+	"\x15GetConfigDeltaRequest\x12%\n" +
+	"\x0esince_revision\x18\x01 \x01(\x03R\rsinceRevision\x12)\n" +
+	"\x10include_metadata\x18\x02 \x01(\bR\x0fincludeMetadata\x12'\n" +
+	"\x0finclude_scripts\x18\x03 \x01(\bR\x0eincludeScripts\x12#\n" +
+	"\rinclude_files\x18\x04 \x01(\bR\fincludeFiles\"\xff\x01\n" +
+	"\x16GetConfigDeltaResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\x123\n" +
+	"\arunners\x18\x02 \x03(\v2\x19.config.v1.RunnerResponseR\arunners\x126\n" +
+	"\bcompares\x18\x03 \x03(\v2\x1a.config.v1.CompareResponseR\bcompares\x12,\n" +
+	"\x12deleted_runner_ids\x18\x04 \x03(\tR\x10deletedRunnerIds\x12.\n" +
+	"\x13deleted_compare_ids\x18\x05 \x03(\tR\x11deletedCompareIds*Z\n" +
+	"\n" +
+	"ConfigKind\x12\x1b\n" +
+	"\x17CONFIG_KIND_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12CONFIG_KIND_RUNNER\x10\x01\x12\x17\n" +
+	"\x13CONFIG_KIND_COMPARE\x10\x02*~\n" +
+	"\fConfigAction\x12\x1d\n" +
+	"\x19CONFIG_ACTION_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15CONFIG_ACTION_CREATED\x10\x01\x12\x19\n" +
+	"\x15CONFIG_ACTION_UPDATED\x10\x02\x12\x19\n" +
+	"\x15CONFIG_ACTION_DELETED\x10\x03B\x88\x01\n" +
+	"\rcom.config.v1B\rRevisionProtoP\x01Z0github.com/CSKU-Lab/config-server/grpc/config/v1\xa2\x02\x03CXX\xaa\x02\tConfig.V1\xca\x02\tConfig\\V1\xe2\x02\x15Config\\V1\\GPBMetadatab\x06proto3"
+
+var (
+	file_config_v1_revision_proto_rawDescOnce sync.Once
+	file_config_v1_revision_proto_rawDescData []byte
+)
+
+func file_config_v1_revision_proto_rawDescGZIP() []byte {
+	file_config_v1_revision_proto_rawDescOnce.Do(func() {
+		file_config_v1_revision_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_config_v1_revision_proto_rawDesc), len(file_config_v1_revision_proto_rawDesc)))
+	})
+	return file_config_v1_revision_proto_rawDescData
+}
+
+var file_config_v1_revision_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_config_v1_revision_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_config_v1_revision_proto_goTypes = []any{
+	(ConfigKind)(0),                // 0: config.v1.ConfigKind
+	(ConfigAction)(0),              // 1: config.v1.ConfigAction
+	(*WatchConfigRequest)(nil),     // 2: config.v1.WatchConfigRequest
+	(*ConfigEvent)(nil),            // 3: config.v1.ConfigEvent
+	(*GetConfigDeltaRequest)(nil),  // 4: config.v1.GetConfigDeltaRequest
+	(*GetConfigDeltaResponse)(nil), // 5: config.v1.GetConfigDeltaResponse
+	(*RunnerResponse)(nil),         // 6: config.v1.RunnerResponse
+	(*CompareResponse)(nil),        // 7: config.v1.CompareResponse
+}
+var file_config_v1_revision_proto_depIdxs = []int32{
+	0, // 0: config.v1.ConfigEvent.kind:type_name -> config.v1.ConfigKind
+	1, // 1: config.v1.ConfigEvent.action:type_name -> config.v1.ConfigAction
+	6, // 2: config.v1.GetConfigDeltaResponse.runners:type_name -> config.v1.RunnerResponse
+	7, // 3: config.v1.GetConfigDeltaResponse.compares:type_name -> config.v1.CompareResponse
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_config_v1_revision_proto_init() }
+func file_config_v1_revision_proto_init() {
+	if File_config_v1_revision_proto != nil {
+		return
+	}
+	file_config_v1_runners_proto_init()
+	file_config_v1_compares_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_config_v1_revision_proto_rawDesc), len(file_config_v1_revision_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_v1_revision_proto_goTypes,
+		DependencyIndexes: file_config_v1_revision_proto_depIdxs,
+		EnumInfos:         file_config_v1_revision_proto_enumTypes,
+		MessageInfos:      file_config_v1_revision_proto_msgTypes,
+	}.Build()
+	File_config_v1_revision_proto = out.File
+	file_config_v1_revision_proto_goTypes = nil
+	file_config_v1_revision_proto_depIdxs = nil
+}
@@ -9,6 +9,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,15 +23,30 @@ const (
 )
 
 type RunnerPaginationData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	BuildScript   string                 `protobuf:"bytes,3,opt,name=build_script,json=buildScript,proto3" json:"build_script,omitempty"`
-	RunScript     string                 `protobuf:"bytes,4,opt,name=run_script,json=runScript,proto3" json:"run_script,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	InitialFiles  []*File                `protobuf:"bytes,6,rep,name=initial_files,json=initialFiles,proto3" json:"initial_files,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BuildScript           string                 `protobuf:"bytes,3,opt,name=build_script,json=buildScript,proto3" json:"build_script,omitempty"`
+	RunScript             string                 `protobuf:"bytes,4,opt,name=run_script,json=runScript,proto3" json:"run_script,omitempty"`
+	Description           string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	InitialFiles          []*File                `protobuf:"bytes,6,rep,name=initial_files,json=initialFiles,proto3" json:"initial_files,omitempty"`
+	ParentId              string                 `protobuf:"bytes,7,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Variables             map[string]string      `protobuf:"bytes,8,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DefaultLimitProfileId string                 `protobuf:"bytes,9,opt,name=default_limit_profile_id,json=defaultLimitProfileId,proto3" json:"default_limit_profile_id,omitempty"`
+	LimitMultipliers      *LimitMultipliers      `protobuf:"bytes,10,opt,name=limit_multipliers,json=limitMultipliers,proto3" json:"limit_multipliers,omitempty"`
+	Env                   []*EnvVar              `protobuf:"bytes,11,rep,name=env,proto3" json:"env,omitempty"`
+	CreateTime            *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime            *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	CreatedBy             string                 `protobuf:"bytes,14,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy             string                 `protobuf:"bytes,15,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Slug                  string                 `protobuf:"bytes,16,opt,name=slug,proto3" json:"slug,omitempty"`
+	Aliases               []string               `protobuf:"bytes,17,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	Metadata              *RunnerMetadata        `protobuf:"bytes,18,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	StarterTemplate       string                 `protobuf:"bytes,19,opt,name=starter_template,json=starterTemplate,proto3" json:"starter_template,omitempty"`
+	EntryPoint            string                 `protobuf:"bytes,20,opt,name=entry_point,json=entryPoint,proto3" json:"entry_point,omitempty"`
+	Namespace             string                 `protobuf:"bytes,21,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *RunnerPaginationData) Reset() {
@@ -105,10 +121,118 @@ func (x *RunnerPaginationData) GetInitialFiles() []*File {
 	return nil
 }
 
+func (x *RunnerPaginationData) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *RunnerPaginationData) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *RunnerPaginationData) GetDefaultLimitProfileId() string {
+	if x != nil {
+		return x.DefaultLimitProfileId
+	}
+	return ""
+}
+
+func (x *RunnerPaginationData) GetLimitMultipliers() *LimitMultipliers {
+	if x != nil {
+		return x.LimitMultipliers
+	}
+	return nil
+}
+
+func (x *RunnerPaginationData) GetEnv() []*EnvVar {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *RunnerPaginationData) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *RunnerPaginationData) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *RunnerPaginationData) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *RunnerPaginationData) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *RunnerPaginationData) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *RunnerPaginationData) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *RunnerPaginationData) GetMetadata() *RunnerMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *RunnerPaginationData) GetStarterTemplate() string {
+	if x != nil {
+		return x.StarterTemplate
+	}
+	return ""
+}
+
+func (x *RunnerPaginationData) GetEntryPoint() string {
+	if x != nil {
+		return x.EntryPoint
+	}
+	return ""
+}
+
+func (x *RunnerPaginationData) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
 type GetRunnersPaginationRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Pagination     *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	IncludeScripts bool                   `protobuf:"varint,2,opt,name=include_scripts,json=includeScripts,proto3" json:"include_scripts,omitempty"`
+	Language       string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	Tags           []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Extension      string                 `protobuf:"bytes,5,opt,name=extension,proto3" json:"extension,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -157,6 +281,27 @@ func (x *GetRunnersPaginationRequest) GetIncludeScripts() bool {
 	return false
 }
 
+func (x *GetRunnersPaginationRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GetRunnersPaginationRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetRunnersPaginationRequest) GetExtension() string {
+	if x != nil {
+		return x.Extension
+	}
+	return ""
+}
+
 type GetRunnersPaginationResponse struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Runners       []*RunnerPaginationData `protobuf:"bytes,1,rep,name=runners,proto3" json:"runners,omitempty"`
@@ -212,6 +357,7 @@ func (x *GetRunnersPaginationResponse) GetCount() int32 {
 type GetRunnerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeRaw    bool                   `protobuf:"varint,2,opt,name=include_raw,json=includeRaw,proto3" json:"include_raw,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -253,6 +399,13 @@ func (x *GetRunnerRequest) GetId() string {
 	return ""
 }
 
+func (x *GetRunnerRequest) GetIncludeRaw() bool {
+	if x != nil {
+		return x.IncludeRaw
+	}
+	return false
+}
+
 type GetAllRunnersRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	IncludeMetadata bool                   `protobuf:"varint,1,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty"`
@@ -308,6 +461,7 @@ func (x *GetAllRunnersRequest) GetIncludeScripts() bool {
 type GetAllRunnersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Runners       []*RunnerResponse      `protobuf:"bytes,1,rep,name=runners,proto3" json:"runners,omitempty"`
+	Revision      int64                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -349,16 +503,39 @@ func (x *GetAllRunnersResponse) GetRunners() []*RunnerResponse {
 	return nil
 }
 
+func (x *GetAllRunnersResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
 type RunnerResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	BuildScript   string                 `protobuf:"bytes,3,opt,name=build_script,json=buildScript,proto3" json:"build_script,omitempty"`
-	RunScript     string                 `protobuf:"bytes,4,opt,name=run_script,json=runScript,proto3" json:"run_script,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	InitialFiles  []*File                `protobuf:"bytes,6,rep,name=initial_files,json=initialFiles,proto3" json:"initial_files,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BuildScript           string                 `protobuf:"bytes,3,opt,name=build_script,json=buildScript,proto3" json:"build_script,omitempty"`
+	RunScript             string                 `protobuf:"bytes,4,opt,name=run_script,json=runScript,proto3" json:"run_script,omitempty"`
+	Description           string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	InitialFiles          []*File                `protobuf:"bytes,6,rep,name=initial_files,json=initialFiles,proto3" json:"initial_files,omitempty"`
+	ParentId              string                 `protobuf:"bytes,7,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Variables             map[string]string      `protobuf:"bytes,8,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Raw                   *RunnerResponse        `protobuf:"bytes,9,opt,name=raw,proto3" json:"raw,omitempty"`
+	DefaultLimitProfileId string                 `protobuf:"bytes,10,opt,name=default_limit_profile_id,json=defaultLimitProfileId,proto3" json:"default_limit_profile_id,omitempty"`
+	LimitMultipliers      *LimitMultipliers      `protobuf:"bytes,11,opt,name=limit_multipliers,json=limitMultipliers,proto3" json:"limit_multipliers,omitempty"`
+	Env                   []*EnvVar              `protobuf:"bytes,12,rep,name=env,proto3" json:"env,omitempty"`
+	CreateTime            *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime            *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	CreatedBy             string                 `protobuf:"bytes,15,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy             string                 `protobuf:"bytes,16,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Slug                  string                 `protobuf:"bytes,17,opt,name=slug,proto3" json:"slug,omitempty"`
+	Aliases               []string               `protobuf:"bytes,18,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	Metadata              *RunnerMetadata        `protobuf:"bytes,19,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	StarterTemplate       string                 `protobuf:"bytes,20,opt,name=starter_template,json=starterTemplate,proto3" json:"starter_template,omitempty"`
+	EntryPoint            string                 `protobuf:"bytes,21,opt,name=entry_point,json=entryPoint,proto3" json:"entry_point,omitempty"`
+	Namespace             string                 `protobuf:"bytes,22,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *RunnerResponse) Reset() {
@@ -433,12 +610,133 @@ func (x *RunnerResponse) GetInitialFiles() []*File {
 	return nil
 }
 
+func (x *RunnerResponse) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *RunnerResponse) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *RunnerResponse) GetRaw() *RunnerResponse {
+	if x != nil {
+		return x.Raw
+	}
+	return nil
+}
+
+func (x *RunnerResponse) GetDefaultLimitProfileId() string {
+	if x != nil {
+		return x.DefaultLimitProfileId
+	}
+	return ""
+}
+
+func (x *RunnerResponse) GetLimitMultipliers() *LimitMultipliers {
+	if x != nil {
+		return x.LimitMultipliers
+	}
+	return nil
+}
+
+func (x *RunnerResponse) GetEnv() []*EnvVar {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *RunnerResponse) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *RunnerResponse) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *RunnerResponse) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *RunnerResponse) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *RunnerResponse) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *RunnerResponse) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *RunnerResponse) GetMetadata() *RunnerMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *RunnerResponse) GetStarterTemplate() string {
+	if x != nil {
+		return x.StarterTemplate
+	}
+	return ""
+}
+
+func (x *RunnerResponse) GetEntryPoint() string {
+	if x != nil {
+		return x.EntryPoint
+	}
+	return ""
+}
+
+func (x *RunnerResponse) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
 type CreateRunnerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Name                  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description           string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ParentId              string                 `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Variables             map[string]string      `protobuf:"bytes,6,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DefaultLimitProfileId string                 `protobuf:"bytes,7,opt,name=default_limit_profile_id,json=defaultLimitProfileId,proto3" json:"default_limit_profile_id,omitempty"`
+	LimitMultipliers      *LimitMultipliers      `protobuf:"bytes,8,opt,name=limit_multipliers,json=limitMultipliers,proto3" json:"limit_multipliers,omitempty"`
+	Env                   []*EnvVar              `protobuf:"bytes,9,rep,name=env,proto3" json:"env,omitempty"`
+	Slug                  string                 `protobuf:"bytes,10,opt,name=slug,proto3" json:"slug,omitempty"`
+	Metadata              *RunnerMetadata        `protobuf:"bytes,11,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	StarterTemplate       string                 `protobuf:"bytes,12,opt,name=starter_template,json=starterTemplate,proto3" json:"starter_template,omitempty"`
+	EntryPoint            string                 `protobuf:"bytes,13,opt,name=entry_point,json=entryPoint,proto3" json:"entry_point,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *CreateRunnerRequest) Reset() {
@@ -485,6 +783,69 @@ func (x *CreateRunnerRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateRunnerRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateRunnerRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *CreateRunnerRequest) GetDefaultLimitProfileId() string {
+	if x != nil {
+		return x.DefaultLimitProfileId
+	}
+	return ""
+}
+
+func (x *CreateRunnerRequest) GetLimitMultipliers() *LimitMultipliers {
+	if x != nil {
+		return x.LimitMultipliers
+	}
+	return nil
+}
+
+func (x *CreateRunnerRequest) GetEnv() []*EnvVar {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *CreateRunnerRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreateRunnerRequest) GetMetadata() *RunnerMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreateRunnerRequest) GetStarterTemplate() string {
+	if x != nil {
+		return x.StarterTemplate
+	}
+	return ""
+}
+
+func (x *CreateRunnerRequest) GetEntryPoint() string {
+	if x != nil {
+		return x.EntryPoint
+	}
+	return ""
+}
+
 type CreateRunnerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -530,15 +891,23 @@ func (x *CreateRunnerResponse) GetId() string {
 }
 
 type UpdateRunnerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	BuildScript   *string                `protobuf:"bytes,3,opt,name=build_script,json=buildScript,proto3,oneof" json:"build_script,omitempty"`
-	RunScript     *string                `protobuf:"bytes,4,opt,name=run_script,json=runScript,proto3,oneof" json:"run_script,omitempty"`
-	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	InitialFiles  []*File                `protobuf:"bytes,6,rep,name=initial_files,json=initialFiles,proto3" json:"initial_files,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                  *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	BuildScript           *string                `protobuf:"bytes,3,opt,name=build_script,json=buildScript,proto3,oneof" json:"build_script,omitempty"`
+	RunScript             *string                `protobuf:"bytes,4,opt,name=run_script,json=runScript,proto3,oneof" json:"run_script,omitempty"`
+	Description           *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	InitialFiles          []*File                `protobuf:"bytes,6,rep,name=initial_files,json=initialFiles,proto3" json:"initial_files,omitempty"`
+	ParentId              *string                `protobuf:"bytes,7,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Variables             map[string]string      `protobuf:"bytes,8,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DefaultLimitProfileId *string                `protobuf:"bytes,9,opt,name=default_limit_profile_id,json=defaultLimitProfileId,proto3,oneof" json:"default_limit_profile_id,omitempty"`
+	LimitMultipliers      *LimitMultipliers      `protobuf:"bytes,10,opt,name=limit_multipliers,json=limitMultipliers,proto3" json:"limit_multipliers,omitempty"`
+	Env                   []*EnvVar              `protobuf:"bytes,11,rep,name=env,proto3" json:"env,omitempty"`
+	Metadata              *RunnerMetadata        `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	StarterTemplate       *string                `protobuf:"bytes,13,opt,name=starter_template,json=starterTemplate,proto3,oneof" json:"starter_template,omitempty"`
+	EntryPoint            *string                `protobuf:"bytes,14,opt,name=entry_point,json=entryPoint,proto3,oneof" json:"entry_point,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *UpdateRunnerRequest) Reset() {
@@ -613,18 +982,74 @@ func (x *UpdateRunnerRequest) GetInitialFiles() []*File {
 	return nil
 }
 
-type DeleteRunnerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateRunnerRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
 }
 
-func (x *DeleteRunnerRequest) Reset() {
-	*x = DeleteRunnerRequest{}
-	mi := &file_config_v1_runners_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpdateRunnerRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *UpdateRunnerRequest) GetDefaultLimitProfileId() string {
+	if x != nil && x.DefaultLimitProfileId != nil {
+		return *x.DefaultLimitProfileId
+	}
+	return ""
+}
+
+func (x *UpdateRunnerRequest) GetLimitMultipliers() *LimitMultipliers {
+	if x != nil {
+		return x.LimitMultipliers
+	}
+	return nil
+}
+
+func (x *UpdateRunnerRequest) GetEnv() []*EnvVar {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *UpdateRunnerRequest) GetMetadata() *RunnerMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UpdateRunnerRequest) GetStarterTemplate() string {
+	if x != nil && x.StarterTemplate != nil {
+		return *x.StarterTemplate
+	}
+	return ""
+}
+
+func (x *UpdateRunnerRequest) GetEntryPoint() string {
+	if x != nil && x.EntryPoint != nil {
+		return *x.EntryPoint
+	}
+	return ""
+}
+
+type DeleteRunnerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRunnerRequest) Reset() {
+	*x = DeleteRunnerRequest{}
+	mi := &file_config_v1_runners_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DeleteRunnerRequest) String() string {
@@ -657,11 +1082,647 @@ func (x *DeleteRunnerRequest) GetId() string {
 	return ""
 }
 
+type EnvVar struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Secret        bool                   `protobuf:"varint,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnvVar) Reset() {
+	*x = EnvVar{}
+	mi := &file_config_v1_runners_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvVar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvVar) ProtoMessage() {}
+
+func (x *EnvVar) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_runners_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvVar.ProtoReflect.Descriptor instead.
+func (*EnvVar) Descriptor() ([]byte, []int) {
+	return file_config_v1_runners_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EnvVar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnvVar) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *EnvVar) GetSecret() bool {
+	if x != nil {
+		return x.Secret
+	}
+	return false
+}
+
+type BatchCreateRunnersRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Requests        []*CreateRunnerRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	ContinueOnError bool                   `protobuf:"varint,2,opt,name=continue_on_error,json=continueOnError,proto3" json:"continue_on_error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BatchCreateRunnersRequest) Reset() {
+	*x = BatchCreateRunnersRequest{}
+	mi := &file_config_v1_runners_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateRunnersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateRunnersRequest) ProtoMessage() {}
+
+func (x *BatchCreateRunnersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_runners_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateRunnersRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateRunnersRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_runners_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchCreateRunnersRequest) GetRequests() []*CreateRunnerRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *BatchCreateRunnersRequest) GetContinueOnError() bool {
+	if x != nil {
+		return x.ContinueOnError
+	}
+	return false
+}
+
+type BatchUpdateRunnersRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Requests        []*UpdateRunnerRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	ContinueOnError bool                   `protobuf:"varint,2,opt,name=continue_on_error,json=continueOnError,proto3" json:"continue_on_error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BatchUpdateRunnersRequest) Reset() {
+	*x = BatchUpdateRunnersRequest{}
+	mi := &file_config_v1_runners_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateRunnersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateRunnersRequest) ProtoMessage() {}
+
+func (x *BatchUpdateRunnersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_runners_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateRunnersRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateRunnersRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_runners_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BatchUpdateRunnersRequest) GetRequests() []*UpdateRunnerRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *BatchUpdateRunnersRequest) GetContinueOnError() bool {
+	if x != nil {
+		return x.ContinueOnError
+	}
+	return false
+}
+
+type BatchDeleteRunnersRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Ids             []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	ContinueOnError bool                   `protobuf:"varint,2,opt,name=continue_on_error,json=continueOnError,proto3" json:"continue_on_error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BatchDeleteRunnersRequest) Reset() {
+	*x = BatchDeleteRunnersRequest{}
+	mi := &file_config_v1_runners_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteRunnersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteRunnersRequest) ProtoMessage() {}
+
+func (x *BatchDeleteRunnersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_runners_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteRunnersRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteRunnersRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_runners_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BatchDeleteRunnersRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchDeleteRunnersRequest) GetContinueOnError() bool {
+	if x != nil {
+		return x.ContinueOnError
+	}
+	return false
+}
+
+type RenameRunnerSlugRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameRunnerSlugRequest) Reset() {
+	*x = RenameRunnerSlugRequest{}
+	mi := &file_config_v1_runners_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameRunnerSlugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameRunnerSlugRequest) ProtoMessage() {}
+
+func (x *RenameRunnerSlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_runners_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameRunnerSlugRequest.ProtoReflect.Descriptor instead.
+func (*RenameRunnerSlugRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_runners_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RenameRunnerSlugRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RenameRunnerSlugRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type RunnerMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Language      string                 `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Extensions    []string               `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	EditorMode    string                 `protobuf:"bytes,4,opt,name=editor_mode,json=editorMode,proto3" json:"editor_mode,omitempty"`
+	Icon          string                 `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunnerMetadata) Reset() {
+	*x = RunnerMetadata{}
+	mi := &file_config_v1_runners_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunnerMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunnerMetadata) ProtoMessage() {}
+
+func (x *RunnerMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_runners_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunnerMetadata.ProtoReflect.Descriptor instead.
+func (*RunnerMetadata) Descriptor() ([]byte, []int) {
+	return file_config_v1_runners_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RunnerMetadata) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *RunnerMetadata) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *RunnerMetadata) GetExtensions() []string {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *RunnerMetadata) GetEditorMode() string {
+	if x != nil {
+		return x.EditorMode
+	}
+	return ""
+}
+
+func (x *RunnerMetadata) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *RunnerMetadata) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type RenderStarterTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunnerId      string                 `protobuf:"bytes,1,opt,name=runner_id,json=runnerId,proto3" json:"runner_id,omitempty"`
+	TaskName      string                 `protobuf:"bytes,2,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	IoStyle       string                 `protobuf:"bytes,3,opt,name=io_style,json=ioStyle,proto3" json:"io_style,omitempty"`
+	Params        map[string]string      `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderStarterTemplateRequest) Reset() {
+	*x = RenderStarterTemplateRequest{}
+	mi := &file_config_v1_runners_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderStarterTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderStarterTemplateRequest) ProtoMessage() {}
+
+func (x *RenderStarterTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_runners_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderStarterTemplateRequest.ProtoReflect.Descriptor instead.
+func (*RenderStarterTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_runners_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RenderStarterTemplateRequest) GetRunnerId() string {
+	if x != nil {
+		return x.RunnerId
+	}
+	return ""
+}
+
+func (x *RenderStarterTemplateRequest) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+func (x *RenderStarterTemplateRequest) GetIoStyle() string {
+	if x != nil {
+		return x.IoStyle
+	}
+	return ""
+}
+
+func (x *RenderStarterTemplateRequest) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type RenderStarterTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderStarterTemplateResponse) Reset() {
+	*x = RenderStarterTemplateResponse{}
+	mi := &file_config_v1_runners_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderStarterTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderStarterTemplateResponse) ProtoMessage() {}
+
+func (x *RenderStarterTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_runners_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderStarterTemplateResponse.ProtoReflect.Descriptor instead.
+func (*RenderStarterTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_runners_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RenderStarterTemplateResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *RenderStarterTemplateResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type MoveRunnerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveRunnerRequest) Reset() {
+	*x = MoveRunnerRequest{}
+	mi := &file_config_v1_runners_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveRunnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveRunnerRequest) ProtoMessage() {}
+
+func (x *MoveRunnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_runners_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveRunnerRequest.ProtoReflect.Descriptor instead.
+func (*MoveRunnerRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_runners_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MoveRunnerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveRunnerRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type CopyRunnerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyRunnerRequest) Reset() {
+	*x = CopyRunnerRequest{}
+	mi := &file_config_v1_runners_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyRunnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyRunnerRequest) ProtoMessage() {}
+
+func (x *CopyRunnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_runners_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyRunnerRequest.ProtoReflect.Descriptor instead.
+func (*CopyRunnerRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_runners_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CopyRunnerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CopyRunnerRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CopyRunnerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CopyRunnerRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type CopyRunnerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyRunnerResponse) Reset() {
+	*x = CopyRunnerResponse{}
+	mi := &file_config_v1_runners_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyRunnerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyRunnerResponse) ProtoMessage() {}
+
+func (x *CopyRunnerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_runners_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyRunnerResponse.ProtoReflect.Descriptor instead.
+func (*CopyRunnerResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_runners_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CopyRunnerResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 var File_config_v1_runners_proto protoreflect.FileDescriptor
 
 const file_config_v1_runners_proto_rawDesc = "" +
 	"\n" +
-	"\x17config/v1/runners.proto\x12\tconfig.v1\x1a\x1aconfig/v1/pagination.proto\x1a\x14config/v1/file.proto\"\xd4\x01\n" +
+	"\x17config/v1/runners.proto\x12\tconfig.v1\x1a\x1aconfig/v1/pagination.proto\x1a\x14config/v1/file.proto\x1a\x16config/v1/limits.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xac\a\n" +
 	"\x14RunnerPaginationData\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
@@ -669,22 +1730,52 @@ const file_config_v1_runners_proto_rawDesc = "" +
 	"\n" +
 	"run_script\x18\x04 \x01(\tR\trunScript\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x124\n" +
-	"\rinitial_files\x18\x06 \x03(\v2\x0f.config.v1.FileR\finitialFiles\"\x84\x01\n" +
+	"\rinitial_files\x18\x06 \x03(\v2\x0f.config.v1.FileR\finitialFiles\x12\x1b\n" +
+	"\tparent_id\x18\a \x01(\tR\bparentId\x12L\n" +
+	"\tvariables\x18\b \x03(\v2..config.v1.RunnerPaginationData.VariablesEntryR\tvariables\x127\n" +
+	"\x18default_limit_profile_id\x18\t \x01(\tR\x15defaultLimitProfileId\x12H\n" +
+	"\x11limit_multipliers\x18\n" +
+	" \x01(\v2\x1b.config.v1.LimitMultipliersR\x10limitMultipliers\x12#\n" +
+	"\x03env\x18\v \x03(\v2\x11.config.v1.EnvVarR\x03env\x12;\n" +
+	"\vcreate_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x0e \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x0f \x01(\tR\tupdatedBy\x12\x12\n" +
+	"\x04slug\x18\x10 \x01(\tR\x04slug\x12\x18\n" +
+	"\aaliases\x18\x11 \x03(\tR\aaliases\x125\n" +
+	"\bmetadata\x18\x12 \x01(\v2\x19.config.v1.RunnerMetadataR\bmetadata\x12)\n" +
+	"\x10starter_template\x18\x13 \x01(\tR\x0fstarterTemplate\x12\x1f\n" +
+	"\ventry_point\x18\x14 \x01(\tR\n" +
+	"entryPoint\x12\x1c\n" +
+	"\tnamespace\x18\x15 \x01(\tR\tnamespace\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd2\x01\n" +
 	"\x1bGetRunnersPaginationRequest\x12<\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x1c.config.v1.PaginationRequestR\n" +
 	"pagination\x12'\n" +
-	"\x0finclude_scripts\x18\x02 \x01(\bR\x0eincludeScripts\"o\n" +
+	"\x0finclude_scripts\x18\x02 \x01(\bR\x0eincludeScripts\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x1c\n" +
+	"\textension\x18\x05 \x01(\tR\textension\"o\n" +
 	"\x1cGetRunnersPaginationResponse\x129\n" +
 	"\arunners\x18\x01 \x03(\v2\x1f.config.v1.RunnerPaginationDataR\arunners\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count\"\"\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"C\n" +
 	"\x10GetRunnerRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"j\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vinclude_raw\x18\x02 \x01(\bR\n" +
+	"includeRaw\"j\n" +
 	"\x14GetAllRunnersRequest\x12)\n" +
 	"\x10include_metadata\x18\x01 \x01(\bR\x0fincludeMetadata\x12'\n" +
-	"\x0finclude_scripts\x18\x02 \x01(\bR\x0eincludeScripts\"L\n" +
+	"\x0finclude_scripts\x18\x02 \x01(\bR\x0eincludeScripts\"h\n" +
 	"\x15GetAllRunnersResponse\x123\n" +
-	"\arunners\x18\x01 \x03(\v2\x19.config.v1.RunnerResponseR\arunners\"\xce\x01\n" +
+	"\arunners\x18\x01 \x03(\v2\x19.config.v1.RunnerResponseR\arunners\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\"\xcd\a\n" +
 	"\x0eRunnerResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
@@ -692,12 +1783,51 @@ const file_config_v1_runners_proto_rawDesc = "" +
 	"\n" +
 	"run_script\x18\x04 \x01(\tR\trunScript\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x124\n" +
-	"\rinitial_files\x18\x06 \x03(\v2\x0f.config.v1.FileR\finitialFiles\"W\n" +
+	"\rinitial_files\x18\x06 \x03(\v2\x0f.config.v1.FileR\finitialFiles\x12\x1b\n" +
+	"\tparent_id\x18\a \x01(\tR\bparentId\x12F\n" +
+	"\tvariables\x18\b \x03(\v2(.config.v1.RunnerResponse.VariablesEntryR\tvariables\x12+\n" +
+	"\x03raw\x18\t \x01(\v2\x19.config.v1.RunnerResponseR\x03raw\x127\n" +
+	"\x18default_limit_profile_id\x18\n" +
+	" \x01(\tR\x15defaultLimitProfileId\x12H\n" +
+	"\x11limit_multipliers\x18\v \x01(\v2\x1b.config.v1.LimitMultipliersR\x10limitMultipliers\x12#\n" +
+	"\x03env\x18\f \x03(\v2\x11.config.v1.EnvVarR\x03env\x12;\n" +
+	"\vcreate_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x0f \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x10 \x01(\tR\tupdatedBy\x12\x12\n" +
+	"\x04slug\x18\x11 \x01(\tR\x04slug\x12\x18\n" +
+	"\aaliases\x18\x12 \x03(\tR\aaliases\x125\n" +
+	"\bmetadata\x18\x13 \x01(\v2\x19.config.v1.RunnerMetadataR\bmetadata\x12)\n" +
+	"\x10starter_template\x18\x14 \x01(\tR\x0fstarterTemplate\x12\x1f\n" +
+	"\ventry_point\x18\x15 \x01(\tR\n" +
+	"entryPoint\x12\x1c\n" +
+	"\tnamespace\x18\x16 \x01(\tR\tnamespace\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbe\x04\n" +
 	"\x13CreateRunnerRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescriptionJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04\"&\n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tparent_id\x18\x05 \x01(\tR\bparentId\x12K\n" +
+	"\tvariables\x18\x06 \x03(\v2-.config.v1.CreateRunnerRequest.VariablesEntryR\tvariables\x127\n" +
+	"\x18default_limit_profile_id\x18\a \x01(\tR\x15defaultLimitProfileId\x12H\n" +
+	"\x11limit_multipliers\x18\b \x01(\v2\x1b.config.v1.LimitMultipliersR\x10limitMultipliers\x12#\n" +
+	"\x03env\x18\t \x03(\v2\x11.config.v1.EnvVarR\x03env\x12\x12\n" +
+	"\x04slug\x18\n" +
+	" \x01(\tR\x04slug\x125\n" +
+	"\bmetadata\x18\v \x01(\v2\x19.config.v1.RunnerMetadataR\bmetadata\x12)\n" +
+	"\x10starter_template\x18\f \x01(\tR\x0fstarterTemplate\x12\x1f\n" +
+	"\ventry_point\x18\r \x01(\tR\n" +
+	"entryPoint\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x02\x10\x03J\x04\b\x03\x10\x04\"&\n" +
 	"\x14CreateRunnerResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xa0\x02\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xd7\x06\n" +
 	"\x13UpdateRunnerRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12&\n" +
@@ -705,12 +1835,77 @@ const file_config_v1_runners_proto_rawDesc = "" +
 	"\n" +
 	"run_script\x18\x04 \x01(\tH\x02R\trunScript\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x05 \x01(\tH\x03R\vdescription\x88\x01\x01\x124\n" +
-	"\rinitial_files\x18\x06 \x03(\v2\x0f.config.v1.FileR\finitialFilesB\a\n" +
+	"\rinitial_files\x18\x06 \x03(\v2\x0f.config.v1.FileR\finitialFiles\x12 \n" +
+	"\tparent_id\x18\a \x01(\tH\x04R\bparentId\x88\x01\x01\x12K\n" +
+	"\tvariables\x18\b \x03(\v2-.config.v1.UpdateRunnerRequest.VariablesEntryR\tvariables\x12<\n" +
+	"\x18default_limit_profile_id\x18\t \x01(\tH\x05R\x15defaultLimitProfileId\x88\x01\x01\x12H\n" +
+	"\x11limit_multipliers\x18\n" +
+	" \x01(\v2\x1b.config.v1.LimitMultipliersR\x10limitMultipliers\x12#\n" +
+	"\x03env\x18\v \x03(\v2\x11.config.v1.EnvVarR\x03env\x125\n" +
+	"\bmetadata\x18\f \x01(\v2\x19.config.v1.RunnerMetadataR\bmetadata\x12.\n" +
+	"\x10starter_template\x18\r \x01(\tH\x06R\x0fstarterTemplate\x88\x01\x01\x12$\n" +
+	"\ventry_point\x18\x0e \x01(\tH\aR\n" +
+	"entryPoint\x88\x01\x01\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
 	"\x05_nameB\x0f\n" +
 	"\r_build_scriptB\r\n" +
 	"\v_run_scriptB\x0e\n" +
-	"\f_description\"%\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_parent_idB\x1b\n" +
+	"\x19_default_limit_profile_idB\x13\n" +
+	"\x11_starter_templateB\x0e\n" +
+	"\f_entry_point\"%\n" +
 	"\x13DeleteRunnerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"J\n" +
+	"\x06EnvVar\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\bR\x06secret\"\x83\x01\n" +
+	"\x19BatchCreateRunnersRequest\x12:\n" +
+	"\brequests\x18\x01 \x03(\v2\x1e.config.v1.CreateRunnerRequestR\brequests\x12*\n" +
+	"\x11continue_on_error\x18\x02 \x01(\bR\x0fcontinueOnError\"\x83\x01\n" +
+	"\x19BatchUpdateRunnersRequest\x12:\n" +
+	"\brequests\x18\x01 \x03(\v2\x1e.config.v1.UpdateRunnerRequestR\brequests\x12*\n" +
+	"\x11continue_on_error\x18\x02 \x01(\bR\x0fcontinueOnError\"Y\n" +
+	"\x19BatchDeleteRunnersRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12*\n" +
+	"\x11continue_on_error\x18\x02 \x01(\bR\x0fcontinueOnError\"=\n" +
+	"\x17RenameRunnerSlugRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\"\xaf\x01\n" +
+	"\x0eRunnerMetadata\x12\x1a\n" +
+	"\blanguage\x18\x01 \x01(\tR\blanguage\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1e\n" +
+	"\n" +
+	"extensions\x18\x03 \x03(\tR\n" +
+	"extensions\x12\x1f\n" +
+	"\veditor_mode\x18\x04 \x01(\tR\n" +
+	"editorMode\x12\x12\n" +
+	"\x04icon\x18\x05 \x01(\tR\x04icon\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\"\xfb\x01\n" +
+	"\x1cRenderStarterTemplateRequest\x12\x1b\n" +
+	"\trunner_id\x18\x01 \x01(\tR\brunnerId\x12\x1b\n" +
+	"\ttask_name\x18\x02 \x01(\tR\btaskName\x12\x19\n" +
+	"\bio_style\x18\x03 \x01(\tR\aioStyle\x12K\n" +
+	"\x06params\x18\x04 \x03(\v23.config.v1.RenderStarterTemplateRequest.ParamsEntryR\x06params\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"U\n" +
+	"\x1dRenderStarterTemplateResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"A\n" +
+	"\x11MoveRunnerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"i\n" +
+	"\x11CopyRunnerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x04 \x01(\tR\x04slug\"$\n" +
+	"\x12CopyRunnerResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02idB\x94\x01\n" +
 	"\rcom.config.v1B\fRunnersProtoP\x01Z0github.com/CSKU-Lab/config-server/grpc/config/v1\xa2\x02\x03CXX\xaa\x02\tConfig.V1\xca\x02\tConfig\\V1\xe2\x02\x15Config\\V1\\GPBMetadata\xea\x02\n" +
 	"Config::V1b\x06proto3"
@@ -727,34 +1922,76 @@ func file_config_v1_runners_proto_rawDescGZIP() []byte {
 	return file_config_v1_runners_proto_rawDescData
 }
 
-var file_config_v1_runners_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_config_v1_runners_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_config_v1_runners_proto_goTypes = []any{
-	(*RunnerPaginationData)(nil),         // 0: config.v1.RunnerPaginationData
-	(*GetRunnersPaginationRequest)(nil),  // 1: config.v1.GetRunnersPaginationRequest
-	(*GetRunnersPaginationResponse)(nil), // 2: config.v1.GetRunnersPaginationResponse
-	(*GetRunnerRequest)(nil),             // 3: config.v1.GetRunnerRequest
-	(*GetAllRunnersRequest)(nil),         // 4: config.v1.GetAllRunnersRequest
-	(*GetAllRunnersResponse)(nil),        // 5: config.v1.GetAllRunnersResponse
-	(*RunnerResponse)(nil),               // 6: config.v1.RunnerResponse
-	(*CreateRunnerRequest)(nil),          // 7: config.v1.CreateRunnerRequest
-	(*CreateRunnerResponse)(nil),         // 8: config.v1.CreateRunnerResponse
-	(*UpdateRunnerRequest)(nil),          // 9: config.v1.UpdateRunnerRequest
-	(*DeleteRunnerRequest)(nil),          // 10: config.v1.DeleteRunnerRequest
-	(*File)(nil),                         // 11: config.v1.File
-	(*PaginationRequest)(nil),            // 12: config.v1.PaginationRequest
+	(*RunnerPaginationData)(nil),          // 0: config.v1.RunnerPaginationData
+	(*GetRunnersPaginationRequest)(nil),   // 1: config.v1.GetRunnersPaginationRequest
+	(*GetRunnersPaginationResponse)(nil),  // 2: config.v1.GetRunnersPaginationResponse
+	(*GetRunnerRequest)(nil),              // 3: config.v1.GetRunnerRequest
+	(*GetAllRunnersRequest)(nil),          // 4: config.v1.GetAllRunnersRequest
+	(*GetAllRunnersResponse)(nil),         // 5: config.v1.GetAllRunnersResponse
+	(*RunnerResponse)(nil),                // 6: config.v1.RunnerResponse
+	(*CreateRunnerRequest)(nil),           // 7: config.v1.CreateRunnerRequest
+	(*CreateRunnerResponse)(nil),          // 8: config.v1.CreateRunnerResponse
+	(*UpdateRunnerRequest)(nil),           // 9: config.v1.UpdateRunnerRequest
+	(*DeleteRunnerRequest)(nil),           // 10: config.v1.DeleteRunnerRequest
+	(*EnvVar)(nil),                        // 11: config.v1.EnvVar
+	(*BatchCreateRunnersRequest)(nil),     // 12: config.v1.BatchCreateRunnersRequest
+	(*BatchUpdateRunnersRequest)(nil),     // 13: config.v1.BatchUpdateRunnersRequest
+	(*BatchDeleteRunnersRequest)(nil),     // 14: config.v1.BatchDeleteRunnersRequest
+	(*RenameRunnerSlugRequest)(nil),       // 15: config.v1.RenameRunnerSlugRequest
+	(*RunnerMetadata)(nil),                // 16: config.v1.RunnerMetadata
+	(*RenderStarterTemplateRequest)(nil),  // 17: config.v1.RenderStarterTemplateRequest
+	(*RenderStarterTemplateResponse)(nil), // 18: config.v1.RenderStarterTemplateResponse
+	(*MoveRunnerRequest)(nil),             // 19: config.v1.MoveRunnerRequest
+	(*CopyRunnerRequest)(nil),             // 20: config.v1.CopyRunnerRequest
+	(*CopyRunnerResponse)(nil),            // 21: config.v1.CopyRunnerResponse
+	nil,                                   // 22: config.v1.RunnerPaginationData.VariablesEntry
+	nil,                                   // 23: config.v1.RunnerResponse.VariablesEntry
+	nil,                                   // 24: config.v1.CreateRunnerRequest.VariablesEntry
+	nil,                                   // 25: config.v1.UpdateRunnerRequest.VariablesEntry
+	nil,                                   // 26: config.v1.RenderStarterTemplateRequest.ParamsEntry
+	(*File)(nil),                          // 27: config.v1.File
+	(*LimitMultipliers)(nil),              // 28: config.v1.LimitMultipliers
+	(*timestamppb.Timestamp)(nil),         // 29: google.protobuf.Timestamp
+	(*PaginationRequest)(nil),             // 30: config.v1.PaginationRequest
 }
 var file_config_v1_runners_proto_depIdxs = []int32{
-	11, // 0: config.v1.RunnerPaginationData.initial_files:type_name -> config.v1.File
-	12, // 1: config.v1.GetRunnersPaginationRequest.pagination:type_name -> config.v1.PaginationRequest
-	0,  // 2: config.v1.GetRunnersPaginationResponse.runners:type_name -> config.v1.RunnerPaginationData
-	6,  // 3: config.v1.GetAllRunnersResponse.runners:type_name -> config.v1.RunnerResponse
-	11, // 4: config.v1.RunnerResponse.initial_files:type_name -> config.v1.File
-	11, // 5: config.v1.UpdateRunnerRequest.initial_files:type_name -> config.v1.File
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	27, // 0: config.v1.RunnerPaginationData.initial_files:type_name -> config.v1.File
+	22, // 1: config.v1.RunnerPaginationData.variables:type_name -> config.v1.RunnerPaginationData.VariablesEntry
+	28, // 2: config.v1.RunnerPaginationData.limit_multipliers:type_name -> config.v1.LimitMultipliers
+	11, // 3: config.v1.RunnerPaginationData.env:type_name -> config.v1.EnvVar
+	29, // 4: config.v1.RunnerPaginationData.create_time:type_name -> google.protobuf.Timestamp
+	29, // 5: config.v1.RunnerPaginationData.update_time:type_name -> google.protobuf.Timestamp
+	16, // 6: config.v1.RunnerPaginationData.metadata:type_name -> config.v1.RunnerMetadata
+	30, // 7: config.v1.GetRunnersPaginationRequest.pagination:type_name -> config.v1.PaginationRequest
+	0,  // 8: config.v1.GetRunnersPaginationResponse.runners:type_name -> config.v1.RunnerPaginationData
+	6,  // 9: config.v1.GetAllRunnersResponse.runners:type_name -> config.v1.RunnerResponse
+	27, // 10: config.v1.RunnerResponse.initial_files:type_name -> config.v1.File
+	23, // 11: config.v1.RunnerResponse.variables:type_name -> config.v1.RunnerResponse.VariablesEntry
+	6,  // 12: config.v1.RunnerResponse.raw:type_name -> config.v1.RunnerResponse
+	28, // 13: config.v1.RunnerResponse.limit_multipliers:type_name -> config.v1.LimitMultipliers
+	11, // 14: config.v1.RunnerResponse.env:type_name -> config.v1.EnvVar
+	29, // 15: config.v1.RunnerResponse.create_time:type_name -> google.protobuf.Timestamp
+	29, // 16: config.v1.RunnerResponse.update_time:type_name -> google.protobuf.Timestamp
+	16, // 17: config.v1.RunnerResponse.metadata:type_name -> config.v1.RunnerMetadata
+	24, // 18: config.v1.CreateRunnerRequest.variables:type_name -> config.v1.CreateRunnerRequest.VariablesEntry
+	28, // 19: config.v1.CreateRunnerRequest.limit_multipliers:type_name -> config.v1.LimitMultipliers
+	11, // 20: config.v1.CreateRunnerRequest.env:type_name -> config.v1.EnvVar
+	16, // 21: config.v1.CreateRunnerRequest.metadata:type_name -> config.v1.RunnerMetadata
+	27, // 22: config.v1.UpdateRunnerRequest.initial_files:type_name -> config.v1.File
+	25, // 23: config.v1.UpdateRunnerRequest.variables:type_name -> config.v1.UpdateRunnerRequest.VariablesEntry
+	28, // 24: config.v1.UpdateRunnerRequest.limit_multipliers:type_name -> config.v1.LimitMultipliers
+	11, // 25: config.v1.UpdateRunnerRequest.env:type_name -> config.v1.EnvVar
+	16, // 26: config.v1.UpdateRunnerRequest.metadata:type_name -> config.v1.RunnerMetadata
+	7,  // 27: config.v1.BatchCreateRunnersRequest.requests:type_name -> config.v1.CreateRunnerRequest
+	9,  // 28: config.v1.BatchUpdateRunnersRequest.requests:type_name -> config.v1.UpdateRunnerRequest
+	26, // 29: config.v1.RenderStarterTemplateRequest.params:type_name -> config.v1.RenderStarterTemplateRequest.ParamsEntry
+	30, // [30:30] is the sub-list for method output_type
+	30, // [30:30] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_config_v1_runners_proto_init() }
@@ -764,6 +2001,7 @@ func file_config_v1_runners_proto_init() {
 	}
 	file_config_v1_pagination_proto_init()
 	file_config_v1_file_proto_init()
+	file_config_v1_limits_proto_init()
 	file_config_v1_runners_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -771,7 +2009,7 @@ func file_config_v1_runners_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_config_v1_runners_proto_rawDesc), len(file_config_v1_runners_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -7,6 +7,7 @@
 package v1
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -25,46 +26,159 @@ var File_config_v1_service_proto protoreflect.FileDescriptor
 
 const file_config_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\x17config/v1/service.proto\x12\tconfig.v1\x1a\x18config/v1/compares.proto\x1a\x17config/v1/runners.proto\x1a\x1bgoogle/protobuf/empty.proto2\xfd\a\n" +
-	"\rConfigService\x12Q\n" +
-	"\fCreateRunner\x12\x1e.config.v1.CreateRunnerRequest\x1a\x1f.config.v1.CreateRunnerResponse\"\x00\x12i\n" +
-	"\x14GetRunnersPagination\x12&.config.v1.GetRunnersPaginationRequest\x1a'.config.v1.GetRunnersPaginationResponse\"\x00\x12E\n" +
-	"\tGetRunner\x12\x1b.config.v1.GetRunnerRequest\x1a\x19.config.v1.RunnerResponse\"\x00\x12H\n" +
-	"\fUpdateRunner\x12\x1e.config.v1.UpdateRunnerRequest\x1a\x16.google.protobuf.Empty\"\x00\x12H\n" +
-	"\fDeleteRunner\x12\x1e.config.v1.DeleteRunnerRequest\x1a\x16.google.protobuf.Empty\"\x00\x12T\n" +
-	"\rGetAllRunners\x12\x1f.config.v1.GetAllRunnersRequest\x1a .config.v1.GetAllRunnersResponse\"\x00\x12T\n" +
-	"\rCreateCompare\x12\x1f.config.v1.CreateCompareRequest\x1a .config.v1.CreateCompareResponse\"\x00\x12l\n" +
-	"\x15GetComparesPagination\x12'.config.v1.GetComparesPaginationRequest\x1a(.config.v1.GetComparesPaginationResponse\"\x00\x12H\n" +
+	"\x17config/v1/service.proto\x12\tconfig.v1\x1a\x18config/v1/compares.proto\x1a\x17config/v1/runners.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x16config/v1/limits.proto\x1a\x14config/v1/file.proto\x1a\x1cconfig/v1/shared_files.proto\x1a\x17config/v1/archive.proto\x1a\x18config/v1/snapshot.proto\x1a\x18config/v1/revision.proto\x1a\x17config/v1/graders.proto\x1a\x17config/v1/batches.proto2\xd41\n" +
+	"\rConfigService\x12g\n" +
+	"\fCreateRunner\x12\x1e.config.v1.CreateRunnerRequest\x1a\x1f.config.v1.CreateRunnerResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/runners\x12|\n" +
+	"\x14GetRunnersPagination\x12&.config.v1.GetRunnersPaginationRequest\x1a'.config.v1.GetRunnersPaginationResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/runners\x12]\n" +
+	"\tGetRunner\x12\x1b.config.v1.GetRunnerRequest\x1a\x19.config.v1.RunnerResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/runners/{id}\x12c\n" +
+	"\fUpdateRunner\x12\x1e.config.v1.UpdateRunnerRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*2\x10/v1/runners/{id}\x12`\n" +
+	"\fDeleteRunner\x12\x1e.config.v1.DeleteRunnerRequest\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/runners/{id}\x12k\n" +
+	"\rGetAllRunners\x12\x1f.config.v1.GetAllRunnersRequest\x1a .config.v1.GetAllRunnersResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/runners:all\x12k\n" +
+	"\rCreateCompare\x12\x1f.config.v1.CreateCompareRequest\x1a .config.v1.CreateCompareResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/compares\x12\x80\x01\n" +
+	"\x15GetComparesPagination\x12'.config.v1.GetComparesPaginationRequest\x1a(.config.v1.GetComparesPaginationResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/compares\x12a\n" +
 	"\n" +
-	"GetCompare\x12\x1c.config.v1.GetCompareRequest\x1a\x1a.config.v1.CompareResponse\"\x00\x12W\n" +
-	"\x0eGetAllCompares\x12 .config.v1.GetAllComparesRequest\x1a!.config.v1.GetAllComparesResponse\"\x00\x12J\n" +
-	"\rUpdateCompare\x12\x1f.config.v1.UpdateCompareRequest\x1a\x16.google.protobuf.Empty\"\x00\x12J\n" +
-	"\rDeleteCompare\x12\x1f.config.v1.DeleteCompareRequest\x1a\x16.google.protobuf.Empty\"\x00B\x94\x01\n" +
+	"GetCompare\x12\x1c.config.v1.GetCompareRequest\x1a\x1a.config.v1.CompareResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/compares/{id}\x12o\n" +
+	"\x0eGetAllCompares\x12 .config.v1.GetAllComparesRequest\x1a!.config.v1.GetAllComparesResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/compares:all\x12f\n" +
+	"\rUpdateCompare\x12\x1f.config.v1.UpdateCompareRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/compares/{id}\x12c\n" +
+	"\rDeleteCompare\x12\x1f.config.v1.DeleteCompareRequest\x1a\x16.google.protobuf.Empty\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/compares/{id}\x12o\n" +
+	"\vTestCompare\x12\x1d.config.v1.TestCompareRequest\x1a\x1e.config.v1.TestCompareResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/compares/{id}:test\x12{\n" +
+	"\x11GetComparePresets\x12#.config.v1.GetComparePresetsRequest\x1a$.config.v1.GetComparePresetsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/compare-presets\x12\x99\x01\n" +
+	"\x17CreateCompareFromPreset\x12).config.v1.CreateCompareFromPresetRequest\x1a .config.v1.CreateCompareResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/compare-presets/{preset_id}:create\x12\x82\x01\n" +
+	"\x14UpgradeComparePreset\x12&.config.v1.UpgradeComparePresetRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/compares/{id}:upgradePreset\x12\x80\x01\n" +
+	"\x12CreateLimitProfile\x12$.config.v1.CreateLimitProfileRequest\x1a%.config.v1.CreateLimitProfileResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/limit-profiles\x12\x95\x01\n" +
+	"\x1aGetLimitProfilesPagination\x12,.config.v1.GetLimitProfilesPaginationRequest\x1a-.config.v1.GetLimitProfilesPaginationResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/limit-profiles\x12v\n" +
+	"\x0fGetLimitProfile\x12!.config.v1.GetLimitProfileRequest\x1a\x1f.config.v1.LimitProfileResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/limit-profiles/{id}\x12\x84\x01\n" +
+	"\x13GetAllLimitProfiles\x12%.config.v1.GetAllLimitProfilesRequest\x1a&.config.v1.GetAllLimitProfilesResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/limit-profiles:all\x12v\n" +
+	"\x12UpdateLimitProfile\x12$.config.v1.UpdateLimitProfileRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*2\x17/v1/limit-profiles/{id}\x12s\n" +
+	"\x12DeleteLimitProfile\x12$.config.v1.DeleteLimitProfileRequest\x1a\x16.google.protobuf.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v1/limit-profiles/{id}\x12k\n" +
+	"\fResolveLimit\x12\x1e.config.v1.ResolveLimitRequest\x1a\x1f.config.v1.ResolveLimitResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/limits:resolve\x12H\n" +
+	"\fDownloadFile\x12\x1e.config.v1.DownloadFileRequest\x1a\x14.config.v1.FileChunk\"\x000\x01\x12x\n" +
+	"\x10CreateSharedFile\x12\".config.v1.CreateSharedFileRequest\x1a#.config.v1.CreateSharedFileResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/shared-files\x12\x8d\x01\n" +
+	"\x18GetSharedFilesPagination\x12*.config.v1.GetSharedFilesPaginationRequest\x1a+.config.v1.GetSharedFilesPaginationResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/shared-files\x12n\n" +
+	"\rGetSharedFile\x12\x1f.config.v1.GetSharedFileRequest\x1a\x1d.config.v1.SharedFileResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/shared-files/{id}\x12|\n" +
+	"\x11GetAllSharedFiles\x12#.config.v1.GetAllSharedFilesRequest\x1a$.config.v1.GetAllSharedFilesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/shared-files:all\x12p\n" +
+	"\x10UpdateSharedFile\x12\".config.v1.UpdateSharedFileRequest\x1a\x16.google.protobuf.Empty\" \x82\xd3\xe4\x93\x02\x1a:\x01*2\x15/v1/shared-files/{id}\x12m\n" +
+	"\x10DeleteSharedFile\x12\".config.v1.DeleteSharedFileRequest\x1a\x16.google.protobuf.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/shared-files/{id}\x12k\n" +
+	"\rGetFileUsages\x12\x1f.config.v1.GetFileUsagesRequest\x1a .config.v1.GetFileUsagesResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/file-usages\x12]\n" +
+	"\x14ImportCompareArchive\x12\x1f.config.v1.ImportArchiveRequest\x1a .config.v1.CreateCompareResponse\"\x00(\x01\x12T\n" +
+	"\x14ExportCompareArchive\x12\x1f.config.v1.ExportArchiveRequest\x1a\x17.config.v1.ArchiveChunk\"\x000\x01\x12[\n" +
+	"\x13ImportRunnerArchive\x12\x1f.config.v1.ImportArchiveRequest\x1a\x1f.config.v1.CreateRunnerResponse\"\x00(\x01\x12S\n" +
+	"\x13ExportRunnerArchive\x12\x1f.config.v1.ExportArchiveRequest\x1a\x17.config.v1.ArchiveChunk\"\x000\x01\x12;\n" +
+	"\x04Sync\x12\x16.config.v1.SyncRequest\x1a\x17.config.v1.SyncResponse\"\x00(\x01\x12O\n" +
+	"\x0eExportSnapshot\x12 .config.v1.ExportSnapshotRequest\x1a\x17.config.v1.ArchiveChunk\"\x000\x01\x12Y\n" +
+	"\x0eImportSnapshot\x12 .config.v1.ImportSnapshotRequest\x1a!.config.v1.ImportSnapshotResponse\"\x00(\x01\x12H\n" +
+	"\vWatchConfig\x12\x1d.config.v1.WatchConfigRequest\x1a\x16.config.v1.ConfigEvent\"\x000\x01\x12o\n" +
+	"\x0eGetConfigDelta\x12 .config.v1.GetConfigDeltaRequest\x1a!.config.v1.GetConfigDeltaResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/config/delta\x12m\n" +
+	"\x0fGetGraderStatus\x12!.config.v1.GetGraderStatusRequest\x1a\".config.v1.GetGraderStatusResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/graders\x12a\n" +
+	"\n" +
+	"BeginBatch\x12\x1c.config.v1.BeginBatchRequest\x1a\x1d.config.v1.BeginBatchResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/batches\x12n\n" +
+	"\vCommitBatch\x12\x1d.config.v1.CommitBatchRequest\x1a\x16.google.protobuf.Empty\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/batches/{batch_id}:commit\x12\x80\x01\n" +
+	"\x12BatchCreateRunners\x12$.config.v1.BatchCreateRunnersRequest\x1a .config.v1.BatchMutationResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/runners:batchCreate\x12\x80\x01\n" +
+	"\x12BatchUpdateRunners\x12$.config.v1.BatchUpdateRunnersRequest\x1a .config.v1.BatchMutationResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/runners:batchUpdate\x12\x80\x01\n" +
+	"\x12BatchDeleteRunners\x12$.config.v1.BatchDeleteRunnersRequest\x1a .config.v1.BatchMutationResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/runners:batchDelete\x12\x83\x01\n" +
+	"\x13BatchCreateCompares\x12%.config.v1.BatchCreateComparesRequest\x1a .config.v1.BatchMutationResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/compares:batchCreate\x12\x83\x01\n" +
+	"\x13BatchUpdateCompares\x12%.config.v1.BatchUpdateComparesRequest\x1a .config.v1.BatchMutationResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/compares:batchUpdate\x12\x83\x01\n" +
+	"\x13BatchDeleteCompares\x12%.config.v1.BatchDeleteComparesRequest\x1a .config.v1.BatchMutationResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/compares:batchDelete\x12v\n" +
+	"\x10RenameRunnerSlug\x12\".config.v1.RenameRunnerSlugRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/runners/{id}:renameSlug\x12y\n" +
+	"\x11RenameCompareSlug\x12#.config.v1.RenameCompareSlugRequest\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/compares/{id}:renameSlug\x12\x9c\x01\n" +
+	"\x15RenderStarterTemplate\x12'.config.v1.RenderStarterTemplateRequest\x1a(.config.v1.RenderStarterTemplateResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/runners/{runner_id}:renderStarter\x12d\n" +
+	"\n" +
+	"MoveRunner\x12\x1c.config.v1.MoveRunnerRequest\x1a\x16.google.protobuf.Empty\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/runners/{id}:move\x12k\n" +
+	"\n" +
+	"CopyRunner\x12\x1c.config.v1.CopyRunnerRequest\x1a\x1d.config.v1.CopyRunnerResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/runners/{id}:copy\x12g\n" +
+	"\vMoveCompare\x12\x1d.config.v1.MoveCompareRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/compares/{id}:move\x12o\n" +
+	"\vCopyCompare\x12\x1d.config.v1.CopyCompareRequest\x1a\x1e.config.v1.CopyCompareResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/compares/{id}:copyB\x94\x01\n" +
 	"\rcom.config.v1B\fServiceProtoP\x01Z0github.com/CSKU-Lab/config-server/grpc/config/v1\xa2\x02\x03CXX\xaa\x02\tConfig.V1\xca\x02\tConfig\\V1\xe2\x02\x15Config\\V1\\GPBMetadata\xea\x02\n" +
 	"Config::V1b\x06proto3"
 
 var file_config_v1_service_proto_goTypes = []any{
-	(*CreateRunnerRequest)(nil),           // 0: config.v1.CreateRunnerRequest
-	(*GetRunnersPaginationRequest)(nil),   // 1: config.v1.GetRunnersPaginationRequest
-	(*GetRunnerRequest)(nil),              // 2: config.v1.GetRunnerRequest
-	(*UpdateRunnerRequest)(nil),           // 3: config.v1.UpdateRunnerRequest
-	(*DeleteRunnerRequest)(nil),           // 4: config.v1.DeleteRunnerRequest
-	(*GetAllRunnersRequest)(nil),          // 5: config.v1.GetAllRunnersRequest
-	(*CreateCompareRequest)(nil),          // 6: config.v1.CreateCompareRequest
-	(*GetComparesPaginationRequest)(nil),  // 7: config.v1.GetComparesPaginationRequest
-	(*GetCompareRequest)(nil),             // 8: config.v1.GetCompareRequest
-	(*GetAllComparesRequest)(nil),         // 9: config.v1.GetAllComparesRequest
-	(*UpdateCompareRequest)(nil),          // 10: config.v1.UpdateCompareRequest
-	(*DeleteCompareRequest)(nil),          // 11: config.v1.DeleteCompareRequest
-	(*CreateRunnerResponse)(nil),          // 12: config.v1.CreateRunnerResponse
-	(*GetRunnersPaginationResponse)(nil),  // 13: config.v1.GetRunnersPaginationResponse
-	(*RunnerResponse)(nil),                // 14: config.v1.RunnerResponse
-	(*emptypb.Empty)(nil),                 // 15: google.protobuf.Empty
-	(*GetAllRunnersResponse)(nil),         // 16: config.v1.GetAllRunnersResponse
-	(*CreateCompareResponse)(nil),         // 17: config.v1.CreateCompareResponse
-	(*GetComparesPaginationResponse)(nil), // 18: config.v1.GetComparesPaginationResponse
-	(*CompareResponse)(nil),               // 19: config.v1.CompareResponse
-	(*GetAllComparesResponse)(nil),        // 20: config.v1.GetAllComparesResponse
+	(*CreateRunnerRequest)(nil),                // 0: config.v1.CreateRunnerRequest
+	(*GetRunnersPaginationRequest)(nil),        // 1: config.v1.GetRunnersPaginationRequest
+	(*GetRunnerRequest)(nil),                   // 2: config.v1.GetRunnerRequest
+	(*UpdateRunnerRequest)(nil),                // 3: config.v1.UpdateRunnerRequest
+	(*DeleteRunnerRequest)(nil),                // 4: config.v1.DeleteRunnerRequest
+	(*GetAllRunnersRequest)(nil),               // 5: config.v1.GetAllRunnersRequest
+	(*CreateCompareRequest)(nil),               // 6: config.v1.CreateCompareRequest
+	(*GetComparesPaginationRequest)(nil),       // 7: config.v1.GetComparesPaginationRequest
+	(*GetCompareRequest)(nil),                  // 8: config.v1.GetCompareRequest
+	(*GetAllComparesRequest)(nil),              // 9: config.v1.GetAllComparesRequest
+	(*UpdateCompareRequest)(nil),               // 10: config.v1.UpdateCompareRequest
+	(*DeleteCompareRequest)(nil),               // 11: config.v1.DeleteCompareRequest
+	(*TestCompareRequest)(nil),                 // 12: config.v1.TestCompareRequest
+	(*GetComparePresetsRequest)(nil),           // 13: config.v1.GetComparePresetsRequest
+	(*CreateCompareFromPresetRequest)(nil),     // 14: config.v1.CreateCompareFromPresetRequest
+	(*UpgradeComparePresetRequest)(nil),        // 15: config.v1.UpgradeComparePresetRequest
+	(*CreateLimitProfileRequest)(nil),          // 16: config.v1.CreateLimitProfileRequest
+	(*GetLimitProfilesPaginationRequest)(nil),  // 17: config.v1.GetLimitProfilesPaginationRequest
+	(*GetLimitProfileRequest)(nil),             // 18: config.v1.GetLimitProfileRequest
+	(*GetAllLimitProfilesRequest)(nil),         // 19: config.v1.GetAllLimitProfilesRequest
+	(*UpdateLimitProfileRequest)(nil),          // 20: config.v1.UpdateLimitProfileRequest
+	(*DeleteLimitProfileRequest)(nil),          // 21: config.v1.DeleteLimitProfileRequest
+	(*ResolveLimitRequest)(nil),                // 22: config.v1.ResolveLimitRequest
+	(*DownloadFileRequest)(nil),                // 23: config.v1.DownloadFileRequest
+	(*CreateSharedFileRequest)(nil),            // 24: config.v1.CreateSharedFileRequest
+	(*GetSharedFilesPaginationRequest)(nil),    // 25: config.v1.GetSharedFilesPaginationRequest
+	(*GetSharedFileRequest)(nil),               // 26: config.v1.GetSharedFileRequest
+	(*GetAllSharedFilesRequest)(nil),           // 27: config.v1.GetAllSharedFilesRequest
+	(*UpdateSharedFileRequest)(nil),            // 28: config.v1.UpdateSharedFileRequest
+	(*DeleteSharedFileRequest)(nil),            // 29: config.v1.DeleteSharedFileRequest
+	(*GetFileUsagesRequest)(nil),               // 30: config.v1.GetFileUsagesRequest
+	(*ImportArchiveRequest)(nil),               // 31: config.v1.ImportArchiveRequest
+	(*ExportArchiveRequest)(nil),               // 32: config.v1.ExportArchiveRequest
+	(*SyncRequest)(nil),                        // 33: config.v1.SyncRequest
+	(*ExportSnapshotRequest)(nil),              // 34: config.v1.ExportSnapshotRequest
+	(*ImportSnapshotRequest)(nil),              // 35: config.v1.ImportSnapshotRequest
+	(*WatchConfigRequest)(nil),                 // 36: config.v1.WatchConfigRequest
+	(*GetConfigDeltaRequest)(nil),              // 37: config.v1.GetConfigDeltaRequest
+	(*GetGraderStatusRequest)(nil),             // 38: config.v1.GetGraderStatusRequest
+	(*BeginBatchRequest)(nil),                  // 39: config.v1.BeginBatchRequest
+	(*CommitBatchRequest)(nil),                 // 40: config.v1.CommitBatchRequest
+	(*BatchCreateRunnersRequest)(nil),          // 41: config.v1.BatchCreateRunnersRequest
+	(*BatchUpdateRunnersRequest)(nil),          // 42: config.v1.BatchUpdateRunnersRequest
+	(*BatchDeleteRunnersRequest)(nil),          // 43: config.v1.BatchDeleteRunnersRequest
+	(*BatchCreateComparesRequest)(nil),         // 44: config.v1.BatchCreateComparesRequest
+	(*BatchUpdateComparesRequest)(nil),         // 45: config.v1.BatchUpdateComparesRequest
+	(*BatchDeleteComparesRequest)(nil),         // 46: config.v1.BatchDeleteComparesRequest
+	(*RenameRunnerSlugRequest)(nil),            // 47: config.v1.RenameRunnerSlugRequest
+	(*RenameCompareSlugRequest)(nil),           // 48: config.v1.RenameCompareSlugRequest
+	(*RenderStarterTemplateRequest)(nil),       // 49: config.v1.RenderStarterTemplateRequest
+	(*MoveRunnerRequest)(nil),                  // 50: config.v1.MoveRunnerRequest
+	(*CopyRunnerRequest)(nil),                  // 51: config.v1.CopyRunnerRequest
+	(*MoveCompareRequest)(nil),                 // 52: config.v1.MoveCompareRequest
+	(*CopyCompareRequest)(nil),                 // 53: config.v1.CopyCompareRequest
+	(*CreateRunnerResponse)(nil),               // 54: config.v1.CreateRunnerResponse
+	(*GetRunnersPaginationResponse)(nil),       // 55: config.v1.GetRunnersPaginationResponse
+	(*RunnerResponse)(nil),                     // 56: config.v1.RunnerResponse
+	(*emptypb.Empty)(nil),                      // 57: google.protobuf.Empty
+	(*GetAllRunnersResponse)(nil),              // 58: config.v1.GetAllRunnersResponse
+	(*CreateCompareResponse)(nil),              // 59: config.v1.CreateCompareResponse
+	(*GetComparesPaginationResponse)(nil),      // 60: config.v1.GetComparesPaginationResponse
+	(*CompareResponse)(nil),                    // 61: config.v1.CompareResponse
+	(*GetAllComparesResponse)(nil),             // 62: config.v1.GetAllComparesResponse
+	(*TestCompareResponse)(nil),                // 63: config.v1.TestCompareResponse
+	(*GetComparePresetsResponse)(nil),          // 64: config.v1.GetComparePresetsResponse
+	(*CreateLimitProfileResponse)(nil),         // 65: config.v1.CreateLimitProfileResponse
+	(*GetLimitProfilesPaginationResponse)(nil), // 66: config.v1.GetLimitProfilesPaginationResponse
+	(*LimitProfileResponse)(nil),               // 67: config.v1.LimitProfileResponse
+	(*GetAllLimitProfilesResponse)(nil),        // 68: config.v1.GetAllLimitProfilesResponse
+	(*ResolveLimitResponse)(nil),               // 69: config.v1.ResolveLimitResponse
+	(*FileChunk)(nil),                          // 70: config.v1.FileChunk
+	(*CreateSharedFileResponse)(nil),           // 71: config.v1.CreateSharedFileResponse
+	(*GetSharedFilesPaginationResponse)(nil),   // 72: config.v1.GetSharedFilesPaginationResponse
+	(*SharedFileResponse)(nil),                 // 73: config.v1.SharedFileResponse
+	(*GetAllSharedFilesResponse)(nil),          // 74: config.v1.GetAllSharedFilesResponse
+	(*GetFileUsagesResponse)(nil),              // 75: config.v1.GetFileUsagesResponse
+	(*ArchiveChunk)(nil),                       // 76: config.v1.ArchiveChunk
+	(*SyncResponse)(nil),                       // 77: config.v1.SyncResponse
+	(*ImportSnapshotResponse)(nil),             // 78: config.v1.ImportSnapshotResponse
+	(*ConfigEvent)(nil),                        // 79: config.v1.ConfigEvent
+	(*GetConfigDeltaResponse)(nil),             // 80: config.v1.GetConfigDeltaResponse
+	(*GetGraderStatusResponse)(nil),            // 81: config.v1.GetGraderStatusResponse
+	(*BeginBatchResponse)(nil),                 // 82: config.v1.BeginBatchResponse
+	(*BatchMutationResponse)(nil),              // 83: config.v1.BatchMutationResponse
+	(*RenderStarterTemplateResponse)(nil),      // 84: config.v1.RenderStarterTemplateResponse
+	(*CopyRunnerResponse)(nil),                 // 85: config.v1.CopyRunnerResponse
+	(*CopyCompareResponse)(nil),                // 86: config.v1.CopyCompareResponse
 }
 var file_config_v1_service_proto_depIdxs = []int32{
 	0,  // 0: config.v1.ConfigService.CreateRunner:input_type -> config.v1.CreateRunnerRequest
@@ -79,20 +193,108 @@ var file_config_v1_service_proto_depIdxs = []int32{
 	9,  // 9: config.v1.ConfigService.GetAllCompares:input_type -> config.v1.GetAllComparesRequest
 	10, // 10: config.v1.ConfigService.UpdateCompare:input_type -> config.v1.UpdateCompareRequest
 	11, // 11: config.v1.ConfigService.DeleteCompare:input_type -> config.v1.DeleteCompareRequest
-	12, // 12: config.v1.ConfigService.CreateRunner:output_type -> config.v1.CreateRunnerResponse
-	13, // 13: config.v1.ConfigService.GetRunnersPagination:output_type -> config.v1.GetRunnersPaginationResponse
-	14, // 14: config.v1.ConfigService.GetRunner:output_type -> config.v1.RunnerResponse
-	15, // 15: config.v1.ConfigService.UpdateRunner:output_type -> google.protobuf.Empty
-	15, // 16: config.v1.ConfigService.DeleteRunner:output_type -> google.protobuf.Empty
-	16, // 17: config.v1.ConfigService.GetAllRunners:output_type -> config.v1.GetAllRunnersResponse
-	17, // 18: config.v1.ConfigService.CreateCompare:output_type -> config.v1.CreateCompareResponse
-	18, // 19: config.v1.ConfigService.GetComparesPagination:output_type -> config.v1.GetComparesPaginationResponse
-	19, // 20: config.v1.ConfigService.GetCompare:output_type -> config.v1.CompareResponse
-	20, // 21: config.v1.ConfigService.GetAllCompares:output_type -> config.v1.GetAllComparesResponse
-	15, // 22: config.v1.ConfigService.UpdateCompare:output_type -> google.protobuf.Empty
-	15, // 23: config.v1.ConfigService.DeleteCompare:output_type -> google.protobuf.Empty
-	12, // [12:24] is the sub-list for method output_type
-	0,  // [0:12] is the sub-list for method input_type
+	12, // 12: config.v1.ConfigService.TestCompare:input_type -> config.v1.TestCompareRequest
+	13, // 13: config.v1.ConfigService.GetComparePresets:input_type -> config.v1.GetComparePresetsRequest
+	14, // 14: config.v1.ConfigService.CreateCompareFromPreset:input_type -> config.v1.CreateCompareFromPresetRequest
+	15, // 15: config.v1.ConfigService.UpgradeComparePreset:input_type -> config.v1.UpgradeComparePresetRequest
+	16, // 16: config.v1.ConfigService.CreateLimitProfile:input_type -> config.v1.CreateLimitProfileRequest
+	17, // 17: config.v1.ConfigService.GetLimitProfilesPagination:input_type -> config.v1.GetLimitProfilesPaginationRequest
+	18, // 18: config.v1.ConfigService.GetLimitProfile:input_type -> config.v1.GetLimitProfileRequest
+	19, // 19: config.v1.ConfigService.GetAllLimitProfiles:input_type -> config.v1.GetAllLimitProfilesRequest
+	20, // 20: config.v1.ConfigService.UpdateLimitProfile:input_type -> config.v1.UpdateLimitProfileRequest
+	21, // 21: config.v1.ConfigService.DeleteLimitProfile:input_type -> config.v1.DeleteLimitProfileRequest
+	22, // 22: config.v1.ConfigService.ResolveLimit:input_type -> config.v1.ResolveLimitRequest
+	23, // 23: config.v1.ConfigService.DownloadFile:input_type -> config.v1.DownloadFileRequest
+	24, // 24: config.v1.ConfigService.CreateSharedFile:input_type -> config.v1.CreateSharedFileRequest
+	25, // 25: config.v1.ConfigService.GetSharedFilesPagination:input_type -> config.v1.GetSharedFilesPaginationRequest
+	26, // 26: config.v1.ConfigService.GetSharedFile:input_type -> config.v1.GetSharedFileRequest
+	27, // 27: config.v1.ConfigService.GetAllSharedFiles:input_type -> config.v1.GetAllSharedFilesRequest
+	28, // 28: config.v1.ConfigService.UpdateSharedFile:input_type -> config.v1.UpdateSharedFileRequest
+	29, // 29: config.v1.ConfigService.DeleteSharedFile:input_type -> config.v1.DeleteSharedFileRequest
+	30, // 30: config.v1.ConfigService.GetFileUsages:input_type -> config.v1.GetFileUsagesRequest
+	31, // 31: config.v1.ConfigService.ImportCompareArchive:input_type -> config.v1.ImportArchiveRequest
+	32, // 32: config.v1.ConfigService.ExportCompareArchive:input_type -> config.v1.ExportArchiveRequest
+	31, // 33: config.v1.ConfigService.ImportRunnerArchive:input_type -> config.v1.ImportArchiveRequest
+	32, // 34: config.v1.ConfigService.ExportRunnerArchive:input_type -> config.v1.ExportArchiveRequest
+	33, // 35: config.v1.ConfigService.Sync:input_type -> config.v1.SyncRequest
+	34, // 36: config.v1.ConfigService.ExportSnapshot:input_type -> config.v1.ExportSnapshotRequest
+	35, // 37: config.v1.ConfigService.ImportSnapshot:input_type -> config.v1.ImportSnapshotRequest
+	36, // 38: config.v1.ConfigService.WatchConfig:input_type -> config.v1.WatchConfigRequest
+	37, // 39: config.v1.ConfigService.GetConfigDelta:input_type -> config.v1.GetConfigDeltaRequest
+	38, // 40: config.v1.ConfigService.GetGraderStatus:input_type -> config.v1.GetGraderStatusRequest
+	39, // 41: config.v1.ConfigService.BeginBatch:input_type -> config.v1.BeginBatchRequest
+	40, // 42: config.v1.ConfigService.CommitBatch:input_type -> config.v1.CommitBatchRequest
+	41, // 43: config.v1.ConfigService.BatchCreateRunners:input_type -> config.v1.BatchCreateRunnersRequest
+	42, // 44: config.v1.ConfigService.BatchUpdateRunners:input_type -> config.v1.BatchUpdateRunnersRequest
+	43, // 45: config.v1.ConfigService.BatchDeleteRunners:input_type -> config.v1.BatchDeleteRunnersRequest
+	44, // 46: config.v1.ConfigService.BatchCreateCompares:input_type -> config.v1.BatchCreateComparesRequest
+	45, // 47: config.v1.ConfigService.BatchUpdateCompares:input_type -> config.v1.BatchUpdateComparesRequest
+	46, // 48: config.v1.ConfigService.BatchDeleteCompares:input_type -> config.v1.BatchDeleteComparesRequest
+	47, // 49: config.v1.ConfigService.RenameRunnerSlug:input_type -> config.v1.RenameRunnerSlugRequest
+	48, // 50: config.v1.ConfigService.RenameCompareSlug:input_type -> config.v1.RenameCompareSlugRequest
+	49, // 51: config.v1.ConfigService.RenderStarterTemplate:input_type -> config.v1.RenderStarterTemplateRequest
+	50, // 52: config.v1.ConfigService.MoveRunner:input_type -> config.v1.MoveRunnerRequest
+	51, // 53: config.v1.ConfigService.CopyRunner:input_type -> config.v1.CopyRunnerRequest
+	52, // 54: config.v1.ConfigService.MoveCompare:input_type -> config.v1.MoveCompareRequest
+	53, // 55: config.v1.ConfigService.CopyCompare:input_type -> config.v1.CopyCompareRequest
+	54, // 56: config.v1.ConfigService.CreateRunner:output_type -> config.v1.CreateRunnerResponse
+	55, // 57: config.v1.ConfigService.GetRunnersPagination:output_type -> config.v1.GetRunnersPaginationResponse
+	56, // 58: config.v1.ConfigService.GetRunner:output_type -> config.v1.RunnerResponse
+	57, // 59: config.v1.ConfigService.UpdateRunner:output_type -> google.protobuf.Empty
+	57, // 60: config.v1.ConfigService.DeleteRunner:output_type -> google.protobuf.Empty
+	58, // 61: config.v1.ConfigService.GetAllRunners:output_type -> config.v1.GetAllRunnersResponse
+	59, // 62: config.v1.ConfigService.CreateCompare:output_type -> config.v1.CreateCompareResponse
+	60, // 63: config.v1.ConfigService.GetComparesPagination:output_type -> config.v1.GetComparesPaginationResponse
+	61, // 64: config.v1.ConfigService.GetCompare:output_type -> config.v1.CompareResponse
+	62, // 65: config.v1.ConfigService.GetAllCompares:output_type -> config.v1.GetAllComparesResponse
+	57, // 66: config.v1.ConfigService.UpdateCompare:output_type -> google.protobuf.Empty
+	57, // 67: config.v1.ConfigService.DeleteCompare:output_type -> google.protobuf.Empty
+	63, // 68: config.v1.ConfigService.TestCompare:output_type -> config.v1.TestCompareResponse
+	64, // 69: config.v1.ConfigService.GetComparePresets:output_type -> config.v1.GetComparePresetsResponse
+	59, // 70: config.v1.ConfigService.CreateCompareFromPreset:output_type -> config.v1.CreateCompareResponse
+	57, // 71: config.v1.ConfigService.UpgradeComparePreset:output_type -> google.protobuf.Empty
+	65, // 72: config.v1.ConfigService.CreateLimitProfile:output_type -> config.v1.CreateLimitProfileResponse
+	66, // 73: config.v1.ConfigService.GetLimitProfilesPagination:output_type -> config.v1.GetLimitProfilesPaginationResponse
+	67, // 74: config.v1.ConfigService.GetLimitProfile:output_type -> config.v1.LimitProfileResponse
+	68, // 75: config.v1.ConfigService.GetAllLimitProfiles:output_type -> config.v1.GetAllLimitProfilesResponse
+	57, // 76: config.v1.ConfigService.UpdateLimitProfile:output_type -> google.protobuf.Empty
+	57, // 77: config.v1.ConfigService.DeleteLimitProfile:output_type -> google.protobuf.Empty
+	69, // 78: config.v1.ConfigService.ResolveLimit:output_type -> config.v1.ResolveLimitResponse
+	70, // 79: config.v1.ConfigService.DownloadFile:output_type -> config.v1.FileChunk
+	71, // 80: config.v1.ConfigService.CreateSharedFile:output_type -> config.v1.CreateSharedFileResponse
+	72, // 81: config.v1.ConfigService.GetSharedFilesPagination:output_type -> config.v1.GetSharedFilesPaginationResponse
+	73, // 82: config.v1.ConfigService.GetSharedFile:output_type -> config.v1.SharedFileResponse
+	74, // 83: config.v1.ConfigService.GetAllSharedFiles:output_type -> config.v1.GetAllSharedFilesResponse
+	57, // 84: config.v1.ConfigService.UpdateSharedFile:output_type -> google.protobuf.Empty
+	57, // 85: config.v1.ConfigService.DeleteSharedFile:output_type -> google.protobuf.Empty
+	75, // 86: config.v1.ConfigService.GetFileUsages:output_type -> config.v1.GetFileUsagesResponse
+	59, // 87: config.v1.ConfigService.ImportCompareArchive:output_type -> config.v1.CreateCompareResponse
+	76, // 88: config.v1.ConfigService.ExportCompareArchive:output_type -> config.v1.ArchiveChunk
+	54, // 89: config.v1.ConfigService.ImportRunnerArchive:output_type -> config.v1.CreateRunnerResponse
+	76, // 90: config.v1.ConfigService.ExportRunnerArchive:output_type -> config.v1.ArchiveChunk
+	77, // 91: config.v1.ConfigService.Sync:output_type -> config.v1.SyncResponse
+	76, // 92: config.v1.ConfigService.ExportSnapshot:output_type -> config.v1.ArchiveChunk
+	78, // 93: config.v1.ConfigService.ImportSnapshot:output_type -> config.v1.ImportSnapshotResponse
+	79, // 94: config.v1.ConfigService.WatchConfig:output_type -> config.v1.ConfigEvent
+	80, // 95: config.v1.ConfigService.GetConfigDelta:output_type -> config.v1.GetConfigDeltaResponse
+	81, // 96: config.v1.ConfigService.GetGraderStatus:output_type -> config.v1.GetGraderStatusResponse
+	82, // 97: config.v1.ConfigService.BeginBatch:output_type -> config.v1.BeginBatchResponse
+	57, // 98: config.v1.ConfigService.CommitBatch:output_type -> google.protobuf.Empty
+	83, // 99: config.v1.ConfigService.BatchCreateRunners:output_type -> config.v1.BatchMutationResponse
+	83, // 100: config.v1.ConfigService.BatchUpdateRunners:output_type -> config.v1.BatchMutationResponse
+	83, // 101: config.v1.ConfigService.BatchDeleteRunners:output_type -> config.v1.BatchMutationResponse
+	83, // 102: config.v1.ConfigService.BatchCreateCompares:output_type -> config.v1.BatchMutationResponse
+	83, // 103: config.v1.ConfigService.BatchUpdateCompares:output_type -> config.v1.BatchMutationResponse
+	83, // 104: config.v1.ConfigService.BatchDeleteCompares:output_type -> config.v1.BatchMutationResponse
+	57, // 105: config.v1.ConfigService.RenameRunnerSlug:output_type -> google.protobuf.Empty
+	57, // 106: config.v1.ConfigService.RenameCompareSlug:output_type -> google.protobuf.Empty
+	84, // 107: config.v1.ConfigService.RenderStarterTemplate:output_type -> config.v1.RenderStarterTemplateResponse
+	57, // 108: config.v1.ConfigService.MoveRunner:output_type -> google.protobuf.Empty
+	85, // 109: config.v1.ConfigService.CopyRunner:output_type -> config.v1.CopyRunnerResponse
+	57, // 110: config.v1.ConfigService.MoveCompare:output_type -> google.protobuf.Empty
+	86, // 111: config.v1.ConfigService.CopyCompare:output_type -> config.v1.CopyCompareResponse
+	56, // [56:112] is the sub-list for method output_type
+	0,  // [0:56] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -105,6 +307,14 @@ func file_config_v1_service_proto_init() {
 	}
 	file_config_v1_compares_proto_init()
 	file_config_v1_runners_proto_init()
+	file_config_v1_limits_proto_init()
+	file_config_v1_file_proto_init()
+	file_config_v1_shared_files_proto_init()
+	file_config_v1_archive_proto_init()
+	file_config_v1_snapshot_proto_init()
+	file_config_v1_revision_proto_init()
+	file_config_v1_graders_proto_init()
+	file_config_v1_batches_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -20,18 +20,62 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ConfigService_CreateRunner_FullMethodName          = "/config.v1.ConfigService/CreateRunner"
-	ConfigService_GetRunnersPagination_FullMethodName  = "/config.v1.ConfigService/GetRunnersPagination"
-	ConfigService_GetRunner_FullMethodName             = "/config.v1.ConfigService/GetRunner"
-	ConfigService_UpdateRunner_FullMethodName          = "/config.v1.ConfigService/UpdateRunner"
-	ConfigService_DeleteRunner_FullMethodName          = "/config.v1.ConfigService/DeleteRunner"
-	ConfigService_GetAllRunners_FullMethodName         = "/config.v1.ConfigService/GetAllRunners"
-	ConfigService_CreateCompare_FullMethodName         = "/config.v1.ConfigService/CreateCompare"
-	ConfigService_GetComparesPagination_FullMethodName = "/config.v1.ConfigService/GetComparesPagination"
-	ConfigService_GetCompare_FullMethodName            = "/config.v1.ConfigService/GetCompare"
-	ConfigService_GetAllCompares_FullMethodName        = "/config.v1.ConfigService/GetAllCompares"
-	ConfigService_UpdateCompare_FullMethodName         = "/config.v1.ConfigService/UpdateCompare"
-	ConfigService_DeleteCompare_FullMethodName         = "/config.v1.ConfigService/DeleteCompare"
+	ConfigService_CreateRunner_FullMethodName               = "/config.v1.ConfigService/CreateRunner"
+	ConfigService_GetRunnersPagination_FullMethodName       = "/config.v1.ConfigService/GetRunnersPagination"
+	ConfigService_GetRunner_FullMethodName                  = "/config.v1.ConfigService/GetRunner"
+	ConfigService_UpdateRunner_FullMethodName               = "/config.v1.ConfigService/UpdateRunner"
+	ConfigService_DeleteRunner_FullMethodName               = "/config.v1.ConfigService/DeleteRunner"
+	ConfigService_GetAllRunners_FullMethodName              = "/config.v1.ConfigService/GetAllRunners"
+	ConfigService_CreateCompare_FullMethodName              = "/config.v1.ConfigService/CreateCompare"
+	ConfigService_GetComparesPagination_FullMethodName      = "/config.v1.ConfigService/GetComparesPagination"
+	ConfigService_GetCompare_FullMethodName                 = "/config.v1.ConfigService/GetCompare"
+	ConfigService_GetAllCompares_FullMethodName             = "/config.v1.ConfigService/GetAllCompares"
+	ConfigService_UpdateCompare_FullMethodName              = "/config.v1.ConfigService/UpdateCompare"
+	ConfigService_DeleteCompare_FullMethodName              = "/config.v1.ConfigService/DeleteCompare"
+	ConfigService_TestCompare_FullMethodName                = "/config.v1.ConfigService/TestCompare"
+	ConfigService_GetComparePresets_FullMethodName          = "/config.v1.ConfigService/GetComparePresets"
+	ConfigService_CreateCompareFromPreset_FullMethodName    = "/config.v1.ConfigService/CreateCompareFromPreset"
+	ConfigService_UpgradeComparePreset_FullMethodName       = "/config.v1.ConfigService/UpgradeComparePreset"
+	ConfigService_CreateLimitProfile_FullMethodName         = "/config.v1.ConfigService/CreateLimitProfile"
+	ConfigService_GetLimitProfilesPagination_FullMethodName = "/config.v1.ConfigService/GetLimitProfilesPagination"
+	ConfigService_GetLimitProfile_FullMethodName            = "/config.v1.ConfigService/GetLimitProfile"
+	ConfigService_GetAllLimitProfiles_FullMethodName        = "/config.v1.ConfigService/GetAllLimitProfiles"
+	ConfigService_UpdateLimitProfile_FullMethodName         = "/config.v1.ConfigService/UpdateLimitProfile"
+	ConfigService_DeleteLimitProfile_FullMethodName         = "/config.v1.ConfigService/DeleteLimitProfile"
+	ConfigService_ResolveLimit_FullMethodName               = "/config.v1.ConfigService/ResolveLimit"
+	ConfigService_DownloadFile_FullMethodName               = "/config.v1.ConfigService/DownloadFile"
+	ConfigService_CreateSharedFile_FullMethodName           = "/config.v1.ConfigService/CreateSharedFile"
+	ConfigService_GetSharedFilesPagination_FullMethodName   = "/config.v1.ConfigService/GetSharedFilesPagination"
+	ConfigService_GetSharedFile_FullMethodName              = "/config.v1.ConfigService/GetSharedFile"
+	ConfigService_GetAllSharedFiles_FullMethodName          = "/config.v1.ConfigService/GetAllSharedFiles"
+	ConfigService_UpdateSharedFile_FullMethodName           = "/config.v1.ConfigService/UpdateSharedFile"
+	ConfigService_DeleteSharedFile_FullMethodName           = "/config.v1.ConfigService/DeleteSharedFile"
+	ConfigService_GetFileUsages_FullMethodName              = "/config.v1.ConfigService/GetFileUsages"
+	ConfigService_ImportCompareArchive_FullMethodName       = "/config.v1.ConfigService/ImportCompareArchive"
+	ConfigService_ExportCompareArchive_FullMethodName       = "/config.v1.ConfigService/ExportCompareArchive"
+	ConfigService_ImportRunnerArchive_FullMethodName        = "/config.v1.ConfigService/ImportRunnerArchive"
+	ConfigService_ExportRunnerArchive_FullMethodName        = "/config.v1.ConfigService/ExportRunnerArchive"
+	ConfigService_Sync_FullMethodName                       = "/config.v1.ConfigService/Sync"
+	ConfigService_ExportSnapshot_FullMethodName             = "/config.v1.ConfigService/ExportSnapshot"
+	ConfigService_ImportSnapshot_FullMethodName             = "/config.v1.ConfigService/ImportSnapshot"
+	ConfigService_WatchConfig_FullMethodName                = "/config.v1.ConfigService/WatchConfig"
+	ConfigService_GetConfigDelta_FullMethodName             = "/config.v1.ConfigService/GetConfigDelta"
+	ConfigService_GetGraderStatus_FullMethodName            = "/config.v1.ConfigService/GetGraderStatus"
+	ConfigService_BeginBatch_FullMethodName                 = "/config.v1.ConfigService/BeginBatch"
+	ConfigService_CommitBatch_FullMethodName                = "/config.v1.ConfigService/CommitBatch"
+	ConfigService_BatchCreateRunners_FullMethodName         = "/config.v1.ConfigService/BatchCreateRunners"
+	ConfigService_BatchUpdateRunners_FullMethodName         = "/config.v1.ConfigService/BatchUpdateRunners"
+	ConfigService_BatchDeleteRunners_FullMethodName         = "/config.v1.ConfigService/BatchDeleteRunners"
+	ConfigService_BatchCreateCompares_FullMethodName        = "/config.v1.ConfigService/BatchCreateCompares"
+	ConfigService_BatchUpdateCompares_FullMethodName        = "/config.v1.ConfigService/BatchUpdateCompares"
+	ConfigService_BatchDeleteCompares_FullMethodName        = "/config.v1.ConfigService/BatchDeleteCompares"
+	ConfigService_RenameRunnerSlug_FullMethodName           = "/config.v1.ConfigService/RenameRunnerSlug"
+	ConfigService_RenameCompareSlug_FullMethodName          = "/config.v1.ConfigService/RenameCompareSlug"
+	ConfigService_RenderStarterTemplate_FullMethodName      = "/config.v1.ConfigService/RenderStarterTemplate"
+	ConfigService_MoveRunner_FullMethodName                 = "/config.v1.ConfigService/MoveRunner"
+	ConfigService_CopyRunner_FullMethodName                 = "/config.v1.ConfigService/CopyRunner"
+	ConfigService_MoveCompare_FullMethodName                = "/config.v1.ConfigService/MoveCompare"
+	ConfigService_CopyCompare_FullMethodName                = "/config.v1.ConfigService/CopyCompare"
 )
 
 // ConfigServiceClient is the client API for ConfigService service.
@@ -50,6 +94,50 @@ type ConfigServiceClient interface {
 	GetAllCompares(ctx context.Context, in *GetAllComparesRequest, opts ...grpc.CallOption) (*GetAllComparesResponse, error)
 	UpdateCompare(ctx context.Context, in *UpdateCompareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteCompare(ctx context.Context, in *DeleteCompareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TestCompare(ctx context.Context, in *TestCompareRequest, opts ...grpc.CallOption) (*TestCompareResponse, error)
+	GetComparePresets(ctx context.Context, in *GetComparePresetsRequest, opts ...grpc.CallOption) (*GetComparePresetsResponse, error)
+	CreateCompareFromPreset(ctx context.Context, in *CreateCompareFromPresetRequest, opts ...grpc.CallOption) (*CreateCompareResponse, error)
+	UpgradeComparePreset(ctx context.Context, in *UpgradeComparePresetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateLimitProfile(ctx context.Context, in *CreateLimitProfileRequest, opts ...grpc.CallOption) (*CreateLimitProfileResponse, error)
+	GetLimitProfilesPagination(ctx context.Context, in *GetLimitProfilesPaginationRequest, opts ...grpc.CallOption) (*GetLimitProfilesPaginationResponse, error)
+	GetLimitProfile(ctx context.Context, in *GetLimitProfileRequest, opts ...grpc.CallOption) (*LimitProfileResponse, error)
+	GetAllLimitProfiles(ctx context.Context, in *GetAllLimitProfilesRequest, opts ...grpc.CallOption) (*GetAllLimitProfilesResponse, error)
+	UpdateLimitProfile(ctx context.Context, in *UpdateLimitProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteLimitProfile(ctx context.Context, in *DeleteLimitProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResolveLimit(ctx context.Context, in *ResolveLimitRequest, opts ...grpc.CallOption) (*ResolveLimitResponse, error)
+	DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileChunk], error)
+	CreateSharedFile(ctx context.Context, in *CreateSharedFileRequest, opts ...grpc.CallOption) (*CreateSharedFileResponse, error)
+	GetSharedFilesPagination(ctx context.Context, in *GetSharedFilesPaginationRequest, opts ...grpc.CallOption) (*GetSharedFilesPaginationResponse, error)
+	GetSharedFile(ctx context.Context, in *GetSharedFileRequest, opts ...grpc.CallOption) (*SharedFileResponse, error)
+	GetAllSharedFiles(ctx context.Context, in *GetAllSharedFilesRequest, opts ...grpc.CallOption) (*GetAllSharedFilesResponse, error)
+	UpdateSharedFile(ctx context.Context, in *UpdateSharedFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteSharedFile(ctx context.Context, in *DeleteSharedFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetFileUsages(ctx context.Context, in *GetFileUsagesRequest, opts ...grpc.CallOption) (*GetFileUsagesResponse, error)
+	ImportCompareArchive(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportArchiveRequest, CreateCompareResponse], error)
+	ExportCompareArchive(ctx context.Context, in *ExportArchiveRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArchiveChunk], error)
+	ImportRunnerArchive(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportArchiveRequest, CreateRunnerResponse], error)
+	ExportRunnerArchive(ctx context.Context, in *ExportArchiveRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArchiveChunk], error)
+	Sync(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[SyncRequest, SyncResponse], error)
+	ExportSnapshot(ctx context.Context, in *ExportSnapshotRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArchiveChunk], error)
+	ImportSnapshot(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportSnapshotRequest, ImportSnapshotResponse], error)
+	WatchConfig(ctx context.Context, in *WatchConfigRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ConfigEvent], error)
+	GetConfigDelta(ctx context.Context, in *GetConfigDeltaRequest, opts ...grpc.CallOption) (*GetConfigDeltaResponse, error)
+	GetGraderStatus(ctx context.Context, in *GetGraderStatusRequest, opts ...grpc.CallOption) (*GetGraderStatusResponse, error)
+	BeginBatch(ctx context.Context, in *BeginBatchRequest, opts ...grpc.CallOption) (*BeginBatchResponse, error)
+	CommitBatch(ctx context.Context, in *CommitBatchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BatchCreateRunners(ctx context.Context, in *BatchCreateRunnersRequest, opts ...grpc.CallOption) (*BatchMutationResponse, error)
+	BatchUpdateRunners(ctx context.Context, in *BatchUpdateRunnersRequest, opts ...grpc.CallOption) (*BatchMutationResponse, error)
+	BatchDeleteRunners(ctx context.Context, in *BatchDeleteRunnersRequest, opts ...grpc.CallOption) (*BatchMutationResponse, error)
+	BatchCreateCompares(ctx context.Context, in *BatchCreateComparesRequest, opts ...grpc.CallOption) (*BatchMutationResponse, error)
+	BatchUpdateCompares(ctx context.Context, in *BatchUpdateComparesRequest, opts ...grpc.CallOption) (*BatchMutationResponse, error)
+	BatchDeleteCompares(ctx context.Context, in *BatchDeleteComparesRequest, opts ...grpc.CallOption) (*BatchMutationResponse, error)
+	RenameRunnerSlug(ctx context.Context, in *RenameRunnerSlugRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RenameCompareSlug(ctx context.Context, in *RenameCompareSlugRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RenderStarterTemplate(ctx context.Context, in *RenderStarterTemplateRequest, opts ...grpc.CallOption) (*RenderStarterTemplateResponse, error)
+	MoveRunner(ctx context.Context, in *MoveRunnerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CopyRunner(ctx context.Context, in *CopyRunnerRequest, opts ...grpc.CallOption) (*CopyRunnerResponse, error)
+	MoveCompare(ctx context.Context, in *MoveCompareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CopyCompare(ctx context.Context, in *CopyCompareRequest, opts ...grpc.CallOption) (*CopyCompareResponse, error)
 }
 
 type configServiceClient struct {
@@ -180,6 +268,503 @@ func (c *configServiceClient) DeleteCompare(ctx context.Context, in *DeleteCompa
 	return out, nil
 }
 
+func (c *configServiceClient) TestCompare(ctx context.Context, in *TestCompareRequest, opts ...grpc.CallOption) (*TestCompareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestCompareResponse)
+	err := c.cc.Invoke(ctx, ConfigService_TestCompare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) GetComparePresets(ctx context.Context, in *GetComparePresetsRequest, opts ...grpc.CallOption) (*GetComparePresetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetComparePresetsResponse)
+	err := c.cc.Invoke(ctx, ConfigService_GetComparePresets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) CreateCompareFromPreset(ctx context.Context, in *CreateCompareFromPresetRequest, opts ...grpc.CallOption) (*CreateCompareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCompareResponse)
+	err := c.cc.Invoke(ctx, ConfigService_CreateCompareFromPreset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) UpgradeComparePreset(ctx context.Context, in *UpgradeComparePresetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_UpgradeComparePreset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) CreateLimitProfile(ctx context.Context, in *CreateLimitProfileRequest, opts ...grpc.CallOption) (*CreateLimitProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLimitProfileResponse)
+	err := c.cc.Invoke(ctx, ConfigService_CreateLimitProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) GetLimitProfilesPagination(ctx context.Context, in *GetLimitProfilesPaginationRequest, opts ...grpc.CallOption) (*GetLimitProfilesPaginationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLimitProfilesPaginationResponse)
+	err := c.cc.Invoke(ctx, ConfigService_GetLimitProfilesPagination_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) GetLimitProfile(ctx context.Context, in *GetLimitProfileRequest, opts ...grpc.CallOption) (*LimitProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LimitProfileResponse)
+	err := c.cc.Invoke(ctx, ConfigService_GetLimitProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) GetAllLimitProfiles(ctx context.Context, in *GetAllLimitProfilesRequest, opts ...grpc.CallOption) (*GetAllLimitProfilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllLimitProfilesResponse)
+	err := c.cc.Invoke(ctx, ConfigService_GetAllLimitProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) UpdateLimitProfile(ctx context.Context, in *UpdateLimitProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_UpdateLimitProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) DeleteLimitProfile(ctx context.Context, in *DeleteLimitProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_DeleteLimitProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) ResolveLimit(ctx context.Context, in *ResolveLimitRequest, opts ...grpc.CallOption) (*ResolveLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveLimitResponse)
+	err := c.cc.Invoke(ctx, ConfigService_ResolveLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ConfigService_ServiceDesc.Streams[0], ConfigService_DownloadFile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadFileRequest, FileChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_DownloadFileClient = grpc.ServerStreamingClient[FileChunk]
+
+func (c *configServiceClient) CreateSharedFile(ctx context.Context, in *CreateSharedFileRequest, opts ...grpc.CallOption) (*CreateSharedFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSharedFileResponse)
+	err := c.cc.Invoke(ctx, ConfigService_CreateSharedFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) GetSharedFilesPagination(ctx context.Context, in *GetSharedFilesPaginationRequest, opts ...grpc.CallOption) (*GetSharedFilesPaginationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSharedFilesPaginationResponse)
+	err := c.cc.Invoke(ctx, ConfigService_GetSharedFilesPagination_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) GetSharedFile(ctx context.Context, in *GetSharedFileRequest, opts ...grpc.CallOption) (*SharedFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharedFileResponse)
+	err := c.cc.Invoke(ctx, ConfigService_GetSharedFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) GetAllSharedFiles(ctx context.Context, in *GetAllSharedFilesRequest, opts ...grpc.CallOption) (*GetAllSharedFilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllSharedFilesResponse)
+	err := c.cc.Invoke(ctx, ConfigService_GetAllSharedFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) UpdateSharedFile(ctx context.Context, in *UpdateSharedFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_UpdateSharedFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) DeleteSharedFile(ctx context.Context, in *DeleteSharedFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_DeleteSharedFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) GetFileUsages(ctx context.Context, in *GetFileUsagesRequest, opts ...grpc.CallOption) (*GetFileUsagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileUsagesResponse)
+	err := c.cc.Invoke(ctx, ConfigService_GetFileUsages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) ImportCompareArchive(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportArchiveRequest, CreateCompareResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ConfigService_ServiceDesc.Streams[1], ConfigService_ImportCompareArchive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportArchiveRequest, CreateCompareResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_ImportCompareArchiveClient = grpc.ClientStreamingClient[ImportArchiveRequest, CreateCompareResponse]
+
+func (c *configServiceClient) ExportCompareArchive(ctx context.Context, in *ExportArchiveRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArchiveChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ConfigService_ServiceDesc.Streams[2], ConfigService_ExportCompareArchive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportArchiveRequest, ArchiveChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_ExportCompareArchiveClient = grpc.ServerStreamingClient[ArchiveChunk]
+
+func (c *configServiceClient) ImportRunnerArchive(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportArchiveRequest, CreateRunnerResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ConfigService_ServiceDesc.Streams[3], ConfigService_ImportRunnerArchive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportArchiveRequest, CreateRunnerResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_ImportRunnerArchiveClient = grpc.ClientStreamingClient[ImportArchiveRequest, CreateRunnerResponse]
+
+func (c *configServiceClient) ExportRunnerArchive(ctx context.Context, in *ExportArchiveRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArchiveChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ConfigService_ServiceDesc.Streams[4], ConfigService_ExportRunnerArchive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportArchiveRequest, ArchiveChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_ExportRunnerArchiveClient = grpc.ServerStreamingClient[ArchiveChunk]
+
+func (c *configServiceClient) Sync(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[SyncRequest, SyncResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ConfigService_ServiceDesc.Streams[5], ConfigService_Sync_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SyncRequest, SyncResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_SyncClient = grpc.ClientStreamingClient[SyncRequest, SyncResponse]
+
+func (c *configServiceClient) ExportSnapshot(ctx context.Context, in *ExportSnapshotRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArchiveChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ConfigService_ServiceDesc.Streams[6], ConfigService_ExportSnapshot_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportSnapshotRequest, ArchiveChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_ExportSnapshotClient = grpc.ServerStreamingClient[ArchiveChunk]
+
+func (c *configServiceClient) ImportSnapshot(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportSnapshotRequest, ImportSnapshotResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ConfigService_ServiceDesc.Streams[7], ConfigService_ImportSnapshot_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportSnapshotRequest, ImportSnapshotResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_ImportSnapshotClient = grpc.ClientStreamingClient[ImportSnapshotRequest, ImportSnapshotResponse]
+
+func (c *configServiceClient) WatchConfig(ctx context.Context, in *WatchConfigRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ConfigEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ConfigService_ServiceDesc.Streams[8], ConfigService_WatchConfig_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchConfigRequest, ConfigEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_WatchConfigClient = grpc.ServerStreamingClient[ConfigEvent]
+
+func (c *configServiceClient) GetConfigDelta(ctx context.Context, in *GetConfigDeltaRequest, opts ...grpc.CallOption) (*GetConfigDeltaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConfigDeltaResponse)
+	err := c.cc.Invoke(ctx, ConfigService_GetConfigDelta_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) GetGraderStatus(ctx context.Context, in *GetGraderStatusRequest, opts ...grpc.CallOption) (*GetGraderStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGraderStatusResponse)
+	err := c.cc.Invoke(ctx, ConfigService_GetGraderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) BeginBatch(ctx context.Context, in *BeginBatchRequest, opts ...grpc.CallOption) (*BeginBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginBatchResponse)
+	err := c.cc.Invoke(ctx, ConfigService_BeginBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) CommitBatch(ctx context.Context, in *CommitBatchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_CommitBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) BatchCreateRunners(ctx context.Context, in *BatchCreateRunnersRequest, opts ...grpc.CallOption) (*BatchMutationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchMutationResponse)
+	err := c.cc.Invoke(ctx, ConfigService_BatchCreateRunners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) BatchUpdateRunners(ctx context.Context, in *BatchUpdateRunnersRequest, opts ...grpc.CallOption) (*BatchMutationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchMutationResponse)
+	err := c.cc.Invoke(ctx, ConfigService_BatchUpdateRunners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) BatchDeleteRunners(ctx context.Context, in *BatchDeleteRunnersRequest, opts ...grpc.CallOption) (*BatchMutationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchMutationResponse)
+	err := c.cc.Invoke(ctx, ConfigService_BatchDeleteRunners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) BatchCreateCompares(ctx context.Context, in *BatchCreateComparesRequest, opts ...grpc.CallOption) (*BatchMutationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchMutationResponse)
+	err := c.cc.Invoke(ctx, ConfigService_BatchCreateCompares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) BatchUpdateCompares(ctx context.Context, in *BatchUpdateComparesRequest, opts ...grpc.CallOption) (*BatchMutationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchMutationResponse)
+	err := c.cc.Invoke(ctx, ConfigService_BatchUpdateCompares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) BatchDeleteCompares(ctx context.Context, in *BatchDeleteComparesRequest, opts ...grpc.CallOption) (*BatchMutationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchMutationResponse)
+	err := c.cc.Invoke(ctx, ConfigService_BatchDeleteCompares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) RenameRunnerSlug(ctx context.Context, in *RenameRunnerSlugRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_RenameRunnerSlug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) RenameCompareSlug(ctx context.Context, in *RenameCompareSlugRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_RenameCompareSlug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) RenderStarterTemplate(ctx context.Context, in *RenderStarterTemplateRequest, opts ...grpc.CallOption) (*RenderStarterTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderStarterTemplateResponse)
+	err := c.cc.Invoke(ctx, ConfigService_RenderStarterTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) MoveRunner(ctx context.Context, in *MoveRunnerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_MoveRunner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) CopyRunner(ctx context.Context, in *CopyRunnerRequest, opts ...grpc.CallOption) (*CopyRunnerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CopyRunnerResponse)
+	err := c.cc.Invoke(ctx, ConfigService_CopyRunner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) MoveCompare(ctx context.Context, in *MoveCompareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigService_MoveCompare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) CopyCompare(ctx context.Context, in *CopyCompareRequest, opts ...grpc.CallOption) (*CopyCompareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CopyCompareResponse)
+	err := c.cc.Invoke(ctx, ConfigService_CopyCompare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConfigServiceServer is the server API for ConfigService service.
 // All implementations must embed UnimplementedConfigServiceServer
 // for forward compatibility.
@@ -196,285 +781,1174 @@ type ConfigServiceServer interface {
 	GetAllCompares(context.Context, *GetAllComparesRequest) (*GetAllComparesResponse, error)
 	UpdateCompare(context.Context, *UpdateCompareRequest) (*emptypb.Empty, error)
 	DeleteCompare(context.Context, *DeleteCompareRequest) (*emptypb.Empty, error)
+	TestCompare(context.Context, *TestCompareRequest) (*TestCompareResponse, error)
+	GetComparePresets(context.Context, *GetComparePresetsRequest) (*GetComparePresetsResponse, error)
+	CreateCompareFromPreset(context.Context, *CreateCompareFromPresetRequest) (*CreateCompareResponse, error)
+	UpgradeComparePreset(context.Context, *UpgradeComparePresetRequest) (*emptypb.Empty, error)
+	CreateLimitProfile(context.Context, *CreateLimitProfileRequest) (*CreateLimitProfileResponse, error)
+	GetLimitProfilesPagination(context.Context, *GetLimitProfilesPaginationRequest) (*GetLimitProfilesPaginationResponse, error)
+	GetLimitProfile(context.Context, *GetLimitProfileRequest) (*LimitProfileResponse, error)
+	GetAllLimitProfiles(context.Context, *GetAllLimitProfilesRequest) (*GetAllLimitProfilesResponse, error)
+	UpdateLimitProfile(context.Context, *UpdateLimitProfileRequest) (*emptypb.Empty, error)
+	DeleteLimitProfile(context.Context, *DeleteLimitProfileRequest) (*emptypb.Empty, error)
+	ResolveLimit(context.Context, *ResolveLimitRequest) (*ResolveLimitResponse, error)
+	DownloadFile(*DownloadFileRequest, grpc.ServerStreamingServer[FileChunk]) error
+	CreateSharedFile(context.Context, *CreateSharedFileRequest) (*CreateSharedFileResponse, error)
+	GetSharedFilesPagination(context.Context, *GetSharedFilesPaginationRequest) (*GetSharedFilesPaginationResponse, error)
+	GetSharedFile(context.Context, *GetSharedFileRequest) (*SharedFileResponse, error)
+	GetAllSharedFiles(context.Context, *GetAllSharedFilesRequest) (*GetAllSharedFilesResponse, error)
+	UpdateSharedFile(context.Context, *UpdateSharedFileRequest) (*emptypb.Empty, error)
+	DeleteSharedFile(context.Context, *DeleteSharedFileRequest) (*emptypb.Empty, error)
+	GetFileUsages(context.Context, *GetFileUsagesRequest) (*GetFileUsagesResponse, error)
+	ImportCompareArchive(grpc.ClientStreamingServer[ImportArchiveRequest, CreateCompareResponse]) error
+	ExportCompareArchive(*ExportArchiveRequest, grpc.ServerStreamingServer[ArchiveChunk]) error
+	ImportRunnerArchive(grpc.ClientStreamingServer[ImportArchiveRequest, CreateRunnerResponse]) error
+	ExportRunnerArchive(*ExportArchiveRequest, grpc.ServerStreamingServer[ArchiveChunk]) error
+	Sync(grpc.ClientStreamingServer[SyncRequest, SyncResponse]) error
+	ExportSnapshot(*ExportSnapshotRequest, grpc.ServerStreamingServer[ArchiveChunk]) error
+	ImportSnapshot(grpc.ClientStreamingServer[ImportSnapshotRequest, ImportSnapshotResponse]) error
+	WatchConfig(*WatchConfigRequest, grpc.ServerStreamingServer[ConfigEvent]) error
+	GetConfigDelta(context.Context, *GetConfigDeltaRequest) (*GetConfigDeltaResponse, error)
+	GetGraderStatus(context.Context, *GetGraderStatusRequest) (*GetGraderStatusResponse, error)
+	BeginBatch(context.Context, *BeginBatchRequest) (*BeginBatchResponse, error)
+	CommitBatch(context.Context, *CommitBatchRequest) (*emptypb.Empty, error)
+	BatchCreateRunners(context.Context, *BatchCreateRunnersRequest) (*BatchMutationResponse, error)
+	BatchUpdateRunners(context.Context, *BatchUpdateRunnersRequest) (*BatchMutationResponse, error)
+	BatchDeleteRunners(context.Context, *BatchDeleteRunnersRequest) (*BatchMutationResponse, error)
+	BatchCreateCompares(context.Context, *BatchCreateComparesRequest) (*BatchMutationResponse, error)
+	BatchUpdateCompares(context.Context, *BatchUpdateComparesRequest) (*BatchMutationResponse, error)
+	BatchDeleteCompares(context.Context, *BatchDeleteComparesRequest) (*BatchMutationResponse, error)
+	RenameRunnerSlug(context.Context, *RenameRunnerSlugRequest) (*emptypb.Empty, error)
+	RenameCompareSlug(context.Context, *RenameCompareSlugRequest) (*emptypb.Empty, error)
+	RenderStarterTemplate(context.Context, *RenderStarterTemplateRequest) (*RenderStarterTemplateResponse, error)
+	MoveRunner(context.Context, *MoveRunnerRequest) (*emptypb.Empty, error)
+	CopyRunner(context.Context, *CopyRunnerRequest) (*CopyRunnerResponse, error)
+	MoveCompare(context.Context, *MoveCompareRequest) (*emptypb.Empty, error)
+	CopyCompare(context.Context, *CopyCompareRequest) (*CopyCompareResponse, error)
+	mustEmbedUnimplementedConfigServiceServer()
+}
+
+// UnimplementedConfigServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedConfigServiceServer struct{}
+
+func (UnimplementedConfigServiceServer) CreateRunner(context.Context, *CreateRunnerRequest) (*CreateRunnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRunner not implemented")
+}
+func (UnimplementedConfigServiceServer) GetRunnersPagination(context.Context, *GetRunnersPaginationRequest) (*GetRunnersPaginationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRunnersPagination not implemented")
+}
+func (UnimplementedConfigServiceServer) GetRunner(context.Context, *GetRunnerRequest) (*RunnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRunner not implemented")
+}
+func (UnimplementedConfigServiceServer) UpdateRunner(context.Context, *UpdateRunnerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRunner not implemented")
+}
+func (UnimplementedConfigServiceServer) DeleteRunner(context.Context, *DeleteRunnerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRunner not implemented")
+}
+func (UnimplementedConfigServiceServer) GetAllRunners(context.Context, *GetAllRunnersRequest) (*GetAllRunnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllRunners not implemented")
+}
+func (UnimplementedConfigServiceServer) CreateCompare(context.Context, *CreateCompareRequest) (*CreateCompareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCompare not implemented")
+}
+func (UnimplementedConfigServiceServer) GetComparesPagination(context.Context, *GetComparesPaginationRequest) (*GetComparesPaginationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComparesPagination not implemented")
+}
+func (UnimplementedConfigServiceServer) GetCompare(context.Context, *GetCompareRequest) (*CompareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompare not implemented")
+}
+func (UnimplementedConfigServiceServer) GetAllCompares(context.Context, *GetAllComparesRequest) (*GetAllComparesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCompares not implemented")
+}
+func (UnimplementedConfigServiceServer) UpdateCompare(context.Context, *UpdateCompareRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompare not implemented")
+}
+func (UnimplementedConfigServiceServer) DeleteCompare(context.Context, *DeleteCompareRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCompare not implemented")
+}
+func (UnimplementedConfigServiceServer) TestCompare(context.Context, *TestCompareRequest) (*TestCompareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestCompare not implemented")
+}
+func (UnimplementedConfigServiceServer) GetComparePresets(context.Context, *GetComparePresetsRequest) (*GetComparePresetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComparePresets not implemented")
+}
+func (UnimplementedConfigServiceServer) CreateCompareFromPreset(context.Context, *CreateCompareFromPresetRequest) (*CreateCompareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCompareFromPreset not implemented")
+}
+func (UnimplementedConfigServiceServer) UpgradeComparePreset(context.Context, *UpgradeComparePresetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeComparePreset not implemented")
+}
+func (UnimplementedConfigServiceServer) CreateLimitProfile(context.Context, *CreateLimitProfileRequest) (*CreateLimitProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLimitProfile not implemented")
+}
+func (UnimplementedConfigServiceServer) GetLimitProfilesPagination(context.Context, *GetLimitProfilesPaginationRequest) (*GetLimitProfilesPaginationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLimitProfilesPagination not implemented")
+}
+func (UnimplementedConfigServiceServer) GetLimitProfile(context.Context, *GetLimitProfileRequest) (*LimitProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLimitProfile not implemented")
+}
+func (UnimplementedConfigServiceServer) GetAllLimitProfiles(context.Context, *GetAllLimitProfilesRequest) (*GetAllLimitProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllLimitProfiles not implemented")
+}
+func (UnimplementedConfigServiceServer) UpdateLimitProfile(context.Context, *UpdateLimitProfileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLimitProfile not implemented")
+}
+func (UnimplementedConfigServiceServer) DeleteLimitProfile(context.Context, *DeleteLimitProfileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLimitProfile not implemented")
+}
+func (UnimplementedConfigServiceServer) ResolveLimit(context.Context, *ResolveLimitRequest) (*ResolveLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveLimit not implemented")
+}
+func (UnimplementedConfigServiceServer) DownloadFile(*DownloadFileRequest, grpc.ServerStreamingServer[FileChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadFile not implemented")
+}
+func (UnimplementedConfigServiceServer) CreateSharedFile(context.Context, *CreateSharedFileRequest) (*CreateSharedFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSharedFile not implemented")
+}
+func (UnimplementedConfigServiceServer) GetSharedFilesPagination(context.Context, *GetSharedFilesPaginationRequest) (*GetSharedFilesPaginationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedFilesPagination not implemented")
+}
+func (UnimplementedConfigServiceServer) GetSharedFile(context.Context, *GetSharedFileRequest) (*SharedFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedFile not implemented")
+}
+func (UnimplementedConfigServiceServer) GetAllSharedFiles(context.Context, *GetAllSharedFilesRequest) (*GetAllSharedFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllSharedFiles not implemented")
+}
+func (UnimplementedConfigServiceServer) UpdateSharedFile(context.Context, *UpdateSharedFileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSharedFile not implemented")
+}
+func (UnimplementedConfigServiceServer) DeleteSharedFile(context.Context, *DeleteSharedFileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSharedFile not implemented")
+}
+func (UnimplementedConfigServiceServer) GetFileUsages(context.Context, *GetFileUsagesRequest) (*GetFileUsagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileUsages not implemented")
+}
+func (UnimplementedConfigServiceServer) ImportCompareArchive(grpc.ClientStreamingServer[ImportArchiveRequest, CreateCompareResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportCompareArchive not implemented")
+}
+func (UnimplementedConfigServiceServer) ExportCompareArchive(*ExportArchiveRequest, grpc.ServerStreamingServer[ArchiveChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportCompareArchive not implemented")
+}
+func (UnimplementedConfigServiceServer) ImportRunnerArchive(grpc.ClientStreamingServer[ImportArchiveRequest, CreateRunnerResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportRunnerArchive not implemented")
+}
+func (UnimplementedConfigServiceServer) ExportRunnerArchive(*ExportArchiveRequest, grpc.ServerStreamingServer[ArchiveChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportRunnerArchive not implemented")
+}
+func (UnimplementedConfigServiceServer) Sync(grpc.ClientStreamingServer[SyncRequest, SyncResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Sync not implemented")
+}
+func (UnimplementedConfigServiceServer) ExportSnapshot(*ExportSnapshotRequest, grpc.ServerStreamingServer[ArchiveChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSnapshot not implemented")
+}
+func (UnimplementedConfigServiceServer) ImportSnapshot(grpc.ClientStreamingServer[ImportSnapshotRequest, ImportSnapshotResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportSnapshot not implemented")
+}
+func (UnimplementedConfigServiceServer) WatchConfig(*WatchConfigRequest, grpc.ServerStreamingServer[ConfigEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConfig not implemented")
+}
+func (UnimplementedConfigServiceServer) GetConfigDelta(context.Context, *GetConfigDeltaRequest) (*GetConfigDeltaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigDelta not implemented")
+}
+func (UnimplementedConfigServiceServer) GetGraderStatus(context.Context, *GetGraderStatusRequest) (*GetGraderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGraderStatus not implemented")
+}
+func (UnimplementedConfigServiceServer) BeginBatch(context.Context, *BeginBatchRequest) (*BeginBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginBatch not implemented")
+}
+func (UnimplementedConfigServiceServer) CommitBatch(context.Context, *CommitBatchRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitBatch not implemented")
+}
+func (UnimplementedConfigServiceServer) BatchCreateRunners(context.Context, *BatchCreateRunnersRequest) (*BatchMutationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateRunners not implemented")
+}
+func (UnimplementedConfigServiceServer) BatchUpdateRunners(context.Context, *BatchUpdateRunnersRequest) (*BatchMutationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateRunners not implemented")
+}
+func (UnimplementedConfigServiceServer) BatchDeleteRunners(context.Context, *BatchDeleteRunnersRequest) (*BatchMutationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteRunners not implemented")
+}
+func (UnimplementedConfigServiceServer) BatchCreateCompares(context.Context, *BatchCreateComparesRequest) (*BatchMutationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateCompares not implemented")
+}
+func (UnimplementedConfigServiceServer) BatchUpdateCompares(context.Context, *BatchUpdateComparesRequest) (*BatchMutationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateCompares not implemented")
+}
+func (UnimplementedConfigServiceServer) BatchDeleteCompares(context.Context, *BatchDeleteComparesRequest) (*BatchMutationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteCompares not implemented")
+}
+func (UnimplementedConfigServiceServer) RenameRunnerSlug(context.Context, *RenameRunnerSlugRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameRunnerSlug not implemented")
+}
+func (UnimplementedConfigServiceServer) RenameCompareSlug(context.Context, *RenameCompareSlugRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCompareSlug not implemented")
+}
+func (UnimplementedConfigServiceServer) RenderStarterTemplate(context.Context, *RenderStarterTemplateRequest) (*RenderStarterTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderStarterTemplate not implemented")
+}
+func (UnimplementedConfigServiceServer) MoveRunner(context.Context, *MoveRunnerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveRunner not implemented")
+}
+func (UnimplementedConfigServiceServer) CopyRunner(context.Context, *CopyRunnerRequest) (*CopyRunnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyRunner not implemented")
+}
+func (UnimplementedConfigServiceServer) MoveCompare(context.Context, *MoveCompareRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCompare not implemented")
+}
+func (UnimplementedConfigServiceServer) CopyCompare(context.Context, *CopyCompareRequest) (*CopyCompareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyCompare not implemented")
+}
+func (UnimplementedConfigServiceServer) mustEmbedUnimplementedConfigServiceServer() {}
+func (UnimplementedConfigServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeConfigServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConfigServiceServer will
+// result in compilation errors.
+type UnsafeConfigServiceServer interface {
 	mustEmbedUnimplementedConfigServiceServer()
 }
 
-// UnimplementedConfigServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedConfigServiceServer struct{}
+func RegisterConfigServiceServer(s grpc.ServiceRegistrar, srv ConfigServiceServer) {
+	// If the following call pancis, it indicates UnimplementedConfigServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ConfigService_ServiceDesc, srv)
+}
+
+func _ConfigService_CreateRunner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRunnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).CreateRunner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_CreateRunner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).CreateRunner(ctx, req.(*CreateRunnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_GetRunnersPagination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunnersPaginationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetRunnersPagination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetRunnersPagination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetRunnersPagination(ctx, req.(*GetRunnersPaginationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_GetRunner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetRunner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetRunner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetRunner(ctx, req.(*GetRunnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_UpdateRunner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRunnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).UpdateRunner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_UpdateRunner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).UpdateRunner(ctx, req.(*UpdateRunnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_DeleteRunner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRunnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).DeleteRunner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_DeleteRunner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).DeleteRunner(ctx, req.(*DeleteRunnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_GetAllRunners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRunnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetAllRunners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetAllRunners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetAllRunners(ctx, req.(*GetAllRunnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_CreateCompare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).CreateCompare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_CreateCompare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).CreateCompare(ctx, req.(*CreateCompareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_GetComparesPagination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComparesPaginationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetComparesPagination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetComparesPagination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetComparesPagination(ctx, req.(*GetComparesPaginationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_GetCompare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetCompare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetCompare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetCompare(ctx, req.(*GetCompareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_GetAllCompares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllComparesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetAllCompares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetAllCompares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetAllCompares(ctx, req.(*GetAllComparesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_UpdateCompare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).UpdateCompare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_UpdateCompare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).UpdateCompare(ctx, req.(*UpdateCompareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_DeleteCompare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCompareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).DeleteCompare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_DeleteCompare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).DeleteCompare(ctx, req.(*DeleteCompareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_TestCompare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestCompareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).TestCompare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_TestCompare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).TestCompare(ctx, req.(*TestCompareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedConfigServiceServer) CreateRunner(context.Context, *CreateRunnerRequest) (*CreateRunnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRunner not implemented")
+func _ConfigService_GetComparePresets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComparePresetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetComparePresets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetComparePresets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetComparePresets(ctx, req.(*GetComparePresetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServiceServer) GetRunnersPagination(context.Context, *GetRunnersPaginationRequest) (*GetRunnersPaginationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRunnersPagination not implemented")
+
+func _ConfigService_CreateCompareFromPreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompareFromPresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).CreateCompareFromPreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_CreateCompareFromPreset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).CreateCompareFromPreset(ctx, req.(*CreateCompareFromPresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServiceServer) GetRunner(context.Context, *GetRunnerRequest) (*RunnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRunner not implemented")
+
+func _ConfigService_UpgradeComparePreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeComparePresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).UpgradeComparePreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_UpgradeComparePreset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).UpgradeComparePreset(ctx, req.(*UpgradeComparePresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_CreateLimitProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLimitProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).CreateLimitProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_CreateLimitProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).CreateLimitProfile(ctx, req.(*CreateLimitProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_GetLimitProfilesPagination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLimitProfilesPaginationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetLimitProfilesPagination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetLimitProfilesPagination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetLimitProfilesPagination(ctx, req.(*GetLimitProfilesPaginationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_GetLimitProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLimitProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetLimitProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetLimitProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetLimitProfile(ctx, req.(*GetLimitProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_GetAllLimitProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllLimitProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetAllLimitProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetAllLimitProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetAllLimitProfiles(ctx, req.(*GetAllLimitProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_UpdateLimitProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLimitProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).UpdateLimitProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_UpdateLimitProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).UpdateLimitProfile(ctx, req.(*UpdateLimitProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_DeleteLimitProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLimitProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).DeleteLimitProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_DeleteLimitProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).DeleteLimitProfile(ctx, req.(*DeleteLimitProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_ResolveLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).ResolveLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_ResolveLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).ResolveLimit(ctx, req.(*ResolveLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_DownloadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConfigServiceServer).DownloadFile(m, &grpc.GenericServerStream[DownloadFileRequest, FileChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_DownloadFileServer = grpc.ServerStreamingServer[FileChunk]
+
+func _ConfigService_CreateSharedFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSharedFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).CreateSharedFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_CreateSharedFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).CreateSharedFile(ctx, req.(*CreateSharedFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_GetSharedFilesPagination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedFilesPaginationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetSharedFilesPagination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetSharedFilesPagination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetSharedFilesPagination(ctx, req.(*GetSharedFilesPaginationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_GetSharedFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetSharedFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetSharedFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetSharedFile(ctx, req.(*GetSharedFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_GetAllSharedFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllSharedFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetAllSharedFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetAllSharedFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetAllSharedFiles(ctx, req.(*GetAllSharedFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_UpdateSharedFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSharedFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).UpdateSharedFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_UpdateSharedFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).UpdateSharedFile(ctx, req.(*UpdateSharedFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_DeleteSharedFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSharedFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).DeleteSharedFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_DeleteSharedFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).DeleteSharedFile(ctx, req.(*DeleteSharedFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_GetFileUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileUsagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetFileUsages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetFileUsages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetFileUsages(ctx, req.(*GetFileUsagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_ImportCompareArchive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ConfigServiceServer).ImportCompareArchive(&grpc.GenericServerStream[ImportArchiveRequest, CreateCompareResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_ImportCompareArchiveServer = grpc.ClientStreamingServer[ImportArchiveRequest, CreateCompareResponse]
+
+func _ConfigService_ExportCompareArchive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportArchiveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConfigServiceServer).ExportCompareArchive(m, &grpc.GenericServerStream[ExportArchiveRequest, ArchiveChunk]{ServerStream: stream})
 }
-func (UnimplementedConfigServiceServer) UpdateRunner(context.Context, *UpdateRunnerRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRunner not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_ExportCompareArchiveServer = grpc.ServerStreamingServer[ArchiveChunk]
+
+func _ConfigService_ImportRunnerArchive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ConfigServiceServer).ImportRunnerArchive(&grpc.GenericServerStream[ImportArchiveRequest, CreateRunnerResponse]{ServerStream: stream})
 }
-func (UnimplementedConfigServiceServer) DeleteRunner(context.Context, *DeleteRunnerRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRunner not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_ImportRunnerArchiveServer = grpc.ClientStreamingServer[ImportArchiveRequest, CreateRunnerResponse]
+
+func _ConfigService_ExportRunnerArchive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportArchiveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConfigServiceServer).ExportRunnerArchive(m, &grpc.GenericServerStream[ExportArchiveRequest, ArchiveChunk]{ServerStream: stream})
 }
-func (UnimplementedConfigServiceServer) GetAllRunners(context.Context, *GetAllRunnersRequest) (*GetAllRunnersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllRunners not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_ExportRunnerArchiveServer = grpc.ServerStreamingServer[ArchiveChunk]
+
+func _ConfigService_Sync_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ConfigServiceServer).Sync(&grpc.GenericServerStream[SyncRequest, SyncResponse]{ServerStream: stream})
 }
-func (UnimplementedConfigServiceServer) CreateCompare(context.Context, *CreateCompareRequest) (*CreateCompareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCompare not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_SyncServer = grpc.ClientStreamingServer[SyncRequest, SyncResponse]
+
+func _ConfigService_ExportSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConfigServiceServer).ExportSnapshot(m, &grpc.GenericServerStream[ExportSnapshotRequest, ArchiveChunk]{ServerStream: stream})
 }
-func (UnimplementedConfigServiceServer) GetComparesPagination(context.Context, *GetComparesPaginationRequest) (*GetComparesPaginationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetComparesPagination not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_ExportSnapshotServer = grpc.ServerStreamingServer[ArchiveChunk]
+
+func _ConfigService_ImportSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ConfigServiceServer).ImportSnapshot(&grpc.GenericServerStream[ImportSnapshotRequest, ImportSnapshotResponse]{ServerStream: stream})
 }
-func (UnimplementedConfigServiceServer) GetCompare(context.Context, *GetCompareRequest) (*CompareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCompare not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_ImportSnapshotServer = grpc.ClientStreamingServer[ImportSnapshotRequest, ImportSnapshotResponse]
+
+func _ConfigService_WatchConfig_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchConfigRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConfigServiceServer).WatchConfig(m, &grpc.GenericServerStream[WatchConfigRequest, ConfigEvent]{ServerStream: stream})
 }
-func (UnimplementedConfigServiceServer) GetAllCompares(context.Context, *GetAllComparesRequest) (*GetAllComparesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllCompares not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigService_WatchConfigServer = grpc.ServerStreamingServer[ConfigEvent]
+
+func _ConfigService_GetConfigDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigDeltaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetConfigDelta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetConfigDelta_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetConfigDelta(ctx, req.(*GetConfigDeltaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServiceServer) UpdateCompare(context.Context, *UpdateCompareRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompare not implemented")
+
+func _ConfigService_GetGraderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGraderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetGraderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetGraderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetGraderStatus(ctx, req.(*GetGraderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServiceServer) DeleteCompare(context.Context, *DeleteCompareRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCompare not implemented")
+
+func _ConfigService_BeginBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).BeginBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_BeginBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).BeginBatch(ctx, req.(*BeginBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServiceServer) mustEmbedUnimplementedConfigServiceServer() {}
-func (UnimplementedConfigServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeConfigServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ConfigServiceServer will
-// result in compilation errors.
-type UnsafeConfigServiceServer interface {
-	mustEmbedUnimplementedConfigServiceServer()
+func _ConfigService_CommitBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).CommitBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_CommitBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).CommitBatch(ctx, req.(*CommitBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterConfigServiceServer(s grpc.ServiceRegistrar, srv ConfigServiceServer) {
-	// If the following call pancis, it indicates UnimplementedConfigServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ConfigService_BatchCreateRunners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateRunnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ConfigService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).BatchCreateRunners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_BatchCreateRunners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).BatchCreateRunners(ctx, req.(*BatchCreateRunnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ConfigService_CreateRunner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRunnerRequest)
+func _ConfigService_BatchUpdateRunners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateRunnersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServiceServer).CreateRunner(ctx, in)
+		return srv.(ConfigServiceServer).BatchUpdateRunners(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConfigService_CreateRunner_FullMethodName,
+		FullMethod: ConfigService_BatchUpdateRunners_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).CreateRunner(ctx, req.(*CreateRunnerRequest))
+		return srv.(ConfigServiceServer).BatchUpdateRunners(ctx, req.(*BatchUpdateRunnersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConfigService_GetRunnersPagination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRunnersPaginationRequest)
+func _ConfigService_BatchDeleteRunners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteRunnersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServiceServer).GetRunnersPagination(ctx, in)
+		return srv.(ConfigServiceServer).BatchDeleteRunners(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConfigService_GetRunnersPagination_FullMethodName,
+		FullMethod: ConfigService_BatchDeleteRunners_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).GetRunnersPagination(ctx, req.(*GetRunnersPaginationRequest))
+		return srv.(ConfigServiceServer).BatchDeleteRunners(ctx, req.(*BatchDeleteRunnersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConfigService_GetRunner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRunnerRequest)
+func _ConfigService_BatchCreateCompares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateComparesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServiceServer).GetRunner(ctx, in)
+		return srv.(ConfigServiceServer).BatchCreateCompares(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConfigService_GetRunner_FullMethodName,
+		FullMethod: ConfigService_BatchCreateCompares_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).GetRunner(ctx, req.(*GetRunnerRequest))
+		return srv.(ConfigServiceServer).BatchCreateCompares(ctx, req.(*BatchCreateComparesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConfigService_UpdateRunner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRunnerRequest)
+func _ConfigService_BatchUpdateCompares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateComparesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServiceServer).UpdateRunner(ctx, in)
+		return srv.(ConfigServiceServer).BatchUpdateCompares(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConfigService_UpdateRunner_FullMethodName,
+		FullMethod: ConfigService_BatchUpdateCompares_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).UpdateRunner(ctx, req.(*UpdateRunnerRequest))
+		return srv.(ConfigServiceServer).BatchUpdateCompares(ctx, req.(*BatchUpdateComparesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConfigService_DeleteRunner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRunnerRequest)
+func _ConfigService_BatchDeleteCompares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteComparesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServiceServer).DeleteRunner(ctx, in)
+		return srv.(ConfigServiceServer).BatchDeleteCompares(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConfigService_DeleteRunner_FullMethodName,
+		FullMethod: ConfigService_BatchDeleteCompares_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).DeleteRunner(ctx, req.(*DeleteRunnerRequest))
+		return srv.(ConfigServiceServer).BatchDeleteCompares(ctx, req.(*BatchDeleteComparesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConfigService_GetAllRunners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRunnersRequest)
+func _ConfigService_RenameRunnerSlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameRunnerSlugRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServiceServer).GetAllRunners(ctx, in)
+		return srv.(ConfigServiceServer).RenameRunnerSlug(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConfigService_GetAllRunners_FullMethodName,
+		FullMethod: ConfigService_RenameRunnerSlug_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).GetAllRunners(ctx, req.(*GetAllRunnersRequest))
+		return srv.(ConfigServiceServer).RenameRunnerSlug(ctx, req.(*RenameRunnerSlugRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConfigService_CreateCompare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCompareRequest)
+func _ConfigService_RenameCompareSlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCompareSlugRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServiceServer).CreateCompare(ctx, in)
+		return srv.(ConfigServiceServer).RenameCompareSlug(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConfigService_CreateCompare_FullMethodName,
+		FullMethod: ConfigService_RenameCompareSlug_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).CreateCompare(ctx, req.(*CreateCompareRequest))
+		return srv.(ConfigServiceServer).RenameCompareSlug(ctx, req.(*RenameCompareSlugRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConfigService_GetComparesPagination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetComparesPaginationRequest)
+func _ConfigService_RenderStarterTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderStarterTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServiceServer).GetComparesPagination(ctx, in)
+		return srv.(ConfigServiceServer).RenderStarterTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConfigService_GetComparesPagination_FullMethodName,
+		FullMethod: ConfigService_RenderStarterTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).GetComparesPagination(ctx, req.(*GetComparesPaginationRequest))
+		return srv.(ConfigServiceServer).RenderStarterTemplate(ctx, req.(*RenderStarterTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConfigService_GetCompare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCompareRequest)
+func _ConfigService_MoveRunner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveRunnerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServiceServer).GetCompare(ctx, in)
+		return srv.(ConfigServiceServer).MoveRunner(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConfigService_GetCompare_FullMethodName,
+		FullMethod: ConfigService_MoveRunner_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).GetCompare(ctx, req.(*GetCompareRequest))
+		return srv.(ConfigServiceServer).MoveRunner(ctx, req.(*MoveRunnerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConfigService_GetAllCompares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllComparesRequest)
+func _ConfigService_CopyRunner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyRunnerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServiceServer).GetAllCompares(ctx, in)
+		return srv.(ConfigServiceServer).CopyRunner(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConfigService_GetAllCompares_FullMethodName,
+		FullMethod: ConfigService_CopyRunner_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).GetAllCompares(ctx, req.(*GetAllComparesRequest))
+		return srv.(ConfigServiceServer).CopyRunner(ctx, req.(*CopyRunnerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConfigService_UpdateCompare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCompareRequest)
+func _ConfigService_MoveCompare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCompareRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServiceServer).UpdateCompare(ctx, in)
+		return srv.(ConfigServiceServer).MoveCompare(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConfigService_UpdateCompare_FullMethodName,
+		FullMethod: ConfigService_MoveCompare_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).UpdateCompare(ctx, req.(*UpdateCompareRequest))
+		return srv.(ConfigServiceServer).MoveCompare(ctx, req.(*MoveCompareRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConfigService_DeleteCompare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCompareRequest)
+func _ConfigService_CopyCompare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyCompareRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServiceServer).DeleteCompare(ctx, in)
+		return srv.(ConfigServiceServer).CopyCompare(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConfigService_DeleteCompare_FullMethodName,
+		FullMethod: ConfigService_CopyCompare_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).DeleteCompare(ctx, req.(*DeleteCompareRequest))
+		return srv.(ConfigServiceServer).CopyCompare(ctx, req.(*CopyCompareRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -534,7 +2008,193 @@ var ConfigService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCompare",
 			Handler:    _ConfigService_DeleteCompare_Handler,
 		},
+		{
+			MethodName: "TestCompare",
+			Handler:    _ConfigService_TestCompare_Handler,
+		},
+		{
+			MethodName: "GetComparePresets",
+			Handler:    _ConfigService_GetComparePresets_Handler,
+		},
+		{
+			MethodName: "CreateCompareFromPreset",
+			Handler:    _ConfigService_CreateCompareFromPreset_Handler,
+		},
+		{
+			MethodName: "UpgradeComparePreset",
+			Handler:    _ConfigService_UpgradeComparePreset_Handler,
+		},
+		{
+			MethodName: "CreateLimitProfile",
+			Handler:    _ConfigService_CreateLimitProfile_Handler,
+		},
+		{
+			MethodName: "GetLimitProfilesPagination",
+			Handler:    _ConfigService_GetLimitProfilesPagination_Handler,
+		},
+		{
+			MethodName: "GetLimitProfile",
+			Handler:    _ConfigService_GetLimitProfile_Handler,
+		},
+		{
+			MethodName: "GetAllLimitProfiles",
+			Handler:    _ConfigService_GetAllLimitProfiles_Handler,
+		},
+		{
+			MethodName: "UpdateLimitProfile",
+			Handler:    _ConfigService_UpdateLimitProfile_Handler,
+		},
+		{
+			MethodName: "DeleteLimitProfile",
+			Handler:    _ConfigService_DeleteLimitProfile_Handler,
+		},
+		{
+			MethodName: "ResolveLimit",
+			Handler:    _ConfigService_ResolveLimit_Handler,
+		},
+		{
+			MethodName: "CreateSharedFile",
+			Handler:    _ConfigService_CreateSharedFile_Handler,
+		},
+		{
+			MethodName: "GetSharedFilesPagination",
+			Handler:    _ConfigService_GetSharedFilesPagination_Handler,
+		},
+		{
+			MethodName: "GetSharedFile",
+			Handler:    _ConfigService_GetSharedFile_Handler,
+		},
+		{
+			MethodName: "GetAllSharedFiles",
+			Handler:    _ConfigService_GetAllSharedFiles_Handler,
+		},
+		{
+			MethodName: "UpdateSharedFile",
+			Handler:    _ConfigService_UpdateSharedFile_Handler,
+		},
+		{
+			MethodName: "DeleteSharedFile",
+			Handler:    _ConfigService_DeleteSharedFile_Handler,
+		},
+		{
+			MethodName: "GetFileUsages",
+			Handler:    _ConfigService_GetFileUsages_Handler,
+		},
+		{
+			MethodName: "GetConfigDelta",
+			Handler:    _ConfigService_GetConfigDelta_Handler,
+		},
+		{
+			MethodName: "GetGraderStatus",
+			Handler:    _ConfigService_GetGraderStatus_Handler,
+		},
+		{
+			MethodName: "BeginBatch",
+			Handler:    _ConfigService_BeginBatch_Handler,
+		},
+		{
+			MethodName: "CommitBatch",
+			Handler:    _ConfigService_CommitBatch_Handler,
+		},
+		{
+			MethodName: "BatchCreateRunners",
+			Handler:    _ConfigService_BatchCreateRunners_Handler,
+		},
+		{
+			MethodName: "BatchUpdateRunners",
+			Handler:    _ConfigService_BatchUpdateRunners_Handler,
+		},
+		{
+			MethodName: "BatchDeleteRunners",
+			Handler:    _ConfigService_BatchDeleteRunners_Handler,
+		},
+		{
+			MethodName: "BatchCreateCompares",
+			Handler:    _ConfigService_BatchCreateCompares_Handler,
+		},
+		{
+			MethodName: "BatchUpdateCompares",
+			Handler:    _ConfigService_BatchUpdateCompares_Handler,
+		},
+		{
+			MethodName: "BatchDeleteCompares",
+			Handler:    _ConfigService_BatchDeleteCompares_Handler,
+		},
+		{
+			MethodName: "RenameRunnerSlug",
+			Handler:    _ConfigService_RenameRunnerSlug_Handler,
+		},
+		{
+			MethodName: "RenameCompareSlug",
+			Handler:    _ConfigService_RenameCompareSlug_Handler,
+		},
+		{
+			MethodName: "RenderStarterTemplate",
+			Handler:    _ConfigService_RenderStarterTemplate_Handler,
+		},
+		{
+			MethodName: "MoveRunner",
+			Handler:    _ConfigService_MoveRunner_Handler,
+		},
+		{
+			MethodName: "CopyRunner",
+			Handler:    _ConfigService_CopyRunner_Handler,
+		},
+		{
+			MethodName: "MoveCompare",
+			Handler:    _ConfigService_MoveCompare_Handler,
+		},
+		{
+			MethodName: "CopyCompare",
+			Handler:    _ConfigService_CopyCompare_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadFile",
+			Handler:       _ConfigService_DownloadFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportCompareArchive",
+			Handler:       _ConfigService_ImportCompareArchive_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportCompareArchive",
+			Handler:       _ConfigService_ExportCompareArchive_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportRunnerArchive",
+			Handler:       _ConfigService_ImportRunnerArchive_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportRunnerArchive",
+			Handler:       _ConfigService_ExportRunnerArchive_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Sync",
+			Handler:       _ConfigService_Sync_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportSnapshot",
+			Handler:       _ConfigService_ExportSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportSnapshot",
+			Handler:       _ConfigService_ImportSnapshot_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchConfig",
+			Handler:       _ConfigService_WatchConfig_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "config/v1/service.proto",
 }
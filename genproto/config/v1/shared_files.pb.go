@@ -0,0 +1,847 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: config/v1/shared_files.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SharedFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	File          *File                  `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedFileResponse) Reset() {
+	*x = SharedFileResponse{}
+	mi := &file_config_v1_shared_files_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedFileResponse) ProtoMessage() {}
+
+func (x *SharedFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_shared_files_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedFileResponse.ProtoReflect.Descriptor instead.
+func (*SharedFileResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_shared_files_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SharedFileResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SharedFileResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SharedFileResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SharedFileResponse) GetFile() *File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+type GetSharedFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedFileRequest) Reset() {
+	*x = GetSharedFileRequest{}
+	mi := &file_config_v1_shared_files_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedFileRequest) ProtoMessage() {}
+
+func (x *GetSharedFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_shared_files_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedFileRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedFileRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_shared_files_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSharedFileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetAllSharedFilesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IncludeContent bool                   `protobuf:"varint,1,opt,name=include_content,json=includeContent,proto3" json:"include_content,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAllSharedFilesRequest) Reset() {
+	*x = GetAllSharedFilesRequest{}
+	mi := &file_config_v1_shared_files_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllSharedFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllSharedFilesRequest) ProtoMessage() {}
+
+func (x *GetAllSharedFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_shared_files_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllSharedFilesRequest.ProtoReflect.Descriptor instead.
+func (*GetAllSharedFilesRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_shared_files_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAllSharedFilesRequest) GetIncludeContent() bool {
+	if x != nil {
+		return x.IncludeContent
+	}
+	return false
+}
+
+type GetAllSharedFilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SharedFiles   []*SharedFileResponse  `protobuf:"bytes,1,rep,name=shared_files,json=sharedFiles,proto3" json:"shared_files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllSharedFilesResponse) Reset() {
+	*x = GetAllSharedFilesResponse{}
+	mi := &file_config_v1_shared_files_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllSharedFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllSharedFilesResponse) ProtoMessage() {}
+
+func (x *GetAllSharedFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_shared_files_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllSharedFilesResponse.ProtoReflect.Descriptor instead.
+func (*GetAllSharedFilesResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_shared_files_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetAllSharedFilesResponse) GetSharedFiles() []*SharedFileResponse {
+	if x != nil {
+		return x.SharedFiles
+	}
+	return nil
+}
+
+type GetSharedFilesPaginationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedFilesPaginationRequest) Reset() {
+	*x = GetSharedFilesPaginationRequest{}
+	mi := &file_config_v1_shared_files_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedFilesPaginationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedFilesPaginationRequest) ProtoMessage() {}
+
+func (x *GetSharedFilesPaginationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_shared_files_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedFilesPaginationRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedFilesPaginationRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_shared_files_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSharedFilesPaginationRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetSharedFilesPaginationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SharedFiles   []*SharedFileResponse  `protobuf:"bytes,1,rep,name=shared_files,json=sharedFiles,proto3" json:"shared_files,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedFilesPaginationResponse) Reset() {
+	*x = GetSharedFilesPaginationResponse{}
+	mi := &file_config_v1_shared_files_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedFilesPaginationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedFilesPaginationResponse) ProtoMessage() {}
+
+func (x *GetSharedFilesPaginationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_shared_files_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedFilesPaginationResponse.ProtoReflect.Descriptor instead.
+func (*GetSharedFilesPaginationResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_shared_files_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSharedFilesPaginationResponse) GetSharedFiles() []*SharedFileResponse {
+	if x != nil {
+		return x.SharedFiles
+	}
+	return nil
+}
+
+func (x *GetSharedFilesPaginationResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type CreateSharedFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	File          *File                  `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"`
+	LinkExisting  bool                   `protobuf:"varint,4,opt,name=link_existing,json=linkExisting,proto3" json:"link_existing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSharedFileRequest) Reset() {
+	*x = CreateSharedFileRequest{}
+	mi := &file_config_v1_shared_files_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSharedFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSharedFileRequest) ProtoMessage() {}
+
+func (x *CreateSharedFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_shared_files_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSharedFileRequest.ProtoReflect.Descriptor instead.
+func (*CreateSharedFileRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_shared_files_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateSharedFileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSharedFileRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateSharedFileRequest) GetFile() *File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *CreateSharedFileRequest) GetLinkExisting() bool {
+	if x != nil {
+		return x.LinkExisting
+	}
+	return false
+}
+
+type CreateSharedFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LinkedCount   int32                  `protobuf:"varint,2,opt,name=linked_count,json=linkedCount,proto3" json:"linked_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSharedFileResponse) Reset() {
+	*x = CreateSharedFileResponse{}
+	mi := &file_config_v1_shared_files_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSharedFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSharedFileResponse) ProtoMessage() {}
+
+func (x *CreateSharedFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_shared_files_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSharedFileResponse.ProtoReflect.Descriptor instead.
+func (*CreateSharedFileResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_shared_files_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateSharedFileResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateSharedFileResponse) GetLinkedCount() int32 {
+	if x != nil {
+		return x.LinkedCount
+	}
+	return 0
+}
+
+type UpdateSharedFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	File          *File                  `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSharedFileRequest) Reset() {
+	*x = UpdateSharedFileRequest{}
+	mi := &file_config_v1_shared_files_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSharedFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSharedFileRequest) ProtoMessage() {}
+
+func (x *UpdateSharedFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_shared_files_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSharedFileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSharedFileRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_shared_files_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateSharedFileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSharedFileRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSharedFileRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateSharedFileRequest) GetFile() *File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+type DeleteSharedFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSharedFileRequest) Reset() {
+	*x = DeleteSharedFileRequest{}
+	mi := &file_config_v1_shared_files_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSharedFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSharedFileRequest) ProtoMessage() {}
+
+func (x *DeleteSharedFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_shared_files_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSharedFileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSharedFileRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_shared_files_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteSharedFileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type FileUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	FileName      string                 `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	SharedFileId  string                 `protobuf:"bytes,5,opt,name=shared_file_id,json=sharedFileId,proto3" json:"shared_file_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileUsage) Reset() {
+	*x = FileUsage{}
+	mi := &file_config_v1_shared_files_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileUsage) ProtoMessage() {}
+
+func (x *FileUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_shared_files_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileUsage.ProtoReflect.Descriptor instead.
+func (*FileUsage) Descriptor() ([]byte, []int) {
+	return file_config_v1_shared_files_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FileUsage) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *FileUsage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FileUsage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileUsage) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *FileUsage) GetSharedFileId() string {
+	if x != nil {
+		return x.SharedFileId
+	}
+	return ""
+}
+
+type GetFileUsagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sha256        string                 `protobuf:"bytes,1,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	SharedFileId  string                 `protobuf:"bytes,2,opt,name=shared_file_id,json=sharedFileId,proto3" json:"shared_file_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileUsagesRequest) Reset() {
+	*x = GetFileUsagesRequest{}
+	mi := &file_config_v1_shared_files_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileUsagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileUsagesRequest) ProtoMessage() {}
+
+func (x *GetFileUsagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_shared_files_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileUsagesRequest.ProtoReflect.Descriptor instead.
+func (*GetFileUsagesRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_shared_files_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetFileUsagesRequest) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *GetFileUsagesRequest) GetSharedFileId() string {
+	if x != nil {
+		return x.SharedFileId
+	}
+	return ""
+}
+
+type GetFileUsagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Usages        []*FileUsage           `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileUsagesResponse) Reset() {
+	*x = GetFileUsagesResponse{}
+	mi := &file_config_v1_shared_files_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileUsagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileUsagesResponse) ProtoMessage() {}
+
+func (x *GetFileUsagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_shared_files_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileUsagesResponse.ProtoReflect.Descriptor instead.
+func (*GetFileUsagesResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_shared_files_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetFileUsagesResponse) GetUsages() []*FileUsage {
+	if x != nil {
+		return x.Usages
+	}
+	return nil
+}
+
+var File_config_v1_shared_files_proto protoreflect.FileDescriptor
+
+const file_config_v1_shared_files_proto_rawDesc = "" +
+	"\n" +
+	"\x1cconfig/v1/shared_files.proto\x12\tconfig.v1\x1a\x14config/v1/file.proto\x1a\x1aconfig/v1/pagination.proto\"\x7f\n" +
+	"\x12SharedFileResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\x04file\x18\x04 \x01(\v2\x0f.config.v1.FileR\x04file\"&\n" +
+	"\x14GetSharedFileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
+	"\x18GetAllSharedFilesRequest\x12'\n" +
+	"\x0finclude_content\x18\x01 \x01(\bR\x0eincludeContent\"]\n" +
+	"\x19GetAllSharedFilesResponse\x12@\n" +
+	"\fshared_files\x18\x01 \x03(\v2\x1d.config.v1.SharedFileResponseR\vsharedFiles\"_\n" +
+	"\x1fGetSharedFilesPaginationRequest\x12<\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1c.config.v1.PaginationRequestR\n" +
+	"pagination\"z\n" +
+	" GetSharedFilesPaginationResponse\x12@\n" +
+	"\fshared_files\x18\x01 \x03(\v2\x1d.config.v1.SharedFileResponseR\vsharedFiles\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x99\x01\n" +
+	"\x17CreateSharedFileRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12#\n" +
+	"\x04file\x18\x03 \x01(\v2\x0f.config.v1.FileR\x04file\x12#\n" +
+	"\rlink_existing\x18\x04 \x01(\bR\flinkExisting\"M\n" +
+	"\x18CreateSharedFileResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\flinked_count\x18\x02 \x01(\x05R\vlinkedCount\"\xa7\x01\n" +
+	"\x17UpdateSharedFileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12#\n" +
+	"\x04file\x18\x04 \x01(\v2\x0f.config.v1.FileR\x04fileB\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_description\")\n" +
+	"\x17DeleteSharedFileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x86\x01\n" +
+	"\tFileUsage\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12$\n" +
+	"\x0eshared_file_id\x18\x05 \x01(\tR\fsharedFileId\"T\n" +
+	"\x14GetFileUsagesRequest\x12\x16\n" +
+	"\x06sha256\x18\x01 \x01(\tR\x06sha256\x12$\n" +
+	"\x0eshared_file_id\x18\x02 \x01(\tR\fsharedFileId\"E\n" +
+	"\x15GetFileUsagesResponse\x12,\n" +
+	"\x06usages\x18\x01 \x03(\v2\x14.config.v1.FileUsageR\x06usagesB\x8b\x01\n" +
+	"\rcom.config.v1B\x10SharedFilesProtoP\x01Z0github.com/CSKU-Lab/config-server/grpc/config/v1\xa2\x02\x03CXX\xaa\x02\tConfig.V1\xca\x02\tConfig\\V1\xe2\x02\x15Config\\V1\\GPBMetadatab\x06proto3"
+
+var (
+	file_config_v1_shared_files_proto_rawDescOnce sync.Once
+	file_config_v1_shared_files_proto_rawDescData []byte
+)
+
+func file_config_v1_shared_files_proto_rawDescGZIP() []byte {
+	file_config_v1_shared_files_proto_rawDescOnce.Do(func() {
+		file_config_v1_shared_files_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_config_v1_shared_files_proto_rawDesc), len(file_config_v1_shared_files_proto_rawDesc)))
+	})
+	return file_config_v1_shared_files_proto_rawDescData
+}
+
+var file_config_v1_shared_files_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_config_v1_shared_files_proto_goTypes = []any{
+	(*SharedFileResponse)(nil),               // 0: config.v1.SharedFileResponse
+	(*GetSharedFileRequest)(nil),             // 1: config.v1.GetSharedFileRequest
+	(*GetAllSharedFilesRequest)(nil),         // 2: config.v1.GetAllSharedFilesRequest
+	(*GetAllSharedFilesResponse)(nil),        // 3: config.v1.GetAllSharedFilesResponse
+	(*GetSharedFilesPaginationRequest)(nil),  // 4: config.v1.GetSharedFilesPaginationRequest
+	(*GetSharedFilesPaginationResponse)(nil), // 5: config.v1.GetSharedFilesPaginationResponse
+	(*CreateSharedFileRequest)(nil),          // 6: config.v1.CreateSharedFileRequest
+	(*CreateSharedFileResponse)(nil),         // 7: config.v1.CreateSharedFileResponse
+	(*UpdateSharedFileRequest)(nil),          // 8: config.v1.UpdateSharedFileRequest
+	(*DeleteSharedFileRequest)(nil),          // 9: config.v1.DeleteSharedFileRequest
+	(*FileUsage)(nil),                        // 10: config.v1.FileUsage
+	(*GetFileUsagesRequest)(nil),             // 11: config.v1.GetFileUsagesRequest
+	(*GetFileUsagesResponse)(nil),            // 12: config.v1.GetFileUsagesResponse
+	(*File)(nil),                             // 13: config.v1.File
+	(*PaginationRequest)(nil),                // 14: config.v1.PaginationRequest
+}
+var file_config_v1_shared_files_proto_depIdxs = []int32{
+	13, // 0: config.v1.SharedFileResponse.file:type_name -> config.v1.File
+	0,  // 1: config.v1.GetAllSharedFilesResponse.shared_files:type_name -> config.v1.SharedFileResponse
+	14, // 2: config.v1.GetSharedFilesPaginationRequest.pagination:type_name -> config.v1.PaginationRequest
+	0,  // 3: config.v1.GetSharedFilesPaginationResponse.shared_files:type_name -> config.v1.SharedFileResponse
+	13, // 4: config.v1.CreateSharedFileRequest.file:type_name -> config.v1.File
+	13, // 5: config.v1.UpdateSharedFileRequest.file:type_name -> config.v1.File
+	10, // 6: config.v1.GetFileUsagesResponse.usages:type_name -> config.v1.FileUsage
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_config_v1_shared_files_proto_init() }
+func file_config_v1_shared_files_proto_init() {
+	if File_config_v1_shared_files_proto != nil {
+		return
+	}
+	file_config_v1_file_proto_init()
+	file_config_v1_pagination_proto_init()
+	file_config_v1_shared_files_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_config_v1_shared_files_proto_rawDesc), len(file_config_v1_shared_files_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_v1_shared_files_proto_goTypes,
+		DependencyIndexes: file_config_v1_shared_files_proto_depIdxs,
+		MessageInfos:      file_config_v1_shared_files_proto_msgTypes,
+	}.Build()
+	File_config_v1_shared_files_proto = out.File
+	file_config_v1_shared_files_proto_goTypes = nil
+	file_config_v1_shared_files_proto_depIdxs = nil
+}
@@ -141,6 +141,8 @@ type File struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Mode          uint32                 `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -189,6 +191,20 @@ func (x *File) GetContent() string {
 	return ""
 }
 
+func (x *File) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *File) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
 type Limit struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CpuTime       float32                `protobuf:"fixed32,1,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
@@ -956,6 +972,7 @@ func (x *GenerateTestCasesResponse) GetResults() []*TestCaseResponse {
 type BroadcastRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Action        BroadcastAction        `protobuf:"varint,1,opt,name=action,proto3,enum=grader.v1.BroadcastAction" json:"action,omitempty"`
+	Revision      int64                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -997,14 +1014,159 @@ func (x *BroadcastRequest) GetAction() BroadcastAction {
 	return BroadcastAction_REFETCH_CONFIG
 }
 
+func (x *BroadcastRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type CompareRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Files          []*File                `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	BuildScript    string                 `protobuf:"bytes,2,opt,name=build_script,json=buildScript,proto3" json:"build_script,omitempty"`
+	RunScript      string                 `protobuf:"bytes,3,opt,name=run_script,json=runScript,proto3" json:"run_script,omitempty"`
+	RunName        string                 `protobuf:"bytes,4,opt,name=run_name,json=runName,proto3" json:"run_name,omitempty"`
+	ExpectedOutput string                 `protobuf:"bytes,5,opt,name=expected_output,json=expectedOutput,proto3" json:"expected_output,omitempty"`
+	ActualOutput   string                 `protobuf:"bytes,6,opt,name=actual_output,json=actualOutput,proto3" json:"actual_output,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CompareRequest) Reset() {
+	*x = CompareRequest{}
+	mi := &file_grader_v1_messages_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareRequest) ProtoMessage() {}
+
+func (x *CompareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grader_v1_messages_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareRequest.ProtoReflect.Descriptor instead.
+func (*CompareRequest) Descriptor() ([]byte, []int) {
+	return file_grader_v1_messages_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CompareRequest) GetFiles() []*File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *CompareRequest) GetBuildScript() string {
+	if x != nil {
+		return x.BuildScript
+	}
+	return ""
+}
+
+func (x *CompareRequest) GetRunScript() string {
+	if x != nil {
+		return x.RunScript
+	}
+	return ""
+}
+
+func (x *CompareRequest) GetRunName() string {
+	if x != nil {
+		return x.RunName
+	}
+	return ""
+}
+
+func (x *CompareRequest) GetExpectedOutput() string {
+	if x != nil {
+		return x.ExpectedOutput
+	}
+	return ""
+}
+
+func (x *CompareRequest) GetActualOutput() string {
+	if x != nil {
+		return x.ActualOutput
+	}
+	return ""
+}
+
+type CompareResultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ExecutionStatus        `protobuf:"varint,1,opt,name=status,proto3,enum=grader.v1.ExecutionStatus" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareResultResponse) Reset() {
+	*x = CompareResultResponse{}
+	mi := &file_grader_v1_messages_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareResultResponse) ProtoMessage() {}
+
+func (x *CompareResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grader_v1_messages_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareResultResponse.ProtoReflect.Descriptor instead.
+func (*CompareResultResponse) Descriptor() ([]byte, []int) {
+	return file_grader_v1_messages_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CompareResultResponse) GetStatus() ExecutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ExecutionStatus_STATUS_UNSPECIFIED
+}
+
+func (x *CompareResultResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_grader_v1_messages_proto protoreflect.FileDescriptor
 
 const file_grader_v1_messages_proto_rawDesc = "" +
 	"\n" +
-	"\x18grader/v1/messages.proto\x12\tgrader.v1\"4\n" +
+	"\x18grader/v1/messages.proto\x12\tgrader.v1\"\\\n" +
 	"\x04File\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\"\x82\x02\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\rR\x04mode\"\x82\x02\n" +
 	"\x05Limit\x12\x19\n" +
 	"\bcpu_time\x18\x01 \x01(\x02R\acpuTime\x12$\n" +
 	"\x0ecpu_extra_time\x18\x02 \x01(\x02R\fcpuExtraTime\x12\x1b\n" +
@@ -1064,9 +1226,21 @@ const file_grader_v1_messages_proto_rawDesc = "" +
 	"\x05input\x18\x03 \x01(\tR\x05input\x12\x16\n" +
 	"\x06output\x18\x04 \x01(\tR\x06output\"R\n" +
 	"\x19GenerateTestCasesResponse\x125\n" +
-	"\aresults\x18\x01 \x03(\v2\x1b.grader.v1.TestCaseResponseR\aresults\"F\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.grader.v1.TestCaseResponseR\aresults\"b\n" +
 	"\x10BroadcastRequest\x122\n" +
-	"\x06action\x18\x01 \x01(\x0e2\x1a.grader.v1.BroadcastActionR\x06action*\xa7\x02\n" +
+	"\x06action\x18\x01 \x01(\x0e2\x1a.grader.v1.BroadcastActionR\x06action\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\"\xe2\x01\n" +
+	"\x0eCompareRequest\x12%\n" +
+	"\x05files\x18\x01 \x03(\v2\x0f.grader.v1.FileR\x05files\x12!\n" +
+	"\fbuild_script\x18\x02 \x01(\tR\vbuildScript\x12\x1d\n" +
+	"\n" +
+	"run_script\x18\x03 \x01(\tR\trunScript\x12\x19\n" +
+	"\brun_name\x18\x04 \x01(\tR\arunName\x12'\n" +
+	"\x0fexpected_output\x18\x05 \x01(\tR\x0eexpectedOutput\x12#\n" +
+	"\ractual_output\x18\x06 \x01(\tR\factualOutput\"e\n" +
+	"\x15CompareResultResponse\x122\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1a.grader.v1.ExecutionStatusR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage*\xa7\x02\n" +
 	"\x0fExecutionStatus\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15STATUS_COMPILE_FAILED\x10\x01\x12\x15\n" +
@@ -1098,7 +1272,7 @@ func file_grader_v1_messages_proto_rawDescGZIP() []byte {
 }
 
 var file_grader_v1_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_grader_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_grader_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_grader_v1_messages_proto_goTypes = []any{
 	(ExecutionStatus)(0),              // 0: grader.v1.ExecutionStatus
 	(BroadcastAction)(0),              // 1: grader.v1.BroadcastAction
@@ -1115,6 +1289,8 @@ var file_grader_v1_messages_proto_goTypes = []any{
 	(*TestCaseResponse)(nil),          // 12: grader.v1.TestCaseResponse
 	(*GenerateTestCasesResponse)(nil), // 13: grader.v1.GenerateTestCasesResponse
 	(*BroadcastRequest)(nil),          // 14: grader.v1.BroadcastRequest
+	(*CompareRequest)(nil),            // 15: grader.v1.CompareRequest
+	(*CompareResultResponse)(nil),     // 16: grader.v1.CompareResultResponse
 }
 var file_grader_v1_messages_proto_depIdxs = []int32{
 	2,  // 0: grader.v1.RunRequest.files:type_name -> grader.v1.File
@@ -1130,11 +1306,13 @@ var file_grader_v1_messages_proto_depIdxs = []int32{
 	3,  // 10: grader.v1.GenerateTestCasesRequest.limit:type_name -> grader.v1.Limit
 	12, // 11: grader.v1.GenerateTestCasesResponse.results:type_name -> grader.v1.TestCaseResponse
 	1,  // 12: grader.v1.BroadcastRequest.action:type_name -> grader.v1.BroadcastAction
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	2,  // 13: grader.v1.CompareRequest.files:type_name -> grader.v1.File
+	0,  // 14: grader.v1.CompareResultResponse.status:type_name -> grader.v1.ExecutionStatus
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_grader_v1_messages_proto_init() }
@@ -1148,7 +1326,7 @@ func file_grader_v1_messages_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_grader_v1_messages_proto_rawDesc), len(file_grader_v1_messages_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   13,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -24,6 +24,7 @@ const (
 	GraderService_Grade_FullMethodName             = "/grader.v1.GraderService/Grade"
 	GraderService_GenerateTestCases_FullMethodName = "/grader.v1.GraderService/GenerateTestCases"
 	GraderService_Broadcast_FullMethodName         = "/grader.v1.GraderService/Broadcast"
+	GraderService_Compare_FullMethodName           = "/grader.v1.GraderService/Compare"
 )
 
 // GraderServiceClient is the client API for GraderService service.
@@ -34,6 +35,7 @@ type GraderServiceClient interface {
 	Grade(ctx context.Context, in *GradeRequest, opts ...grpc.CallOption) (*GradeResultResponse, error)
 	GenerateTestCases(ctx context.Context, in *GenerateTestCasesRequest, opts ...grpc.CallOption) (*GenerateTestCasesResponse, error)
 	Broadcast(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Compare(ctx context.Context, in *CompareRequest, opts ...grpc.CallOption) (*CompareResultResponse, error)
 }
 
 type graderServiceClient struct {
@@ -93,6 +95,16 @@ func (c *graderServiceClient) Broadcast(ctx context.Context, in *BroadcastReques
 	return out, nil
 }
 
+func (c *graderServiceClient) Compare(ctx context.Context, in *CompareRequest, opts ...grpc.CallOption) (*CompareResultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareResultResponse)
+	err := c.cc.Invoke(ctx, GraderService_Compare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GraderServiceServer is the server API for GraderService service.
 // All implementations must embed UnimplementedGraderServiceServer
 // for forward compatibility.
@@ -101,6 +113,7 @@ type GraderServiceServer interface {
 	Grade(context.Context, *GradeRequest) (*GradeResultResponse, error)
 	GenerateTestCases(context.Context, *GenerateTestCasesRequest) (*GenerateTestCasesResponse, error)
 	Broadcast(context.Context, *BroadcastRequest) (*emptypb.Empty, error)
+	Compare(context.Context, *CompareRequest) (*CompareResultResponse, error)
 	mustEmbedUnimplementedGraderServiceServer()
 }
 
@@ -123,6 +136,9 @@ func (UnimplementedGraderServiceServer) GenerateTestCases(context.Context, *Gene
 func (UnimplementedGraderServiceServer) Broadcast(context.Context, *BroadcastRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Broadcast not implemented")
 }
+func (UnimplementedGraderServiceServer) Compare(context.Context, *CompareRequest) (*CompareResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Compare not implemented")
+}
 func (UnimplementedGraderServiceServer) mustEmbedUnimplementedGraderServiceServer() {}
 func (UnimplementedGraderServiceServer) testEmbeddedByValue()                       {}
 
@@ -209,6 +225,24 @@ func _GraderService_Broadcast_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GraderService_Compare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraderServiceServer).Compare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GraderService_Compare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraderServiceServer).Compare(ctx, req.(*CompareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GraderService_ServiceDesc is the grpc.ServiceDesc for GraderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -228,6 +262,10 @@ var GraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Broadcast",
 			Handler:    _GraderService_Broadcast_Handler,
 		},
+		{
+			MethodName: "Compare",
+			Handler:    _GraderService_Compare_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
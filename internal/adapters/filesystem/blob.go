@@ -0,0 +1,97 @@
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+)
+
+type blobRepo struct {
+	dir string
+}
+
+// NewBlobRepo stores blobs as files under dir, sharded by the first two
+// characters of their digest.
+func NewBlobRepo(dir string) (repositories.BlobRepository, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot create blob directory %s : %v", dir, err)
+	}
+
+	return &blobRepo{
+		dir: dir,
+	}, nil
+}
+
+func (b *blobRepo) path(sha256 string) (string, error) {
+	if len(sha256) < 3 || filepath.Base(sha256) != sha256 {
+		return "", fmt.Errorf("%w: invalid blob digest %q", cerrors.New(cerrors.INVALID_DATA), sha256)
+	}
+	return filepath.Join(b.dir, sha256[:2], sha256), nil
+}
+
+func (b *blobRepo) Put(ctx context.Context, sha256 string, data []byte) error {
+	path, err := b.path(sha256)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return fmt.Errorf("Cannot create blob directory : %v", err)
+	}
+
+	// Write to a temporary file first so readers never see a partial blob.
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("Cannot create blob %s : %v", sha256, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("Cannot write blob %s : %v", sha256, err)
+	}
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return fmt.Errorf("Cannot store blob %s : %v", sha256, err)
+	}
+	return nil
+}
+
+func (b *blobRepo) Open(ctx context.Context, sha256 string) (io.ReadCloser, int64, error) {
+	path, err := b.path(sha256)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, 0, fmt.Errorf("%w: blob %s not found", cerrors.New(cerrors.CANNOT_GET_DATA), sha256)
+		}
+		return nil, 0, fmt.Errorf("Cannot open blob %s : %v", sha256, err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, 0, fmt.Errorf("Cannot stat blob %s : %v", sha256, err)
+	}
+	return file, info.Size(), nil
+}
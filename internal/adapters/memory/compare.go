@@ -0,0 +1,132 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/namespaces"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+)
+
+type compareRepo struct {
+	col *collection[models.Compare]
+}
+
+func NewCompareRepo() repositories.CompareRepository {
+	return &compareRepo{
+		col: newCollection[models.Compare](),
+	}
+}
+
+func (c *compareRepo) snapshot() func() {
+	return c.col.snapshot()
+}
+
+// compareNameConflict fails when two compares share a name in a namespace.
+func compareNameConflict(compare *models.Compare, stored *models.Compare) error {
+	if stored.Namespace == compare.Namespace && stored.Name == compare.Name {
+		return &cerrors.ConflictError{Kind: "compare", Name: compare.Name, ID: stored.ID}
+	}
+	return nil
+}
+
+func (c *compareRepo) Create(ctx context.Context, ID string, body *requests.CreateCompare) error {
+	return c.col.insert(ID, models.Compare{
+		ID:          ID,
+		Name:        body.Name,
+		Slug:        body.Slug,
+		Aliases:     body.Aliases,
+		Files:       body.Files,
+		BuildScript: body.BuildScript,
+		RunScript:   body.RunScript,
+		RunName:     body.RunName,
+		Description: body.Description,
+		GoldenCases: body.GoldenCases,
+
+		PresetID:      body.PresetID,
+		PresetVersion: body.PresetVersion,
+		PresetParams:  body.PresetParams,
+
+		Namespace: body.Namespace,
+		Audit:     body.Audit,
+	}, compareNameConflict)
+}
+
+func (c *compareRepo) GetAll(ctx context.Context) ([]models.Compare, error) {
+	return c.col.find(func(cmp *models.Compare) bool { return namespaces.IsVisible(ctx, cmp.Namespace) })
+}
+
+func (c *compareRepo) GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.Compare, error) {
+	compares, err := c.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return paginate(compares, req, func(c *models.Compare) string { return c.Name }, func(c *models.Compare) *models.Audit { return &c.Audit }), nil
+}
+
+func (c *compareRepo) Count(ctx context.Context, req *requests.GetPagination) (int, error) {
+	compares, err := c.GetAll(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return len(filterDocs(compares, req, func(c *models.Compare) string { return c.Name }, func(c *models.Compare) *models.Audit { return &c.Audit })), nil
+}
+
+func (c *compareRepo) GetByID(ctx context.Context, ID string) (*models.Compare, error) {
+	compare, err := c.col.get(ID)
+	if err != nil {
+		return nil, err
+	}
+	if !namespaces.IsVisible(ctx, compare.Namespace) {
+		return nil, cerrors.New(cerrors.CANNOT_GET_DATA)
+	}
+	return compare, nil
+}
+
+func (c *compareRepo) GetBySlug(ctx context.Context, slug string) (*models.Compare, error) {
+	compares, err := c.col.find(func(cmp *models.Compare) bool {
+		return namespaces.IsVisible(ctx, cmp.Namespace) && hasSlug(cmp.Slug, cmp.Aliases, slug)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(compares) == 0 {
+		return nil, fmt.Errorf("%w: compare %s not found", cerrors.New(cerrors.CANNOT_GET_DATA), slug)
+	}
+	return &compares[0], nil
+}
+
+func (c *compareRepo) GetByFile(ctx context.Context, sha256 string, sharedFileID string) ([]models.Compare, error) {
+	return c.col.find(func(c *models.Compare) bool { return hasFile(c.Files, sha256, sharedFileID) })
+}
+
+func (c *compareRepo) UpdateByID(ctx context.Context, ID string, body *requests.UpdateCompare) error {
+	updated, err := c.col.update(ID, c.owned(ctx), body, compareNameConflict)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return compareNotFound(ID)
+	}
+	return nil
+}
+
+func (c *compareRepo) DeleteByID(ctx context.Context, ID string) error {
+	if !c.col.delete(ID, c.owned(ctx)) {
+		return compareNotFound(ID)
+	}
+	return nil
+}
+
+// owned matches the compares that can be changed from ctx.
+func (c *compareRepo) owned(ctx context.Context) func(cmp *models.Compare) bool {
+	return func(cmp *models.Compare) bool { return namespaces.IsOwned(ctx, cmp.Namespace) }
+}
+
+func compareNotFound(ID string) error {
+	return fmt.Errorf("%w: compare %s not found", cerrors.New(cerrors.CANNOT_GET_DATA), ID)
+}
@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+)
+
+type idempotencyRepo struct {
+	mu   sync.Mutex
+	keys map[string]models.IdempotencyKey
+}
+
+func NewIdempotencyRepo() repositories.IdempotencyRepository {
+	return &idempotencyRepo{
+		keys: map[string]models.IdempotencyKey{},
+	}
+}
+
+func (r *idempotencyRepo) Reserve(ctx context.Context, key *models.IdempotencyKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.purge()
+	if _, exists := r.keys[key.Key]; exists {
+		return cerrors.New(cerrors.DUPLICATE_DATA)
+	}
+	r.keys[key.Key] = *key
+	return nil
+}
+
+func (r *idempotencyRepo) Get(ctx context.Context, key string) (*models.IdempotencyKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.purge()
+	stored, ok := r.keys[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: idempotency key %s not found", cerrors.New(cerrors.CANNOT_GET_DATA), key)
+	}
+	return &stored, nil
+}
+
+func (r *idempotencyRepo) Complete(ctx context.Context, key string, resultID string, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.keys[key]
+	if !ok {
+		return nil
+	}
+	stored.ResultID = resultID
+	stored.ExpiresAt = expiresAt
+	r.keys[key] = stored
+	return nil
+}
+
+func (r *idempotencyRepo) Delete(ctx context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.keys, key)
+	return nil
+}
+
+// purge drops the expired keys, like the TTL index of the Mongo adapter.
+func (r *idempotencyRepo) purge() {
+	now := time.Now()
+	for key, stored := range r.keys {
+		if !stored.ExpiresAt.After(now) {
+			delete(r.keys, key)
+		}
+	}
+}
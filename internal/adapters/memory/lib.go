@@ -0,0 +1,299 @@
+package memory
+
+import (
+	"cmp"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// collection keeps documents in memory. Documents are deep copied on the way
+// in and out so callers can never mutate the stored state.
+type collection[T any] struct {
+	mu   sync.RWMutex
+	docs map[string]T
+}
+
+func newCollection[T any]() *collection[T] {
+	return &collection[T]{
+		docs: map[string]T{},
+	}
+}
+
+// conflictFunc fails when doc cannot be stored next to the stored document,
+// e.g. because they share a unique name.
+type conflictFunc[T any] func(doc *T, stored *T) error
+
+// checkConflicts runs conflict, if any, against every stored document but
+// the one with ID. It must be called under the write lock, so that two writes
+// cannot both pass it.
+func (c *collection[T]) checkConflicts(ID string, doc *T, conflict conflictFunc[T]) error {
+	if conflict == nil {
+		return nil
+	}
+	for storedID, stored := range c.docs {
+		if storedID == ID {
+			continue
+		}
+		err := conflict(doc, &stored)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *collection[T]) insert(ID string, doc T, conflict conflictFunc[T]) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.docs[ID]; exists {
+		return cerrors.New(cerrors.DUPLICATE_DATA)
+	}
+	err := c.checkConflicts(ID, &doc, conflict)
+	if err != nil {
+		return err
+	}
+
+	copied, err := clone(doc)
+	if err != nil {
+		return err
+	}
+	c.docs[ID] = copied
+	return nil
+}
+
+func (c *collection[T]) get(ID string) (*T, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	doc, ok := c.docs[ID]
+	if !ok {
+		return nil, cerrors.New(cerrors.CANNOT_GET_DATA)
+	}
+
+	copied, err := clone(doc)
+	if err != nil {
+		return nil, err
+	}
+	return &copied, nil
+}
+
+func (c *collection[T]) find(match func(doc *T) bool) ([]T, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	docs := []T{}
+	for _, doc := range c.docs {
+		if match != nil && !match(&doc) {
+			continue
+		}
+
+		copied, err := clone(doc)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, copied)
+	}
+	return docs, nil
+}
+
+func (c *collection[T]) count() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return len(c.docs)
+}
+
+// update applies every non-nil field of body onto the document field sharing
+// its bson tag, mirroring a Mongo $set of getUpdatedFields. It reports false
+// when there is no document with ID, or match, if any, rejects it.
+func (c *collection[T]) update(ID string, match func(doc *T) bool, body any, conflict conflictFunc[T]) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	doc, ok := c.docs[ID]
+	if !ok || (match != nil && !match(&doc)) {
+		return false, nil
+	}
+
+	updated, err := clone(doc)
+	if err != nil {
+		return false, err
+	}
+
+	docVal := reflect.ValueOf(&updated).Elem()
+	bodyVal := reflect.ValueOf(body).Elem()
+	bodyTyp := bodyVal.Type()
+	for i := range bodyVal.NumField() {
+		fieldVal := bodyVal.Field(i)
+		if fieldVal.IsNil() {
+			continue
+		}
+
+		target := fieldByBSONTag(docVal, bodyTyp.Field(i).Tag.Get("bson"))
+		if !target.IsValid() {
+			continue
+		}
+
+		if fieldVal.Kind() == reflect.Ptr && target.Kind() != reflect.Ptr {
+			fieldVal = fieldVal.Elem()
+		}
+		target.Set(fieldVal)
+	}
+
+	updated, err = clone(updated)
+	if err != nil {
+		return false, err
+	}
+	err = c.checkConflicts(ID, &updated, conflict)
+	if err != nil {
+		return false, err
+	}
+	c.docs[ID] = updated
+	return true, nil
+}
+
+// delete removes the document with ID, unless match, if any, rejects it. It
+// reports whether a document got removed.
+func (c *collection[T]) delete(ID string, match func(doc *T) bool) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	doc, ok := c.docs[ID]
+	if !ok || (match != nil && !match(&doc)) {
+		return false
+	}
+	delete(c.docs, ID)
+	return true
+}
+
+// snapshot returns a function putting back the current documents. Stored
+// documents are never mutated, so copying the map is enough.
+func (c *collection[T]) snapshot() func() {
+	c.mu.RLock()
+	docs := maps.Clone(c.docs)
+	c.mu.RUnlock()
+
+	return func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		c.docs = docs
+	}
+}
+
+// fieldByBSONTag finds the field of v with the bson tag, looking into inline
+// structs too.
+func fieldByBSONTag(v reflect.Value, tag string) reflect.Value {
+	t := v.Type()
+	for i := range t.NumField() {
+		name, opts, _ := strings.Cut(t.Field(i).Tag.Get("bson"), ",")
+		if name == tag {
+			return v.Field(i)
+		}
+		if opts == "inline" && v.Field(i).Kind() == reflect.Struct {
+			if field := fieldByBSONTag(v.Field(i), tag); field.IsValid() {
+				return field
+			}
+		}
+	}
+	return reflect.Value{}
+}
+
+func clone[T any](doc T) (T, error) {
+	var copied T
+	raw, err := bson.Marshal(doc)
+	if err != nil {
+		return copied, err
+	}
+	err = bson.Unmarshal(raw, &copied)
+	return copied, err
+}
+
+// paginate filters docs with filterDocs, sorts them by name and returns the
+// requested page, like the Mongo adapters do. With audit, docs are also
+// sorted by their audit.
+func paginate[T any](docs []T, req *requests.GetPagination, name func(doc *T) string, audit func(doc *T) *models.Audit) []T {
+	docs = filterDocs(docs, req, name, audit)
+
+	key := func(doc *T) string { return name(doc) }
+	if audit != nil {
+		switch req.SortBy {
+		case requests.SortByCreatedAt:
+			key = func(doc *T) string { return audit(doc).CreatedAt.Format(sortableTime) }
+		case requests.SortByUpdatedAt:
+			key = func(doc *T) string { return audit(doc).UpdatedAt.Format(sortableTime) }
+		}
+	}
+	slices.SortFunc(docs, func(a, b T) int {
+		if req.SortOrder == "asc" {
+			return cmp.Compare(key(&a), key(&b))
+		}
+		return cmp.Compare(key(&b), key(&a))
+	})
+
+	start := max((req.Page-1)*req.PageSize, 0)
+	if start >= len(docs) {
+		return []T{}
+	}
+	docs = docs[start:]
+	if req.PageSize > 0 && req.PageSize < len(docs) {
+		docs = docs[:req.PageSize]
+	}
+	return docs
+}
+
+// filterDocs keeps the docs matching a case-insensitive name search and, with
+// audit, the audit filters of req.
+func filterDocs[T any](docs []T, req *requests.GetPagination, name func(doc *T) string, audit func(doc *T) *models.Audit) []T {
+	if req.Search != "" {
+		search, err := regexp.Compile("(?i)" + req.Search)
+		if err != nil {
+			search = regexp.MustCompile("(?i)" + regexp.QuoteMeta(req.Search))
+		}
+		docs = slices.DeleteFunc(docs, func(doc T) bool {
+			return !search.MatchString(name(&doc))
+		})
+	}
+	if audit != nil {
+		docs = slices.DeleteFunc(docs, func(doc T) bool {
+			return !matchesAudit(audit(&doc), req)
+		})
+	}
+	return docs
+}
+
+// sortableTime formats UTC times so they sort as strings.
+const sortableTime = "2006-01-02T15:04:05.000"
+
+func matchesAudit(audit *models.Audit, req *requests.GetPagination) bool {
+	switch {
+	case req.CreatedBy != "" && audit.CreatedBy != req.CreatedBy,
+		req.UpdatedBy != "" && audit.UpdatedBy != req.UpdatedBy,
+		!req.CreatedAfter.IsZero() && !audit.CreatedAt.After(req.CreatedAfter),
+		!req.CreatedBefore.IsZero() && !audit.CreatedAt.Before(req.CreatedBefore),
+		!req.UpdatedAfter.IsZero() && !audit.UpdatedAt.After(req.UpdatedAfter),
+		!req.UpdatedBefore.IsZero() && !audit.UpdatedAt.Before(req.UpdatedBefore):
+		return false
+	}
+	return true
+}
+
+func hasFile(files []models.File, sha256 string, sharedFileID string) bool {
+	return slices.ContainsFunc(files, func(f models.File) bool {
+		return (sha256 != "" && f.SHA256 == sha256) || (sharedFileID != "" && f.SharedFileID == sharedFileID)
+	})
+}
+
+func hasSlug(docSlug string, aliases []string, slug string) bool {
+	return docSlug == slug || slices.Contains(aliases, slug)
+}
@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+)
+
+type limitProfileRepo struct {
+	col *collection[models.LimitProfile]
+}
+
+func NewLimitProfileRepo() repositories.LimitProfileRepository {
+	return &limitProfileRepo{
+		col: newCollection[models.LimitProfile](),
+	}
+}
+
+func (l *limitProfileRepo) Create(ctx context.Context, ID string, body *requests.CreateLimitProfile) error {
+	return l.col.insert(ID, models.LimitProfile{
+		ID:          ID,
+		Name:        body.Name,
+		Description: body.Description,
+		Limit:       body.Limit,
+	}, nil)
+}
+
+func (l *limitProfileRepo) GetAll(ctx context.Context) ([]models.LimitProfile, error) {
+	return l.col.find(nil)
+}
+
+func (l *limitProfileRepo) GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.LimitProfile, error) {
+	profiles, err := l.col.find(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return paginate(profiles, req, func(p *models.LimitProfile) string { return p.Name }, nil), nil
+}
+
+func (l *limitProfileRepo) Count(ctx context.Context, req *requests.GetPagination) (int, error) {
+	profiles, err := l.col.find(nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(filterDocs(profiles, req, func(p *models.LimitProfile) string { return p.Name }, nil)), nil
+}
+
+func (l *limitProfileRepo) GetByID(ctx context.Context, ID string) (*models.LimitProfile, error) {
+	return l.col.get(ID)
+}
+
+func (l *limitProfileRepo) UpdateByID(ctx context.Context, ID string, body *requests.UpdateLimitProfile) error {
+	updated, err := l.col.update(ID, nil, body, nil)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return limitProfileNotFound(ID)
+	}
+	return nil
+}
+
+func (l *limitProfileRepo) DeleteByID(ctx context.Context, ID string) error {
+	if !l.col.delete(ID, nil) {
+		return limitProfileNotFound(ID)
+	}
+	return nil
+}
+
+func limitProfileNotFound(ID string) error {
+	return fmt.Errorf("%w: limit profile %s not found", cerrors.New(cerrors.CANNOT_GET_DATA), ID)
+}
@@ -0,0 +1,83 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+	"github.com/CSKU-Lab/config-server/internal/migrate"
+)
+
+type migrationRepo struct {
+	mu      sync.Mutex
+	applied []models.AppliedMigration
+	owner   string
+	expiry  time.Time
+}
+
+func NewMigrationRepo() repositories.MigrationRepository {
+	return &migrationRepo{}
+}
+
+// Migrations returns the schema migrations of the in-memory storage, which
+// starts empty on every run and has none so far.
+func Migrations() []migrate.Migration {
+	return nil
+}
+
+func (r *migrationRepo) Applied(ctx context.Context) ([]models.AppliedMigration, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Clone(r.applied), nil
+}
+
+func (r *migrationRepo) Add(ctx context.Context, migration *models.AppliedMigration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i, found := slices.BinarySearchFunc(r.applied, migration.Version, func(a models.AppliedMigration, version int) int {
+		return a.Version - version
+	})
+	if found {
+		return cerrors.New(cerrors.DUPLICATE_DATA)
+	}
+	r.applied = slices.Insert(r.applied, i, *migration)
+	return nil
+}
+
+func (r *migrationRepo) Remove(ctx context.Context, version int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.applied = slices.DeleteFunc(r.applied, func(a models.AppliedMigration) bool {
+		return a.Version == version
+	})
+	return nil
+}
+
+func (r *migrationRepo) Lock(ctx context.Context, owner string, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.owner != "" && r.owner != owner && r.expiry.After(time.Now()) {
+		return cerrors.New(cerrors.DATA_IN_USE)
+	}
+	r.owner = owner
+	r.expiry = expiresAt
+	return nil
+}
+
+func (r *migrationRepo) Unlock(ctx context.Context, owner string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.owner == owner {
+		r.owner = ""
+	}
+	return nil
+}
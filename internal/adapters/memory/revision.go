@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+)
+
+type revisionRepo struct {
+	mu        sync.RWMutex
+	revisions []models.Revision
+	// changed is closed and replaced whenever a revision is created.
+	changed chan struct{}
+}
+
+func NewRevisionRepo() repositories.RevisionRepository {
+	return &revisionRepo{
+		changed: make(chan struct{}),
+	}
+}
+
+func (r *revisionRepo) Create(ctx context.Context, revision *models.Revision) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if revision.Number != int64(len(r.revisions))+1 {
+		return cerrors.New(cerrors.DUPLICATE_DATA)
+	}
+
+	copied, err := clone(*revision)
+	if err != nil {
+		return err
+	}
+	r.revisions = append(r.revisions, copied)
+
+	close(r.changed)
+	r.changed = make(chan struct{})
+	return nil
+}
+
+func (r *revisionRepo) Latest(ctx context.Context) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return int64(len(r.revisions)), nil
+}
+
+func (r *revisionRepo) GetSince(ctx context.Context, since int64, limit int) ([]models.Revision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	revisions := []models.Revision{}
+	for i := max(since, 0); i < int64(len(r.revisions)); i++ {
+		if limit > 0 && len(revisions) == limit {
+			break
+		}
+
+		copied, err := clone(r.revisions[i])
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, copied)
+	}
+	return revisions, nil
+}
+
+func (r *revisionRepo) Wait(ctx context.Context, since int64) error {
+	r.mu.RLock()
+	latest, changed := int64(len(r.revisions)), r.changed
+	r.mu.RUnlock()
+
+	if latest > since {
+		return nil
+	}
+
+	select {
+	case <-changed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,159 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/namespaces"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+)
+
+type runnerRepo struct {
+	col *collection[models.Runner]
+}
+
+func NewRunnerRepo() repositories.RunnerRepository {
+	return &runnerRepo{
+		col: newCollection[models.Runner](),
+	}
+}
+
+func (l *runnerRepo) snapshot() func() {
+	return l.col.snapshot()
+}
+
+// runnerNameConflict fails when two runners share a name in a namespace.
+func runnerNameConflict(runner *models.Runner, stored *models.Runner) error {
+	if stored.Namespace == runner.Namespace && stored.Name == runner.Name {
+		return &cerrors.ConflictError{Kind: "runner", Name: runner.Name, ID: stored.ID}
+	}
+	return nil
+}
+
+func (l *runnerRepo) Create(ctx context.Context, ID string, body *requests.CreateRunner) error {
+	return l.col.insert(ID, models.Runner{
+		ID:          ID,
+		Name:        body.Name,
+		Slug:        body.Slug,
+		Aliases:     body.Aliases,
+		Description: body.Description,
+		ParentID:    body.ParentID,
+		Variables:   body.Variables,
+		Env:         body.Env,
+
+		DefaultLimitProfileID: body.DefaultLimitProfileID,
+		LimitMultipliers:      body.LimitMultipliers,
+		Metadata:              body.Metadata,
+
+		StarterTemplate: body.StarterTemplate,
+		EntryPoint:      body.EntryPoint,
+
+		Namespace: body.Namespace,
+		Audit:     body.Audit,
+	}, runnerNameConflict)
+}
+
+func (l *runnerRepo) GetAll(ctx context.Context) ([]models.Runner, error) {
+	return l.col.find(func(r *models.Runner) bool { return namespaces.IsVisible(ctx, r.Namespace) })
+}
+
+func (l *runnerRepo) GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.Runner, error) {
+	runners, err := l.col.find(func(r *models.Runner) bool {
+		return namespaces.IsVisible(ctx, r.Namespace) && matchesMetadata(r.Metadata, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return paginate(runners, req, func(r *models.Runner) string { return r.Name }, func(r *models.Runner) *models.Audit { return &r.Audit }), nil
+}
+
+func matchesMetadata(metadata *models.RunnerMetadata, req *requests.GetPagination) bool {
+	if req.Language == "" && len(req.Tags) == 0 && req.Extension == "" {
+		return true
+	}
+	if metadata == nil {
+		return false
+	}
+
+	for _, tag := range req.Tags {
+		if !slices.Contains(metadata.Tags, tag) {
+			return false
+		}
+	}
+	return (req.Language == "" || metadata.Language == req.Language) &&
+		(req.Extension == "" || slices.Contains(metadata.Extensions, req.Extension))
+}
+
+func (l *runnerRepo) Count(ctx context.Context, req *requests.GetPagination) (int, error) {
+	runners, err := l.col.find(func(r *models.Runner) bool {
+		return namespaces.IsVisible(ctx, r.Namespace) && matchesMetadata(r.Metadata, req)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(filterDocs(runners, req, func(r *models.Runner) string { return r.Name }, func(r *models.Runner) *models.Audit { return &r.Audit })), nil
+}
+
+func (l *runnerRepo) GetByID(ctx context.Context, ID string) (*models.Runner, error) {
+	runner, err := l.col.get(ID)
+	if err != nil {
+		return nil, err
+	}
+	if !namespaces.IsVisible(ctx, runner.Namespace) {
+		return nil, cerrors.New(cerrors.CANNOT_GET_DATA)
+	}
+	return runner, nil
+}
+
+func (l *runnerRepo) GetBySlug(ctx context.Context, slug string) (*models.Runner, error) {
+	runners, err := l.col.find(func(r *models.Runner) bool {
+		return namespaces.IsVisible(ctx, r.Namespace) && hasSlug(r.Slug, r.Aliases, slug)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(runners) == 0 {
+		return nil, fmt.Errorf("%w: runner %s not found", cerrors.New(cerrors.CANNOT_GET_DATA), slug)
+	}
+	return &runners[0], nil
+}
+
+func (l *runnerRepo) GetByParentID(ctx context.Context, parentID string) ([]models.Runner, error) {
+	return l.col.find(func(r *models.Runner) bool { return r.ParentID == parentID })
+}
+
+func (l *runnerRepo) GetByFile(ctx context.Context, sha256 string, sharedFileID string) ([]models.Runner, error) {
+	return l.col.find(func(r *models.Runner) bool { return hasFile(r.InitialFiles, sha256, sharedFileID) })
+}
+
+func (l *runnerRepo) UpdateByID(ctx context.Context, ID string, body *requests.UpdateRunner) error {
+	updated, err := l.col.update(ID, l.owned(ctx), body, runnerNameConflict)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return runnerNotFound(ID)
+	}
+	return nil
+}
+
+func (l *runnerRepo) DeleteByID(ctx context.Context, ID string) error {
+	if !l.col.delete(ID, l.owned(ctx)) {
+		return runnerNotFound(ID)
+	}
+	return nil
+}
+
+// owned matches the runners that can be changed from ctx.
+func (l *runnerRepo) owned(ctx context.Context) func(r *models.Runner) bool {
+	return func(r *models.Runner) bool { return namespaces.IsOwned(ctx, r.Namespace) }
+}
+
+func runnerNotFound(ID string) error {
+	return fmt.Errorf("%w: runner %s not found", cerrors.New(cerrors.CANNOT_GET_DATA), ID)
+}
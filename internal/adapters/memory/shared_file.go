@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+)
+
+type sharedFileRepo struct {
+	col *collection[models.SharedFile]
+}
+
+func NewSharedFileRepo() repositories.SharedFileRepository {
+	return &sharedFileRepo{
+		col: newCollection[models.SharedFile](),
+	}
+}
+
+func (s *sharedFileRepo) snapshot() func() {
+	return s.col.snapshot()
+}
+
+func (s *sharedFileRepo) Create(ctx context.Context, ID string, body *requests.CreateSharedFile) error {
+	return s.col.insert(ID, models.SharedFile{
+		ID:          ID,
+		Name:        body.Name,
+		Description: body.Description,
+		File:        body.File,
+	}, nil)
+}
+
+func (s *sharedFileRepo) GetAll(ctx context.Context) ([]models.SharedFile, error) {
+	return s.col.find(nil)
+}
+
+func (s *sharedFileRepo) GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.SharedFile, error) {
+	files, err := s.col.find(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return paginate(files, req, func(f *models.SharedFile) string { return f.Name }, nil), nil
+}
+
+func (s *sharedFileRepo) Count(ctx context.Context) (int, error) {
+	return s.col.count(), nil
+}
+
+func (s *sharedFileRepo) GetByID(ctx context.Context, ID string) (*models.SharedFile, error) {
+	return s.col.get(ID)
+}
+
+func (s *sharedFileRepo) UpdateByID(ctx context.Context, ID string, body *requests.UpdateSharedFile) error {
+	_, err := s.col.update(ID, nil, body, nil)
+	return err
+}
+
+func (s *sharedFileRepo) DeleteByID(ctx context.Context, ID string) error {
+	s.col.delete(ID, nil)
+	return nil
+}
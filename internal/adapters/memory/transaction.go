@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+)
+
+// snapshotter is implemented by the repositories taking part in
+// transactions.
+type snapshotter interface {
+	// snapshot returns a function restoring the current documents.
+	snapshot() func()
+}
+
+type transactor struct {
+	mu    sync.Mutex
+	repos []snapshotter
+}
+
+// NewTransactor runs one transaction at a time, and restores the documents of
+// repos when one is aborted. Writes made meanwhile outside of the transaction
+// are lost along with it, which is fine for development.
+func NewTransactor(repos ...any) repositories.Transactor {
+	t := &transactor{}
+	for _, repo := range repos {
+		if s, ok := repo.(snapshotter); ok {
+			t.repos = append(t.repos, s)
+		}
+	}
+	return t
+}
+
+func (t *transactor) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	restores := make([]func(), len(t.repos))
+	for i, repo := range t.repos {
+		restores[i] = repo.snapshot()
+	}
+
+	err := fn(ctx)
+	if err != nil {
+		for _, restore := range restores {
+			restore()
+		}
+	}
+	return err
+}
@@ -0,0 +1,55 @@
+package mongodb
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type blobRepo struct {
+	bucket *mongo.GridFSBucket
+}
+
+// NewBlobRepo stores blobs in GridFS, using the digest as the file name.
+func NewBlobRepo(db *mongo.Database) repositories.BlobRepository {
+	return &blobRepo{
+		bucket: db.GridFSBucket(options.GridFSBucket().SetName("blobs")),
+	}
+}
+
+func (b *blobRepo) Put(ctx context.Context, sha256 string, data []byte) error {
+	cursor, err := b.bucket.Find(ctx, bson.D{{Key: "filename", Value: sha256}}, options.GridFSFind().SetLimit(1))
+	if err != nil {
+		return fmt.Errorf("Cannot find blob %s : %v", sha256, err)
+	}
+	exists := cursor.Next(ctx)
+	cursor.Close(ctx)
+	if exists {
+		return nil
+	}
+
+	_, err = b.bucket.UploadFromStream(ctx, sha256, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("Cannot upload blob %s : %v", sha256, err)
+	}
+	return nil
+}
+
+func (b *blobRepo) Open(ctx context.Context, sha256 string) (io.ReadCloser, int64, error) {
+	stream, err := b.bucket.OpenDownloadStreamByName(ctx, sha256)
+	if err != nil {
+		if errors.Is(err, mongo.ErrFileNotFound) {
+			return nil, 0, fmt.Errorf("%w: blob %s not found", cerrors.New(cerrors.CANNOT_GET_DATA), sha256)
+		}
+		return nil, 0, fmt.Errorf("Cannot open blob %s : %v", sha256, err)
+	}
+	return stream, stream.GetFile().Length, nil
+}
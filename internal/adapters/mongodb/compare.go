@@ -20,13 +20,23 @@ type compareRepo struct {
 }
 
 type compareDoc struct {
-	ID          string        `bson:"_id"`
-	Name        string        `bson:"name"`
-	Files       []models.File `bson:"files"`
-	BuildScript string        `bson:"build_script"`
-	RunScript   string        `bson:"run_script"`
-	RunName     string        `bson:"run_name"`
-	Description string        `bson:"description"`
+	ID          string              `bson:"_id"`
+	Name        string              `bson:"name"`
+	Slug        string              `bson:"slug,omitempty"`
+	Aliases     []string            `bson:"aliases,omitempty"`
+	Files       []models.File       `bson:"files"`
+	BuildScript string              `bson:"build_script"`
+	RunScript   string              `bson:"run_script"`
+	RunName     string              `bson:"run_name"`
+	Description string              `bson:"description"`
+	GoldenCases []models.GoldenCase `bson:"golden_cases"`
+
+	PresetID      string            `bson:"preset_id,omitempty"`
+	PresetVersion int               `bson:"preset_version,omitempty"`
+	PresetParams  map[string]string `bson:"preset_params,omitempty"`
+
+	Namespace    string `bson:"namespace"`
+	models.Audit `bson:",inline"`
 }
 
 func NewCompareRepo(db *mongo.Database) repositories.CompareRepository {
@@ -39,32 +49,12 @@ func NewCompareRepo(db *mongo.Database) repositories.CompareRepository {
 }
 
 func (c *compareRepo) GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.Compare, error) {
-	orderMap := map[string]int{
-		"desc": -1,
-		"asc":  1,
-	}
-
-	order, ok := orderMap[req.SortOrder]
-	if !ok {
-		order = -1
-	}
-
-	filter := bson.D{}
-	if req.Search != "" {
-		filter = bson.D{
-			{Key: "name", Value: bson.D{
-				{Key: "$regex", Value: req.Search},
-				{Key: "$options", Value: "i"},
-			}},
-		}
-	}
-
 	opts := options.Find().
 		SetSkip(int64((req.Page - 1) * req.PageSize)).
 		SetLimit(int64(req.PageSize)).
-		SetSort(bson.D{{Key: "name", Value: order}})
+		SetSort(auditedSort(req))
 
-	cursor, err := c.col.Find(ctx, filter, opts)
+	cursor, err := c.col.Find(ctx, visibleFilter(ctx, auditedFilter(req)), opts)
 	if err != nil {
 		return nil, err
 	}
@@ -79,8 +69,8 @@ func (c *compareRepo) GetPagination(ctx context.Context, req *requests.GetPagina
 	return compares, nil
 }
 
-func (c *compareRepo) Count(ctx context.Context) (int, error) {
-	count, err := c.col.CountDocuments(ctx, bson.D{})
+func (c *compareRepo) Count(ctx context.Context, req *requests.GetPagination) (int, error) {
+	count, err := c.col.CountDocuments(ctx, visibleFilter(ctx, auditedFilter(req)))
 	if err != nil {
 		return 0, err
 	}
@@ -92,26 +82,35 @@ func (c *compareRepo) Create(ctx context.Context, ID string, body *requests.Crea
 	compare := &compareDoc{
 		ID:          ID,
 		Name:        body.Name,
+		Slug:        body.Slug,
+		Aliases:     body.Aliases,
 		Files:       body.Files,
 		BuildScript: body.BuildScript,
 		RunScript:   body.RunScript,
 		RunName:     body.RunName,
 		Description: body.Description,
+		GoldenCases: body.GoldenCases,
+
+		PresetID:      body.PresetID,
+		PresetVersion: body.PresetVersion,
+		PresetParams:  body.PresetParams,
+
+		Namespace: body.Namespace,
+		Audit:     body.Audit,
 	}
 
 	_, err := c.col.InsertOne(ctx, compare)
+	if conflict := nameConflict(ctx, c.col, "compare", body.Namespace, body.Name, err); conflict != nil {
+		return conflict
+	}
 	if err != nil {
-		var mongoErr mongo.WriteException
-		if errors.As(err, &mongoErr) {
-			return cerrors.New(cerrors.DUPLICATE_DATA)
-		}
-		return cerrors.New(cerrors.UNKNOWN_ERROR)
+		return fmt.Errorf("Cannot create compare : %v", err)
 	}
 	return nil
 }
 
 func (c *compareRepo) GetAll(ctx context.Context) ([]models.Compare, error) {
-	cursor, err := c.col.Find(ctx, bson.D{})
+	cursor, err := c.col.Find(ctx, visibleFilter(ctx, bson.D{}))
 	if err != nil {
 		return nil, fmt.Errorf("Cannot get compares : %v", err)
 	}
@@ -128,24 +127,71 @@ func (c *compareRepo) GetAll(ctx context.Context) ([]models.Compare, error) {
 
 func (c *compareRepo) GetByID(ctx context.Context, ID string) (*models.Compare, error) {
 	var compare models.Compare
-	err := c.col.FindOne(ctx, bson.M{"_id": ID}).Decode(&compare)
+	err := c.col.FindOne(ctx, visibleFilter(ctx, bson.D{{Key: "_id", Value: ID}})).Decode(&compare)
 	if err != nil {
 		return nil, cerrors.New(cerrors.CANNOT_GET_DATA)
 	}
 	return &compare, nil
 }
 
+func (c *compareRepo) GetBySlug(ctx context.Context, slug string) (*models.Compare, error) {
+	var compare models.Compare
+	err := c.col.FindOne(ctx, visibleFilter(ctx, slugFilter(slug))).Decode(&compare)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("%w: compare %s not found", cerrors.New(cerrors.CANNOT_GET_DATA), slug)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Cannot get compare : %v", err)
+	}
+	return &compare, nil
+}
+
+func (c *compareRepo) GetByFile(ctx context.Context, sha256 string, sharedFileID string) ([]models.Compare, error) {
+	filter := fileFilter("files", sha256, sharedFileID)
+	if filter == nil {
+		return nil, nil
+	}
+
+	cursor, err := c.col.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot get compares : %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	var compares []models.Compare
+	err = cursor.All(ctx, &compares)
+	if err != nil {
+		return nil, cerrors.New(cerrors.CANNOT_GET_DATA)
+	}
+
+	return compares, nil
+}
+
 func (c *compareRepo) UpdateByID(ctx context.Context, ID string, body *requests.UpdateCompare) error {
 	updatedFields := getUpdatedFields(body)
-	_, err := c.col.UpdateOne(ctx, bson.M{"_id": ID}, bson.D{{Key: "$set", Value: updatedFields}})
+	result, err := c.col.UpdateOne(ctx, ownedFilter(ctx, bson.D{{Key: "_id", Value: ID}}), bson.D{{Key: "$set", Value: updatedFields}})
+	if body.Name != nil || body.Namespace != nil {
+		if conflict := updateConflict(ctx, c.col, "compare", ID, body.Name, body.Namespace, err); conflict != nil {
+			return conflict
+		}
+	}
 	if err != nil {
 		return err
 	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("%w: compare %s not found", cerrors.New(cerrors.CANNOT_GET_DATA), ID)
+	}
 
 	return nil
 }
 
 func (c *compareRepo) DeleteByID(ctx context.Context, ID string) error {
-	_, err := c.col.DeleteOne(ctx, bson.M{"_id": ID})
-	return err
+	result, err := c.col.DeleteOne(ctx, ownedFilter(ctx, bson.D{{Key: "_id", Value: ID}}))
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("%w: compare %s not found", cerrors.New(cerrors.CANNOT_GET_DATA), ID)
+	}
+	return nil
 }
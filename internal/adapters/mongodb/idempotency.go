@@ -0,0 +1,81 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type idempotencyRepo struct {
+	col *mongo.Collection
+}
+
+// NewIdempotencyRepo stores the keys in a collection whose TTL index on
+// expires_at purges the expired ones.
+func NewIdempotencyRepo(db *mongo.Database) repositories.IdempotencyRepository {
+	return &idempotencyRepo{
+		col: db.Collection("idempotency_keys"),
+	}
+}
+
+// Reserve replaces an expired key the TTL monitor has not purged yet, and
+// otherwise inserts the key, which fails on the unique _id when it exists.
+func (r *idempotencyRepo) Reserve(ctx context.Context, key *models.IdempotencyKey) error {
+	filter := bson.D{
+		{Key: "_id", Value: key.Key},
+		{Key: "expires_at", Value: bson.D{{Key: "$lte", Value: time.Now().UTC()}}},
+	}
+	_, err := r.col.ReplaceOne(ctx, filter, key, options.Replace().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return cerrors.New(cerrors.DUPLICATE_DATA)
+	}
+	if err != nil {
+		return fmt.Errorf("Cannot reserve idempotency key : %v", err)
+	}
+	return nil
+}
+
+func (r *idempotencyRepo) Get(ctx context.Context, key string) (*models.IdempotencyKey, error) {
+	filter := bson.D{
+		{Key: "_id", Value: key},
+		{Key: "expires_at", Value: bson.D{{Key: "$gt", Value: time.Now().UTC()}}},
+	}
+
+	var stored models.IdempotencyKey
+	err := r.col.FindOne(ctx, filter).Decode(&stored)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("%w: idempotency key %s not found", cerrors.New(cerrors.CANNOT_GET_DATA), key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Cannot get idempotency key : %v", err)
+	}
+	return &stored, nil
+}
+
+func (r *idempotencyRepo) Complete(ctx context.Context, key string, resultID string, expiresAt time.Time) error {
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "result_id", Value: resultID},
+		{Key: "expires_at", Value: expiresAt},
+	}}}
+	_, err := r.col.UpdateByID(ctx, key, update)
+	if err != nil {
+		return fmt.Errorf("Cannot complete idempotency key : %v", err)
+	}
+	return nil
+}
+
+func (r *idempotencyRepo) Delete(ctx context.Context, key string) error {
+	_, err := r.col.DeleteOne(ctx, bson.D{{Key: "_id", Value: key}})
+	if err != nil {
+		return fmt.Errorf("Cannot delete idempotency key : %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type index struct {
+	collection string
+	name       string
+	keys       bson.D
+	unique     bool
+	// sparse leaves out the documents without the indexed field.
+	sparse bool
+	// ttl removes the documents once the time in the indexed field is past.
+	ttl bool
+}
+
+var initialIndexes = []index{
+	{collection: "runners", name: "unique_runner_name", keys: bson.D{{Key: "name", Value: 1}}, unique: true},
+	{collection: "compares", name: "unique_compare_name", keys: bson.D{{Key: "name", Value: 1}}, unique: true},
+	{collection: "idempotency_keys", name: "idempotency_keys_ttl", keys: bson.D{{Key: "expires_at", Value: 1}}, ttl: true},
+}
+
+// slugIndexes keep slugs unique among slugs and aliases unique among aliases,
+// the services check a slug against the aliases of the others.
+var slugIndexes = []index{
+	{collection: "runners", name: "unique_runner_slug", keys: bson.D{{Key: "slug", Value: 1}}, unique: true, sparse: true},
+	{collection: "runners", name: "unique_runner_aliases", keys: bson.D{{Key: "aliases", Value: 1}}, unique: true, sparse: true},
+	{collection: "compares", name: "unique_compare_slug", keys: bson.D{{Key: "slug", Value: 1}}, unique: true, sparse: true},
+	{collection: "compares", name: "unique_compare_aliases", keys: bson.D{{Key: "aliases", Value: 1}}, unique: true, sparse: true},
+}
+
+// namespaceIndexes keep names unique within a namespace, replacing the name
+// indexes of initialIndexes.
+var namespaceIndexes = []index{
+	{collection: "runners", name: "unique_runner_namespace_name", keys: bson.D{{Key: "namespace", Value: 1}, {Key: "name", Value: 1}}, unique: true},
+	{collection: "compares", name: "unique_compare_namespace_name", keys: bson.D{{Key: "namespace", Value: 1}, {Key: "name", Value: 1}}, unique: true},
+}
+
+// ensureIndexes creates the indexes the repositories rely on. Existing
+// indexes are left as is.
+func ensureIndexes(ctx context.Context, db *mongo.Database, indexes []index) error {
+	for _, idx := range indexes {
+		opts := options.Index().SetName(idx.name)
+		if idx.unique {
+			opts.SetUnique(true)
+		}
+		if idx.sparse {
+			opts.SetSparse(true)
+		}
+		if idx.ttl {
+			opts.SetExpireAfterSeconds(0)
+		}
+
+		col := db.Collection(idx.collection)
+		_, err := col.Indexes().CreateOne(ctx, mongo.IndexModel{Keys: idx.keys, Options: opts})
+		if mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("Cannot create index %s, rename the duplicated names %q of %s first : %v", idx.name, duplicateNames(ctx, col), idx.collection, err)
+		}
+		if err != nil {
+			return fmt.Errorf("Cannot create index %s : %v", idx.name, err)
+		}
+	}
+	return nil
+}
+
+func dropIndexes(ctx context.Context, db *mongo.Database, indexes []index) error {
+	for _, idx := range indexes {
+		err := db.Collection(idx.collection).Indexes().DropOne(ctx, idx.name)
+		var cmdErr mongo.CommandError
+		if errors.As(err, &cmdErr) && (cmdErr.Name == "IndexNotFound" || cmdErr.Name == "NamespaceNotFound") {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("Cannot drop index %s : %v", idx.name, err)
+		}
+	}
+	return nil
+}
+
+// duplicateNames lists the names shared by several documents, which prevent
+// a unique name index from being created.
+func duplicateNames(ctx context.Context, col *mongo.Collection) []string {
+	pipeline := mongo.Pipeline{
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$name"},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+		{{Key: "$match", Value: bson.D{{Key: "count", Value: bson.D{{Key: "$gt", Value: 1}}}}}},
+	}
+	cursor, err := col.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil
+	}
+	defer cursor.Close(ctx)
+
+	var groups []struct {
+		Name string `bson:"_id"`
+	}
+	if cursor.All(ctx, &groups) != nil {
+		return nil
+	}
+
+	names := make([]string, len(groups))
+	for i, group := range groups {
+		names[i] = group.Name
+	}
+	return names
+}
@@ -1,9 +1,16 @@
 package mongodb
 
 import (
+	"context"
 	"reflect"
+	"time"
 
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/namespaces"
+	"github.com/CSKU-Lab/config-server/domain/requests"
 	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
 func getUpdatedFields(i any) bson.D {
@@ -30,3 +37,150 @@ func getUpdatedFields(i any) bson.D {
 
 	return fields
 }
+
+// fileFilter matches documents with a file in the array field having the given
+// content or shared file, or returns nil when both are empty.
+func fileFilter(field string, sha256 string, sharedFileID string) bson.D {
+	var or bson.A
+	if sha256 != "" {
+		or = append(or, bson.D{{Key: field + ".sha256", Value: sha256}})
+	}
+	if sharedFileID != "" {
+		or = append(or, bson.D{{Key: field + ".shared_file_id", Value: sharedFileID}})
+	}
+	if len(or) == 0 {
+		return nil
+	}
+	return bson.D{{Key: "$or", Value: or}}
+}
+
+// slugFilter matches the document having the slug, or having had it as an
+// alias.
+func slugFilter(slug string) bson.D {
+	return bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: "slug", Value: slug}},
+		bson.D{{Key: "aliases", Value: slug}},
+	}}}
+}
+
+// visibleFilter restricts filter to the documents readable from the
+// namespace of ctx.
+func visibleFilter(ctx context.Context, filter bson.D) bson.D {
+	namespace, ok := namespaces.FromContext(ctx)
+	if !ok {
+		return filter
+	}
+	return append(filter, bson.E{Key: "namespace", Value: bson.D{{Key: "$in", Value: namespaces.Visible(namespace)}}})
+}
+
+// ownedFilter restricts filter to the documents changeable from the namespace
+// of ctx.
+func ownedFilter(ctx context.Context, filter bson.D) bson.D {
+	namespace, ok := namespaces.FromContext(ctx)
+	if !ok {
+		return filter
+	}
+	return append(filter, bson.E{Key: "namespace", Value: namespace})
+}
+
+// nameConflict returns the conflict of a write failing on the unique name
+// index of col, with the ID of the document holding the name in the
+// namespace. It returns nil when err is not a duplicate key error.
+func nameConflict(ctx context.Context, col *mongo.Collection, kind string, namespace string, name string, err error) error {
+	if !mongo.IsDuplicateKeyError(err) {
+		return nil
+	}
+
+	conflict := &cerrors.ConflictError{Kind: kind, Name: name}
+	var existing struct {
+		ID string `bson:"_id"`
+	}
+	opts := options.FindOne().SetProjection(bson.D{{Key: "_id", Value: 1}})
+	filter := bson.D{{Key: "namespace", Value: namespace}, {Key: "name", Value: name}}
+	// Fails within an aborted transaction, the ID is then left out.
+	if col.FindOne(ctx, filter, opts).Decode(&existing) == nil {
+		conflict.ID = existing.ID
+	}
+	return conflict
+}
+
+// updateConflict is nameConflict for an update of the document ID, renaming
+// it or moving it to another namespace.
+func updateConflict(ctx context.Context, col *mongo.Collection, kind string, ID string, name *string, namespace *string, err error) error {
+	if !mongo.IsDuplicateKeyError(err) {
+		return nil
+	}
+
+	var current struct {
+		Name      string `bson:"name"`
+		Namespace string `bson:"namespace"`
+	}
+	// The update failed, so the document is left as it was.
+	_ = col.FindOne(ctx, bson.D{{Key: "_id", Value: ID}}).Decode(&current)
+	if name != nil {
+		current.Name = *name
+	}
+	if namespace != nil {
+		current.Namespace = *namespace
+	}
+	return nameConflict(ctx, col, kind, current.Namespace, current.Name, err)
+}
+
+// searchFilter matches the documents of a pagination request by name.
+func searchFilter(req *requests.GetPagination) bson.D {
+	filter := bson.D{}
+	if req.Search != "" {
+		filter = append(filter, bson.E{Key: "name", Value: bson.D{
+			{Key: "$regex", Value: req.Search},
+			{Key: "$options", Value: "i"},
+		}})
+	}
+	return filter
+}
+
+// auditedFilter matches the runners or compares of a pagination request, by
+// name and by the authorship and times of their audit.
+func auditedFilter(req *requests.GetPagination) bson.D {
+	filter := searchFilter(req)
+	if req.CreatedBy != "" {
+		filter = append(filter, bson.E{Key: "created_by", Value: req.CreatedBy})
+	}
+	if req.UpdatedBy != "" {
+		filter = append(filter, bson.E{Key: "updated_by", Value: req.UpdatedBy})
+	}
+	if r := timeRange(req.CreatedAfter, req.CreatedBefore); r != nil {
+		filter = append(filter, bson.E{Key: "created_at", Value: r})
+	}
+	if r := timeRange(req.UpdatedAfter, req.UpdatedBefore); r != nil {
+		filter = append(filter, bson.E{Key: "updated_at", Value: r})
+	}
+	return filter
+}
+
+// timeRange matches the times after after and before before, or returns nil
+// when both are zero.
+func timeRange(after time.Time, before time.Time) bson.D {
+	var r bson.D
+	if !after.IsZero() {
+		r = append(r, bson.E{Key: "$gt", Value: after})
+	}
+	if !before.IsZero() {
+		r = append(r, bson.E{Key: "$lt", Value: before})
+	}
+	return r
+}
+
+// auditedSort sorts runners or compares by the field of a pagination request,
+// descending unless asc is asked, and then by ID so pages are stable.
+func auditedSort(req *requests.GetPagination) bson.D {
+	field := req.SortBy
+	if field == "" {
+		field = requests.SortByName
+	}
+
+	order := -1
+	if req.SortOrder == "asc" {
+		order = 1
+	}
+	return bson.D{{Key: field, Value: order}, {Key: "_id", Value: order}}
+}
@@ -0,0 +1,138 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type limitProfileRepo struct {
+	col *mongo.Collection
+}
+
+type limitProfileDoc struct {
+	ID          string       `bson:"_id"`
+	Name        string       `bson:"name"`
+	Description string       `bson:"description"`
+	Limit       models.Limit `bson:"limit"`
+}
+
+func NewLimitProfileRepo(db *mongo.Database) repositories.LimitProfileRepository {
+	return &limitProfileRepo{
+		col: db.Collection("limit_profiles"),
+	}
+}
+
+func (l *limitProfileRepo) Create(ctx context.Context, ID string, body *requests.CreateLimitProfile) error {
+	profile := &limitProfileDoc{
+		ID:          ID,
+		Name:        body.Name,
+		Description: body.Description,
+		Limit:       body.Limit,
+	}
+	_, err := l.col.InsertOne(ctx, profile)
+	if mongo.IsDuplicateKeyError(err) {
+		return cerrors.New(cerrors.DUPLICATE_DATA)
+	}
+	if err != nil {
+		return fmt.Errorf("Cannot create limit profile : %v", err)
+	}
+	return nil
+}
+
+func (l *limitProfileRepo) GetAll(ctx context.Context) ([]models.LimitProfile, error) {
+	cursor, err := l.col.Find(ctx, bson.D{})
+	if err != nil {
+		return nil, fmt.Errorf("Cannot get limit profiles : %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	var profiles []models.LimitProfile
+	err = cursor.All(ctx, &profiles)
+	if err != nil {
+		return nil, cerrors.New(cerrors.CANNOT_GET_DATA)
+	}
+
+	return profiles, nil
+}
+
+func (l *limitProfileRepo) GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.LimitProfile, error) {
+	orderMap := map[string]int{
+		"desc": -1,
+		"asc":  1,
+	}
+
+	order, ok := orderMap[req.SortOrder]
+	if !ok {
+		order = -1
+	}
+
+	filter := searchFilter(req)
+
+	opts := options.Find().
+		SetSkip(int64((req.Page - 1) * req.PageSize)).
+		SetLimit(int64(req.PageSize)).
+		SetSort(bson.D{{Key: "name", Value: order}})
+
+	cursor, err := l.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var profiles []models.LimitProfile
+	err = cursor.All(ctx, &profiles)
+	if err != nil {
+		return nil, cerrors.New(cerrors.CANNOT_GET_DATA)
+	}
+
+	return profiles, nil
+}
+
+func (l *limitProfileRepo) Count(ctx context.Context, req *requests.GetPagination) (int, error) {
+	count, err := l.col.CountDocuments(ctx, searchFilter(req))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+func (l *limitProfileRepo) GetByID(ctx context.Context, ID string) (*models.LimitProfile, error) {
+	var profile models.LimitProfile
+	err := l.col.FindOne(ctx, bson.M{"_id": ID}).Decode(&profile)
+	if err != nil {
+		return nil, cerrors.New(cerrors.CANNOT_GET_DATA)
+	}
+	return &profile, nil
+}
+
+func (l *limitProfileRepo) UpdateByID(ctx context.Context, ID string, body *requests.UpdateLimitProfile) error {
+	updatedFields := getUpdatedFields(body)
+	result, err := l.col.UpdateOne(ctx, bson.M{"_id": ID}, bson.D{{Key: "$set", Value: updatedFields}})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("%w: limit profile %s not found", cerrors.New(cerrors.CANNOT_GET_DATA), ID)
+	}
+	return nil
+}
+
+func (l *limitProfileRepo) DeleteByID(ctx context.Context, ID string) error {
+	result, err := l.col.DeleteOne(ctx, bson.M{"_id": ID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("%w: limit profile %s not found", cerrors.New(cerrors.CANNOT_GET_DATA), ID)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const migrationLockID = "migrate"
+
+type migrationRepo struct {
+	col  *mongo.Collection
+	lock *mongo.Collection
+}
+
+type migrationLockDoc struct {
+	ID        string    `bson:"_id"`
+	Owner     string    `bson:"owner"`
+	ExpiresAt time.Time `bson:"expires_at"`
+}
+
+func NewMigrationRepo(db *mongo.Database) repositories.MigrationRepository {
+	return &migrationRepo{
+		col:  db.Collection("schema_migrations"),
+		lock: db.Collection("schema_migrations_lock"),
+	}
+}
+
+func (r *migrationRepo) Applied(ctx context.Context) ([]models.AppliedMigration, error) {
+	cursor, err := r.col.Find(ctx, bson.D{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("Cannot get applied migrations : %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	applied := []models.AppliedMigration{}
+	err = cursor.All(ctx, &applied)
+	if err != nil {
+		return nil, cerrors.New(cerrors.CANNOT_GET_DATA)
+	}
+	return applied, nil
+}
+
+func (r *migrationRepo) Add(ctx context.Context, migration *models.AppliedMigration) error {
+	_, err := r.col.InsertOne(ctx, migration)
+	if mongo.IsDuplicateKeyError(err) {
+		return cerrors.New(cerrors.DUPLICATE_DATA)
+	}
+	if err != nil {
+		return fmt.Errorf("Cannot add applied migration : %v", err)
+	}
+	return nil
+}
+
+func (r *migrationRepo) Remove(ctx context.Context, version int) error {
+	_, err := r.col.DeleteOne(ctx, bson.D{{Key: "_id", Value: version}})
+	if err != nil {
+		return fmt.Errorf("Cannot remove applied migration : %v", err)
+	}
+	return nil
+}
+
+// Lock replaces the lock when it's free, expired or already held by owner,
+// and otherwise fails on the unique _id when inserting it.
+func (r *migrationRepo) Lock(ctx context.Context, owner string, expiresAt time.Time) error {
+	filter := bson.D{
+		{Key: "_id", Value: migrationLockID},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "owner", Value: owner}},
+			bson.D{{Key: "expires_at", Value: bson.D{{Key: "$lte", Value: time.Now().UTC()}}}},
+		}},
+	}
+	lock := &migrationLockDoc{
+		ID:        migrationLockID,
+		Owner:     owner,
+		ExpiresAt: expiresAt,
+	}
+
+	_, err := r.lock.ReplaceOne(ctx, filter, lock, options.Replace().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("%w: another replica is migrating", cerrors.New(cerrors.DATA_IN_USE))
+	}
+	if err != nil {
+		return fmt.Errorf("Cannot lock migrations : %v", err)
+	}
+	return nil
+}
+
+func (r *migrationRepo) Unlock(ctx context.Context, owner string) error {
+	_, err := r.lock.DeleteOne(ctx, bson.D{{Key: "_id", Value: migrationLockID}, {Key: "owner", Value: owner}})
+	if err != nil {
+		return fmt.Errorf("Cannot unlock migrations : %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CSKU-Lab/config-server/internal/migrate"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Migrations returns the schema migrations of the Mongo storage. Versions are
+// never reused, a change to an applied migration goes in a new one.
+func Migrations(db *mongo.Database) []migrate.Migration {
+	return []migrate.Migration{
+		{
+			Version:     1,
+			Description: "create indexes",
+			Up: func(ctx context.Context) error {
+				return ensureIndexes(ctx, db, initialIndexes)
+			},
+			Down: func(ctx context.Context) error {
+				return dropIndexes(ctx, db, initialIndexes)
+			},
+		},
+		{
+			Version:     2,
+			Description: "backfill creation times of runners and compares",
+			Up: func(ctx context.Context) error {
+				for _, name := range []string{"runners", "compares"} {
+					err := backfillCreatedAt(ctx, db.Collection(name))
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			// The times are left in place, older builds ignore them.
+			Down: func(ctx context.Context) error {
+				return nil
+			},
+		},
+		{
+			Version:     3,
+			Description: "create slug indexes",
+			Up: func(ctx context.Context) error {
+				return ensureIndexes(ctx, db, slugIndexes)
+			},
+			Down: func(ctx context.Context) error {
+				return dropIndexes(ctx, db, slugIndexes)
+			},
+		},
+		{
+			Version:     4,
+			Description: "scope runners and compares to namespaces",
+			Up: func(ctx context.Context) error {
+				for _, name := range []string{"runners", "compares"} {
+					err := backfillNamespace(ctx, db.Collection(name))
+					if err != nil {
+						return err
+					}
+				}
+
+				err := ensureIndexes(ctx, db, namespaceIndexes)
+				if err != nil {
+					return err
+				}
+				return dropIndexes(ctx, db, nameIndexes())
+			},
+			// Fails while a name is used in several namespaces.
+			Down: func(ctx context.Context) error {
+				err := ensureIndexes(ctx, db, nameIndexes())
+				if err != nil {
+					return err
+				}
+				return dropIndexes(ctx, db, namespaceIndexes)
+			},
+		},
+	}
+}
+
+// nameIndexes returns the indexes keeping names unique across namespaces.
+func nameIndexes() []index {
+	var indexes []index
+	for _, idx := range initialIndexes {
+		if idx.unique && idx.keys[0].Key == "name" {
+			indexes = append(indexes, idx)
+		}
+	}
+	return indexes
+}
+
+// backfillNamespace moves the documents created before namespaces existed to
+// the global namespace.
+func backfillNamespace(ctx context.Context, col *mongo.Collection) error {
+	filter := bson.D{{Key: "namespace", Value: bson.D{{Key: "$exists", Value: false}}}}
+	_, err := col.UpdateMany(ctx, filter, bson.D{{Key: "$set", Value: bson.D{{Key: "namespace", Value: ""}}}})
+	if err != nil {
+		return fmt.Errorf("Cannot backfill namespaces of %s : %v", col.Name(), err)
+	}
+	return nil
+}
+
+// backfillCreatedAt sets the creation and update times of the documents
+// created before they were tracked to the time in their UUIDv7 ID.
+func backfillCreatedAt(ctx context.Context, col *mongo.Collection) error {
+	filter := bson.D{{Key: "created_at", Value: bson.D{{Key: "$exists", Value: false}}}}
+	cursor, err := col.Find(ctx, filter, options.Find().SetProjection(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []struct {
+		ID string `bson:"_id"`
+	}
+	err = cursor.All(ctx, &docs)
+	if err != nil {
+		return err
+	}
+
+	for _, doc := range docs {
+		createdAt := time.Now().UTC()
+		if id, err := uuid.Parse(doc.ID); err == nil && id.Version() == 7 {
+			createdAt = time.Unix(id.Time().UnixTime()).UTC()
+		}
+
+		_, err := col.UpdateOne(ctx, bson.D{{Key: "_id", Value: doc.ID}}, bson.D{{Key: "$set", Value: bson.D{
+			{Key: "created_at", Value: createdAt},
+			{Key: "updated_at", Value: createdAt},
+		}}})
+		if err != nil {
+			return fmt.Errorf("Cannot backfill %s %s : %v", col.Name(), doc.ID, err)
+		}
+	}
+	return nil
+}
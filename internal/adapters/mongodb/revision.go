@@ -0,0 +1,133 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// revisionPollInterval is how often Wait looks for new revisions when change
+// streams are not available, e.g. on a standalone server.
+const revisionPollInterval = time.Second
+
+type revisionRepo struct {
+	col *mongo.Collection
+	// polling is set once a change stream could not be opened.
+	polling atomic.Bool
+}
+
+func NewRevisionRepo(db *mongo.Database) repositories.RevisionRepository {
+	return &revisionRepo{
+		col: db.Collection("config_revisions"),
+	}
+}
+
+func (r *revisionRepo) Create(ctx context.Context, revision *models.Revision) error {
+	_, err := r.col.InsertOne(ctx, revision)
+	if mongo.IsDuplicateKeyError(err) {
+		return cerrors.New(cerrors.DUPLICATE_DATA)
+	}
+	if err != nil {
+		return fmt.Errorf("Cannot create revision : %v", err)
+	}
+	return nil
+}
+
+func (r *revisionRepo) Latest(ctx context.Context) (int64, error) {
+	opts := options.FindOne().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetProjection(bson.D{{Key: "_id", Value: 1}})
+
+	var revision models.Revision
+	err := r.col.FindOne(ctx, bson.D{}, opts).Decode(&revision)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("Cannot get latest revision : %v", err)
+	}
+	return revision.Number, nil
+}
+
+func (r *revisionRepo) GetSince(ctx context.Context, since int64, limit int) ([]models.Revision, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.col.Find(ctx, bson.D{{Key: "_id", Value: bson.D{{Key: "$gt", Value: since}}}}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot get revisions : %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	revisions := []models.Revision{}
+	err = cursor.All(ctx, &revisions)
+	if err != nil {
+		return nil, cerrors.New(cerrors.CANNOT_GET_DATA)
+	}
+	return revisions, nil
+}
+
+// Wait uses a change stream of the collection, falling back to polling when
+// the server doesn't support them.
+func (r *revisionRepo) Wait(ctx context.Context, since int64) error {
+	if !r.polling.Load() {
+		pipeline := mongo.Pipeline{{{Key: "$match", Value: bson.D{{Key: "operationType", Value: "insert"}}}}}
+		stream, err := r.col.Watch(ctx, pipeline)
+		if err == nil {
+			defer stream.Close(context.WithoutCancel(ctx))
+			return r.waitStream(ctx, stream, since)
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		r.polling.Store(true)
+	}
+
+	ticker := time.NewTicker(revisionPollInterval)
+	defer ticker.Stop()
+	for {
+		latest, err := r.Latest(ctx)
+		if err != nil {
+			return err
+		}
+		if latest > since {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (r *revisionRepo) waitStream(ctx context.Context, stream *mongo.ChangeStream, since int64) error {
+	// A revision created before the stream got opened would be missed.
+	latest, err := r.Latest(ctx)
+	if err != nil {
+		return err
+	}
+	if latest > since {
+		return nil
+	}
+
+	if stream.Next(ctx) {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return fmt.Errorf("Cannot watch revisions : %v", stream.Err())
+}
@@ -19,9 +19,24 @@ type runnerRepo struct {
 }
 
 type runnerDoc struct {
-	ID          string `bson:"_id"`
-	Name        string `bson:"name"`
-	Description string `bson:"description"`
+	ID          string            `bson:"_id"`
+	Name        string            `bson:"name"`
+	Slug        string            `bson:"slug,omitempty"`
+	Aliases     []string          `bson:"aliases,omitempty"`
+	Description string            `bson:"description"`
+	ParentID    string            `bson:"parent_id,omitempty"`
+	Variables   map[string]string `bson:"variables,omitempty"`
+	Env         []models.EnvVar   `bson:"env,omitempty"`
+
+	DefaultLimitProfileID string                   `bson:"default_limit_profile_id,omitempty"`
+	LimitMultipliers      *models.LimitMultipliers `bson:"limit_multipliers,omitempty"`
+	Metadata              *models.RunnerMetadata   `bson:"metadata,omitempty"`
+
+	StarterTemplate string `bson:"starter_template,omitempty"`
+	EntryPoint      string `bson:"entry_point,omitempty"`
+
+	Namespace    string `bson:"namespace"`
+	models.Audit `bson:",inline"`
 }
 
 func NewRunnerRepo(db *mongo.Database) repositories.RunnerRepository {
@@ -34,21 +49,35 @@ func (l *runnerRepo) Create(ctx context.Context, ID string, body *requests.Creat
 	runner := &runnerDoc{
 		ID:          ID,
 		Name:        body.Name,
+		Slug:        body.Slug,
+		Aliases:     body.Aliases,
 		Description: body.Description,
+		ParentID:    body.ParentID,
+		Variables:   body.Variables,
+		Env:         body.Env,
+
+		DefaultLimitProfileID: body.DefaultLimitProfileID,
+		LimitMultipliers:      body.LimitMultipliers,
+		Metadata:              body.Metadata,
+
+		StarterTemplate: body.StarterTemplate,
+		EntryPoint:      body.EntryPoint,
+
+		Namespace: body.Namespace,
+		Audit:     body.Audit,
 	}
 	_, err := l.col.InsertOne(ctx, runner)
+	if conflict := nameConflict(ctx, l.col, "runner", body.Namespace, body.Name, err); conflict != nil {
+		return conflict
+	}
 	if err != nil {
-		var mongoErr mongo.WriteException
-		if errors.As(err, &mongoErr) {
-			return cerrors.New(cerrors.DUPLICATE_DATA)
-		}
-		return cerrors.New(cerrors.UNKNOWN_ERROR)
+		return fmt.Errorf("Cannot create runner : %v", err)
 	}
 	return nil
 }
 
 func (l *runnerRepo) GetAll(ctx context.Context) ([]models.Runner, error) {
-	cursor, err := l.col.Find(ctx, bson.D{})
+	cursor, err := l.col.Find(ctx, visibleFilter(ctx, bson.D{}))
 	if err != nil {
 		return nil, fmt.Errorf("Cannot get runners : %v", err)
 	}
@@ -64,32 +93,12 @@ func (l *runnerRepo) GetAll(ctx context.Context) ([]models.Runner, error) {
 }
 
 func (l *runnerRepo) GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.Runner, error) {
-	orderMap := map[string]int{
-		"desc": -1,
-		"asc":  1,
-	}
-
-	order, ok := orderMap[req.SortOrder]
-	if !ok {
-		order = -1
-	}
-
-	filter := bson.D{}
-	if req.Search != "" {
-		filter = bson.D{
-			{Key: "name", Value: bson.D{
-				{Key: "$regex", Value: req.Search},
-				{Key: "$options", Value: "i"},
-			}},
-		}
-	}
-
 	opts := options.Find().
 		SetSkip(int64((req.Page - 1) * req.PageSize)).
 		SetLimit(int64(req.PageSize)).
-		SetSort(bson.D{{Key: "name", Value: order}})
+		SetSort(auditedSort(req))
 
-	cursor, err := l.col.Find(ctx, filter, opts)
+	cursor, err := l.col.Find(ctx, visibleFilter(ctx, runnerFilter(req)), opts)
 	if err != nil {
 		return nil, err
 	}
@@ -104,8 +113,24 @@ func (l *runnerRepo) GetPagination(ctx context.Context, req *requests.GetPaginat
 	return runners, nil
 }
 
-func (l *runnerRepo) Count(ctx context.Context) (int, error) {
-	count, err := l.col.CountDocuments(ctx, bson.D{})
+// runnerFilter matches the runners having the audit and metadata asked by
+// req.
+func runnerFilter(req *requests.GetPagination) bson.D {
+	filter := auditedFilter(req)
+	if req.Language != "" {
+		filter = append(filter, bson.E{Key: "metadata.language", Value: req.Language})
+	}
+	if len(req.Tags) > 0 {
+		filter = append(filter, bson.E{Key: "metadata.tags", Value: bson.D{{Key: "$all", Value: req.Tags}}})
+	}
+	if req.Extension != "" {
+		filter = append(filter, bson.E{Key: "metadata.extensions", Value: req.Extension})
+	}
+	return filter
+}
+
+func (l *runnerRepo) Count(ctx context.Context, req *requests.GetPagination) (int, error) {
+	count, err := l.col.CountDocuments(ctx, visibleFilter(ctx, runnerFilter(req)))
 	if err != nil {
 		return 0, err
 	}
@@ -115,25 +140,87 @@ func (l *runnerRepo) Count(ctx context.Context) (int, error) {
 
 func (l *runnerRepo) GetByID(ctx context.Context, ID string) (*models.Runner, error) {
 	var _runner models.Runner
-	err := l.col.FindOne(ctx, bson.M{"_id": ID}).Decode(&_runner)
+	err := l.col.FindOne(ctx, visibleFilter(ctx, bson.D{{Key: "_id", Value: ID}})).Decode(&_runner)
 	if err != nil {
 		return nil, err
 	}
 	return &_runner, nil
 }
 
+func (l *runnerRepo) GetBySlug(ctx context.Context, slug string) (*models.Runner, error) {
+	var runner models.Runner
+	err := l.col.FindOne(ctx, visibleFilter(ctx, slugFilter(slug))).Decode(&runner)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("%w: runner %s not found", cerrors.New(cerrors.CANNOT_GET_DATA), slug)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Cannot get runner : %v", err)
+	}
+	return &runner, nil
+}
+
+func (l *runnerRepo) GetByParentID(ctx context.Context, parentID string) ([]models.Runner, error) {
+	cursor, err := l.col.Find(ctx, bson.M{"parent_id": parentID})
+	if err != nil {
+		return nil, fmt.Errorf("Cannot get runners : %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	var runners []models.Runner
+	err = cursor.All(ctx, &runners)
+	if err != nil {
+		return nil, cerrors.New(cerrors.CANNOT_GET_DATA)
+	}
+
+	return runners, nil
+}
+
+func (l *runnerRepo) GetByFile(ctx context.Context, sha256 string, sharedFileID string) ([]models.Runner, error) {
+	filter := fileFilter("initial_files", sha256, sharedFileID)
+	if filter == nil {
+		return nil, nil
+	}
+
+	cursor, err := l.col.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot get runners : %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	var runners []models.Runner
+	err = cursor.All(ctx, &runners)
+	if err != nil {
+		return nil, cerrors.New(cerrors.CANNOT_GET_DATA)
+	}
+
+	return runners, nil
+}
+
 func (l *runnerRepo) UpdateByID(ctx context.Context, ID string, body *requests.UpdateRunner) error {
 	updatedFields := getUpdatedFields(body)
-	_, err := l.col.UpdateOne(ctx, bson.M{"_id": ID}, bson.D{{"$set", updatedFields}})
+	result, err := l.col.UpdateOne(ctx, ownedFilter(ctx, bson.D{{Key: "_id", Value: ID}}), bson.D{{"$set", updatedFields}})
+	if body.Name != nil || body.Namespace != nil {
+		if conflict := updateConflict(ctx, l.col, "runner", ID, body.Name, body.Namespace, err); conflict != nil {
+			return conflict
+		}
+	}
 	if err != nil {
-		fmt.Printf("%T", err)
 		return err
 	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("%w: runner %s not found", cerrors.New(cerrors.CANNOT_GET_DATA), ID)
+	}
 
 	return nil
 }
 
 func (l *runnerRepo) DeleteByID(ctx context.Context, ID string) error {
-	_, err := l.col.DeleteOne(ctx, bson.M{"_id": ID})
-	return err
+	result, err := l.col.DeleteOne(ctx, ownedFilter(ctx, bson.D{{Key: "_id", Value: ID}}))
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("%w: runner %s not found", cerrors.New(cerrors.CANNOT_GET_DATA), ID)
+	}
+	return nil
 }
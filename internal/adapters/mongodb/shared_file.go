@@ -0,0 +1,134 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type sharedFileRepo struct {
+	col *mongo.Collection
+}
+
+type sharedFileDoc struct {
+	ID          string      `bson:"_id"`
+	Name        string      `bson:"name"`
+	Description string      `bson:"description"`
+	File        models.File `bson:"file"`
+}
+
+func NewSharedFileRepo(db *mongo.Database) repositories.SharedFileRepository {
+	return &sharedFileRepo{
+		col: db.Collection("shared_files"),
+	}
+}
+
+func (s *sharedFileRepo) Create(ctx context.Context, ID string, body *requests.CreateSharedFile) error {
+	file := &sharedFileDoc{
+		ID:          ID,
+		Name:        body.Name,
+		Description: body.Description,
+		File:        body.File,
+	}
+	_, err := s.col.InsertOne(ctx, file)
+	if mongo.IsDuplicateKeyError(err) {
+		return cerrors.New(cerrors.DUPLICATE_DATA)
+	}
+	if err != nil {
+		return fmt.Errorf("Cannot create shared file : %v", err)
+	}
+	return nil
+}
+
+func (s *sharedFileRepo) GetAll(ctx context.Context) ([]models.SharedFile, error) {
+	cursor, err := s.col.Find(ctx, bson.D{})
+	if err != nil {
+		return nil, fmt.Errorf("Cannot get shared files : %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	var files []models.SharedFile
+	err = cursor.All(ctx, &files)
+	if err != nil {
+		return nil, cerrors.New(cerrors.CANNOT_GET_DATA)
+	}
+
+	return files, nil
+}
+
+func (s *sharedFileRepo) GetPagination(ctx context.Context, req *requests.GetPagination) ([]models.SharedFile, error) {
+	orderMap := map[string]int{
+		"desc": -1,
+		"asc":  1,
+	}
+
+	order, ok := orderMap[req.SortOrder]
+	if !ok {
+		order = -1
+	}
+
+	filter := bson.D{}
+	if req.Search != "" {
+		filter = bson.D{
+			{Key: "name", Value: bson.D{
+				{Key: "$regex", Value: req.Search},
+				{Key: "$options", Value: "i"},
+			}},
+		}
+	}
+
+	opts := options.Find().
+		SetSkip(int64((req.Page - 1) * req.PageSize)).
+		SetLimit(int64(req.PageSize)).
+		SetSort(bson.D{{Key: "name", Value: order}})
+
+	cursor, err := s.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var files []models.SharedFile
+	err = cursor.All(ctx, &files)
+	if err != nil {
+		return nil, cerrors.New(cerrors.CANNOT_GET_DATA)
+	}
+
+	return files, nil
+}
+
+func (s *sharedFileRepo) Count(ctx context.Context) (int, error) {
+	count, err := s.col.CountDocuments(ctx, bson.D{})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+func (s *sharedFileRepo) GetByID(ctx context.Context, ID string) (*models.SharedFile, error) {
+	var file models.SharedFile
+	err := s.col.FindOne(ctx, bson.M{"_id": ID}).Decode(&file)
+	if err != nil {
+		return nil, cerrors.New(cerrors.CANNOT_GET_DATA)
+	}
+	return &file, nil
+}
+
+func (s *sharedFileRepo) UpdateByID(ctx context.Context, ID string, body *requests.UpdateSharedFile) error {
+	updatedFields := getUpdatedFields(body)
+	_, err := s.col.UpdateOne(ctx, bson.M{"_id": ID}, bson.D{{Key: "$set", Value: updatedFields}})
+	return err
+}
+
+func (s *sharedFileRepo) DeleteByID(ctx context.Context, ID string) error {
+	_, err := s.col.DeleteOne(ctx, bson.M{"_id": ID})
+	return err
+}
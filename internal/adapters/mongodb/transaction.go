@@ -0,0 +1,34 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+type transactor struct {
+	client *mongo.Client
+}
+
+// NewTransactor runs transactions in client sessions, which needs a replica
+// set or a sharded cluster.
+func NewTransactor(client *mongo.Client) repositories.Transactor {
+	return &transactor{
+		client: client,
+	}
+}
+
+func (t *transactor) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	session, err := t.client.StartSession()
+	if err != nil {
+		return fmt.Errorf("Cannot start session : %v", err)
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(ctx context.Context) (any, error) {
+		return nil, fn(ctx)
+	})
+	return err
+}
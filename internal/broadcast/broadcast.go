@@ -0,0 +1,272 @@
+package broadcast
+
+import (
+	"context"
+	"log"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	graderPB "github.com/CSKU-Lab/config-server/genproto/grader/v1"
+	"google.golang.org/grpc"
+)
+
+// Options tune how graders are told about changes. Zero values use the
+// defaults.
+type Options struct {
+	// Attempts is the number of tries per grader and broadcast.
+	Attempts int
+	// Backoff is the delay before the first retry, doubled for every retry.
+	Backoff time.Duration
+	// Timeout bounds a single try.
+	Timeout time.Duration
+	// ResyncInterval is how often graders behind the latest revision are told
+	// again, including graders discovered since the last broadcast.
+	ResyncInterval time.Duration
+	DialOptions    []grpc.DialOption
+}
+
+func (o *Options) withDefaults() Options {
+	opts := *o
+	if opts.Attempts <= 0 {
+		opts.Attempts = 3
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = 200 * time.Millisecond
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 5 * time.Second
+	}
+	if opts.ResyncInterval <= 0 {
+		opts.ResyncInterval = 30 * time.Second
+	}
+	return opts
+}
+
+// GraderStatus is what is known of a grader.
+type GraderStatus struct {
+	Address string
+	// AckedRevision is the latest revision the grader acknowledged.
+	AckedRevision int64
+	LastAck       time.Time
+	LastAttempt   time.Time
+	LastError     string
+	// Failures counts the broadcasts that failed in a row.
+	Failures int
+}
+
+// Broadcaster tells every grader to refetch the configuration, and keeps
+// track of the latest revision each of them acknowledged. Broadcasts happen
+// in the background, so a grader being down never fails a mutation.
+type Broadcaster struct {
+	resolver Resolver
+	latest   func(ctx context.Context) (int64, error)
+	opts     Options
+
+	notify chan struct{}
+
+	mu      sync.Mutex
+	graders map[string]*GraderStatus
+	conns   map[string]*grpc.ClientConn
+}
+
+// New creates a broadcaster sending the revision returned by latest to the
+// graders found by resolver. It does nothing until Run is called.
+func New(resolver Resolver, latest func(ctx context.Context) (int64, error), opts Options) *Broadcaster {
+	return &Broadcaster{
+		resolver: resolver,
+		latest:   latest,
+		opts:     opts.withDefaults(),
+		notify:   make(chan struct{}, 1),
+		graders:  map[string]*GraderStatus{},
+		conns:    map[string]*grpc.ClientConn{},
+	}
+}
+
+// Notify schedules a broadcast of the latest revision and returns right away.
+// Notifications made while a broadcast is running are sent together once it
+// is done.
+func (b *Broadcaster) Notify() {
+	select {
+	case b.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Run broadcasts on every notification, and tells lagging graders again from
+// time to time, until ctx is done.
+func (b *Broadcaster) Run(ctx context.Context) {
+	ticker := time.NewTicker(b.opts.ResyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-b.notify:
+			b.broadcast(ctx, false)
+		case <-ticker.C:
+			b.broadcast(ctx, true)
+		}
+	}
+}
+
+// Status returns the graders sorted by address, and the latest revision.
+func (b *Broadcaster) Status(ctx context.Context) ([]GraderStatus, int64, error) {
+	revision, err := b.latest(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	graders := make([]GraderStatus, 0, len(b.graders))
+	for _, addr := range slices.Sorted(maps.Keys(b.graders)) {
+		graders = append(graders, *b.graders[addr])
+	}
+	return graders, revision, nil
+}
+
+// Close closes the connections to the graders.
+func (b *Broadcaster) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for addr, conn := range b.conns {
+		conn.Close()
+		delete(b.conns, addr)
+	}
+}
+
+// broadcast sends the latest revision to every grader, or only to the ones
+// behind it when laggingOnly is set.
+func (b *Broadcaster) broadcast(ctx context.Context, laggingOnly bool) {
+	revision, err := b.latest(ctx)
+	if err != nil {
+		log.Printf("[Broadcast] cannot get the latest revision: %v", err)
+		return
+	}
+
+	addrs, err := b.resolver.Resolve(ctx)
+	if err != nil {
+		log.Printf("[Broadcast] cannot resolve graders, using the known ones: %v", err)
+		b.mu.Lock()
+		addrs = slices.Collect(maps.Keys(b.graders))
+		b.mu.Unlock()
+	}
+	b.track(addrs)
+
+	var wg sync.WaitGroup
+	for _, addr := range addrs {
+		if laggingOnly && !b.isLagging(addr, revision) {
+			continue
+		}
+
+		wg.Go(func() {
+			err := b.send(ctx, addr, revision)
+			b.record(addr, revision, err)
+			if err != nil && ctx.Err() == nil {
+				log.Printf("[Broadcast] grader %s did not acknowledge revision %d: %v", addr, revision, err)
+			}
+		})
+	}
+	wg.Wait()
+}
+
+// track adds the graders that appeared and forgets the ones that are gone.
+func (b *Broadcaster) track(addrs []string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, addr := range addrs {
+		if _, ok := b.graders[addr]; !ok {
+			b.graders[addr] = &GraderStatus{Address: addr}
+		}
+	}
+	for addr := range b.graders {
+		if slices.Contains(addrs, addr) {
+			continue
+		}
+		delete(b.graders, addr)
+		if conn, ok := b.conns[addr]; ok {
+			conn.Close()
+			delete(b.conns, addr)
+		}
+	}
+}
+
+func (b *Broadcaster) isLagging(addr string, revision int64) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	grader, ok := b.graders[addr]
+	return !ok || grader.AckedRevision < revision
+}
+
+func (b *Broadcaster) record(addr string, revision int64, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	grader, ok := b.graders[addr]
+	if !ok {
+		return
+	}
+
+	grader.LastAttempt = time.Now().UTC()
+	if err != nil {
+		grader.LastError = err.Error()
+		grader.Failures++
+		return
+	}
+	grader.LastAck = grader.LastAttempt
+	grader.LastError = ""
+	grader.Failures = 0
+	grader.AckedRevision = max(grader.AckedRevision, revision)
+}
+
+// send tells a grader about the revision, retrying with a growing delay.
+func (b *Broadcaster) send(ctx context.Context, addr string, revision int64) error {
+	client, err := b.client(addr)
+	if err != nil {
+		return err
+	}
+
+	req := &graderPB.BroadcastRequest{
+		Action:   graderPB.BroadcastAction_REFETCH_CONFIG,
+		Revision: revision,
+	}
+	backoff := b.opts.Backoff
+	for attempt := 1; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, b.opts.Timeout)
+		_, err = client.Broadcast(attemptCtx, req)
+		cancel()
+		if err == nil || attempt == b.opts.Attempts {
+			return err
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		backoff *= 2
+	}
+}
+
+func (b *Broadcaster) client(addr string) (graderPB.GraderServiceClient, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	conn, ok := b.conns[addr]
+	if !ok {
+		var err error
+		conn, err = grpc.NewClient(addr, b.opts.DialOptions...)
+		if err != nil {
+			return nil, err
+		}
+		b.conns[addr] = conn
+	}
+	return graderPB.NewGraderServiceClient(conn), nil
+}
@@ -0,0 +1,161 @@
+package broadcast
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrUnknownBatch = errors.New("unknown or expired batch")
+
+// PublisherOptions tune how changes are grouped. Zero values use the
+// defaults.
+type PublisherOptions struct {
+	// Window is how long to wait for more changes before broadcasting.
+	Window time.Duration
+	// MaxDelay bounds how long changes made in a row wait for a broadcast.
+	MaxDelay time.Duration
+	// BatchTimeout is how long a batch stays open without being committed.
+	BatchTimeout time.Duration
+}
+
+func (o *PublisherOptions) withDefaults() PublisherOptions {
+	opts := *o
+	if opts.Window <= 0 {
+		opts.Window = 500 * time.Millisecond
+	}
+	if opts.MaxDelay <= 0 {
+		opts.MaxDelay = 5 * time.Second
+	}
+	if opts.BatchTimeout <= 0 {
+		opts.BatchTimeout = 5 * time.Minute
+	}
+	return opts
+}
+
+// Publisher groups changes into as few broadcasts as possible. Changes made
+// within the window of each other are broadcast once, and nothing is
+// broadcast while a batch is open, so a bulk edit ends with a single
+// broadcast once its batch is committed.
+type Publisher struct {
+	broadcaster *Broadcaster
+	opts        PublisherOptions
+
+	mu sync.Mutex
+	// since is when the first change waiting for a broadcast was made, zero
+	// without any.
+	since   time.Time
+	timer   *time.Timer
+	batches map[string]time.Time
+}
+
+func NewPublisher(broadcaster *Broadcaster, opts PublisherOptions) *Publisher {
+	return &Publisher{
+		broadcaster: broadcaster,
+		opts:        opts.withDefaults(),
+		batches:     map[string]time.Time{},
+	}
+}
+
+// Publish tells that the configuration changed.
+func (p *Publisher) Publish() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.since.IsZero() {
+		p.since = time.Now()
+	}
+	p.schedule(p.opts.Window)
+}
+
+// BeginBatch holds every broadcast until the returned batch is committed or
+// expires.
+func (p *Publisher) BeginBatch() (string, time.Time, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	expiry := time.Now().Add(p.opts.BatchTimeout)
+	p.batches[id.String()] = expiry
+	p.schedule(p.opts.Window)
+	return id.String(), expiry, nil
+}
+
+// CommitBatch closes the batch. Once no batch is open, the changes made
+// meanwhile are broadcast right away.
+func (p *Publisher) CommitBatch(ID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.expire()
+	if _, ok := p.batches[ID]; !ok {
+		return ErrUnknownBatch
+	}
+	delete(p.batches, ID)
+
+	p.schedule(0)
+	return nil
+}
+
+// schedule arms the timer for the next broadcast, in delay unless batches
+// are open or changes already waited for too long. The caller holds mu.
+func (p *Publisher) schedule(delay time.Duration) {
+	if len(p.batches) > 0 {
+		// Wake up when the first batch expires.
+		var next time.Time
+		for _, expiry := range p.batches {
+			if next.IsZero() || expiry.Before(next) {
+				next = expiry
+			}
+		}
+		delay = time.Until(next)
+	} else if !p.since.IsZero() {
+		delay = min(delay, time.Until(p.since.Add(p.opts.MaxDelay)))
+	} else {
+		return
+	}
+
+	delay = max(delay, 0)
+	if p.timer == nil {
+		p.timer = time.AfterFunc(delay, p.fire)
+		return
+	}
+	p.timer.Reset(delay)
+}
+
+func (p *Publisher) fire() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.expire()
+	if len(p.batches) > 0 {
+		p.schedule(0)
+		return
+	}
+	p.publish()
+}
+
+// expire drops the batches open for too long. The caller holds mu.
+func (p *Publisher) expire() {
+	now := time.Now()
+	for ID, expiry := range p.batches {
+		if !expiry.After(now) {
+			delete(p.batches, ID)
+		}
+	}
+}
+
+// publish broadcasts the pending changes. The caller holds mu.
+func (p *Publisher) publish() {
+	if p.since.IsZero() {
+		return
+	}
+	p.since = time.Time{}
+	p.broadcaster.Notify()
+}
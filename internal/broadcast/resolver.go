@@ -0,0 +1,85 @@
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const srvPrefix = "srv:"
+
+// Resolver lists the addresses of the graders.
+type Resolver interface {
+	Resolve(ctx context.Context) ([]string, error)
+}
+
+type resolver struct {
+	static []string
+	srv    []string
+	lookup func(ctx context.Context, service string, proto string, name string) (string, []*net.SRV, error)
+}
+
+// NewResolver parses a comma separated list of grader endpoints. An endpoint
+// is either a host:port address, or "srv:" followed by the name of a DNS SRV
+// record, e.g. srv:_grpc._tcp.grader.default.svc.cluster.local, looked up on
+// every broadcast.
+func NewResolver(spec string) (Resolver, error) {
+	r := &resolver{
+		lookup: net.DefaultResolver.LookupSRV,
+	}
+	for endpoint := range strings.SplitSeq(spec, ",") {
+		endpoint = strings.TrimSpace(endpoint)
+		if endpoint == "" {
+			continue
+		}
+
+		if name, ok := strings.CutPrefix(endpoint, srvPrefix); ok {
+			if name == "" {
+				return nil, fmt.Errorf("invalid grader endpoint %q, expected srv:name", endpoint)
+			}
+			r.srv = append(r.srv, name)
+			continue
+		}
+
+		_, _, err := net.SplitHostPort(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid grader endpoint %q: %w", endpoint, err)
+		}
+		r.static = append(r.static, endpoint)
+	}
+
+	if len(r.static) == 0 && len(r.srv) == 0 {
+		return nil, errors.New("no grader endpoint")
+	}
+	return r, nil
+}
+
+// Resolve returns the sorted addresses of the graders. A failed lookup is
+// only an error when no address is found at all.
+func (r *resolver) Resolve(ctx context.Context) ([]string, error) {
+	addrs := slices.Clone(r.static)
+
+	var errs []error
+	for _, name := range r.srv {
+		_, records, err := r.lookup(ctx, "", "", name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, record := range records {
+			host := strings.TrimSuffix(record.Target, ".")
+			addrs = append(addrs, net.JoinHostPort(host, strconv.Itoa(int(record.Port))))
+		}
+	}
+
+	if len(addrs) == 0 && len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	slices.Sort(addrs)
+	return slices.Compact(addrs), nil
+}
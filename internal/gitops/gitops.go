@@ -0,0 +1,528 @@
+package gitops
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/namespaces"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+	"github.com/CSKU-Lab/config-server/domain/services"
+	"github.com/CSKU-Lab/config-server/internal/manifest"
+)
+
+type Action string
+
+const (
+	ActionCreate    Action = "create"
+	ActionUpdate    Action = "update"
+	ActionDelete    Action = "delete"
+	ActionUnchanged Action = "unchanged"
+)
+
+type Change struct {
+	Action Action
+	Kind   manifest.Kind
+	ID     string
+	// Namespace and Name identify the runner or compare, names are only
+	// unique within a namespace.
+	Namespace string
+	Name      string
+	// Paths lists the manifest paths that differ from what is stored.
+	Paths []string
+
+	runner  *models.Runner
+	compare *models.Compare
+	// generated tells the compare is generated from a preset, its scripts and
+	// files are left as they are.
+	generated bool
+	// parent is the qualified name of a runner created by the same plan, its
+	// ID is only known once that runner is applied.
+	parent string
+}
+
+// Compare returns the desired compare of a create or update.
+func (c *Change) Compare() *models.Compare {
+	return c.compare
+}
+
+// Plan is the ordered list of changes bringing the stored runners and
+// compares to the ones of a directory.
+type Plan struct {
+	Changes []Change
+}
+
+func (p *Plan) HasChanges() bool {
+	return slices.ContainsFunc(p.Changes, func(c Change) bool { return c.Action != ActionUnchanged })
+}
+
+type Syncer struct {
+	runners  services.RunnerService
+	compares services.CompareService
+	files    services.FileService
+}
+
+func NewSyncer(runners services.RunnerService, compares services.CompareService, files services.FileService) *Syncer {
+	return &Syncer{
+		runners:  runners,
+		compares: compares,
+		files:    files,
+	}
+}
+
+// Split returns the tree of every directory holding a manifest, keyed by the
+// directory. Manifests nested inside another definition are treated as
+// plain files of it.
+func Split(tree manifest.Tree) map[string]manifest.Tree {
+	var dirs []string
+	for p := range tree {
+		if p == manifest.ManifestName || strings.HasSuffix(p, "/"+manifest.ManifestName) {
+			dirs = append(dirs, strings.TrimSuffix(p, manifest.ManifestName))
+		}
+	}
+	slices.SortFunc(dirs, func(a, b string) int { return len(a) - len(b) })
+
+	defs := map[string]manifest.Tree{}
+	for _, dir := range dirs {
+		if slices.ContainsFunc(slices.Collect(maps.Keys(defs)), func(parent string) bool { return strings.HasPrefix(dir, parent) }) {
+			continue
+		}
+		defs[dir] = manifest.Tree{}
+	}
+
+	for p, entry := range tree {
+		for dir, def := range defs {
+			if rel, ok := strings.CutPrefix(p, dir); ok {
+				def[rel] = entry
+				break
+			}
+		}
+	}
+
+	named := make(map[string]manifest.Tree, len(defs))
+	for dir, def := range defs {
+		named[path.Clean("/" + dir)[1:]] = def
+	}
+	return named
+}
+
+// Plan diffs the definitions of the tree against the stored runners and
+// compares. Definitions are matched by their id when it exists, by namespace
+// and name otherwise. With prune, anything stored but not defined is deleted.
+func (s *Syncer) Plan(ctx context.Context, tree manifest.Tree, prune bool) (*Plan, error) {
+	var (
+		runners  []*models.Runner
+		compares []*models.Compare
+	)
+	defs := Split(tree)
+	for _, dir := range slices.Sorted(maps.Keys(defs)) {
+		m, err := manifest.Parse(defs[dir])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", cerrors.New(cerrors.INVALID_DATA), dir, err)
+		}
+
+		switch m.Kind {
+		case manifest.KindRunner:
+			runner, err := m.Runner(defs[dir])
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: %v", cerrors.New(cerrors.INVALID_DATA), dir, err)
+			}
+			runners = append(runners, runner)
+		case manifest.KindCompare:
+			compare, err := m.Compare(defs[dir])
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: %v", cerrors.New(cerrors.INVALID_DATA), dir, err)
+			}
+			compares = append(compares, compare)
+		}
+	}
+
+	runnerChanges, err := s.planRunners(ctx, runners, prune)
+	if err != nil {
+		return nil, err
+	}
+
+	compareChanges, err := s.planCompares(ctx, compares, prune)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deletes go last, compares first and runners children first, so nothing
+	// is deleted while still in use.
+	plan := &Plan{}
+	isDelete := func(c Change) bool { return c.Action == ActionDelete }
+	plan.Changes = append(plan.Changes, slices.DeleteFunc(slices.Clone(runnerChanges), isDelete)...)
+	plan.Changes = append(plan.Changes, slices.DeleteFunc(slices.Clone(compareChanges), isDelete)...)
+	for _, c := range compareChanges {
+		if isDelete(c) {
+			plan.Changes = append(plan.Changes, c)
+		}
+	}
+	var runnerDeletes []Change
+	for _, c := range runnerChanges {
+		if isDelete(c) {
+			runnerDeletes = append(runnerDeletes, c)
+		}
+	}
+	slices.Reverse(runnerDeletes)
+	plan.Changes = append(plan.Changes, runnerDeletes...)
+
+	return plan, nil
+}
+
+func (s *Syncer) planRunners(ctx context.Context, desired []*models.Runner, prune bool) ([]Change, error) {
+	stored, err := s.runners.GetAllRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := matcher(stored, func(r *models.Runner) (string, string) { return r.ID, qualify(r.Namespace, r.Name) })
+	desiredByName := map[string]*models.Runner{}
+	for _, runner := range desired {
+		key := qualify(runner.Namespace, runner.Name)
+		if desiredByName[key] != nil {
+			return nil, fmt.Errorf("%w: runner %q is defined twice in the %s namespace", cerrors.New(cerrors.INVALID_DATA), runner.Name, namespaces.Name(runner.Namespace))
+		}
+		desiredByName[key] = runner
+	}
+
+	var changes []Change
+	matched := map[string]bool{}
+	for _, runner := range sortByParent(desired) {
+		change := Change{Kind: manifest.KindRunner, Namespace: runner.Namespace, Name: runner.Name, runner: runner}
+
+		// Parents may be referred to by the name of a runner of the same
+		// directory, visible from the namespace of the runner.
+		if parent := parentByName(desiredByName, runner); parent != nil && parent != runner {
+			if stored := existing(parent.ID, qualify(parent.Namespace, parent.Name)); stored != nil {
+				runner.ParentID = stored.ID
+			} else {
+				change.parent = qualify(parent.Namespace, parent.Name)
+				runner.ParentID = ""
+			}
+		}
+
+		current := existing(runner.ID, qualify(runner.Namespace, runner.Name))
+		if current == nil {
+			change.Action = ActionCreate
+			changes = append(changes, change)
+			continue
+		}
+		matched[current.ID] = true
+		change.ID = current.ID
+		runner.ID = current.ID
+		keepSlug(&runner.Slug, &runner.Aliases, current.Slug, current.Aliases)
+
+		currentTree, err := manifest.FromRunner(ctx, current, s.files)
+		if err != nil {
+			return nil, err
+		}
+		desiredTree, err := manifest.FromRunner(ctx, runner, s.files)
+		if err != nil {
+			return nil, err
+		}
+
+		change.Paths = manifest.Diff(currentTree, desiredTree)
+		if change.parent != "" && !slices.Contains(change.Paths, manifest.ManifestName) {
+			change.Paths = append([]string{manifest.ManifestName}, change.Paths...)
+		}
+		change.Action = ActionUpdate
+		if len(change.Paths) == 0 {
+			change.Action = ActionUnchanged
+		}
+		changes = append(changes, change)
+	}
+
+	if prune {
+		for _, runner := range sortByParent(pointers(stored)) {
+			if !matched[runner.ID] {
+				changes = append(changes, Change{Action: ActionDelete, Kind: manifest.KindRunner, ID: runner.ID, Namespace: runner.Namespace, Name: runner.Name})
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+func (s *Syncer) planCompares(ctx context.Context, desired []*models.Compare, prune bool) ([]Change, error) {
+	stored, err := s.compares.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := matcher(stored, func(c *models.Compare) (string, string) { return c.ID, qualify(c.Namespace, c.Name) })
+	names := map[string]bool{}
+
+	var changes []Change
+	matched := map[string]bool{}
+	for _, compare := range desired {
+		key := qualify(compare.Namespace, compare.Name)
+		if names[key] {
+			return nil, fmt.Errorf("%w: compare %q is defined twice in the %s namespace", cerrors.New(cerrors.INVALID_DATA), compare.Name, namespaces.Name(compare.Namespace))
+		}
+		names[key] = true
+
+		change := Change{Kind: manifest.KindCompare, Namespace: compare.Namespace, Name: compare.Name, compare: compare}
+		current := existing(compare.ID, key)
+		if current == nil {
+			change.Action = ActionCreate
+			changes = append(changes, change)
+			continue
+		}
+		matched[current.ID] = true
+		change.ID = current.ID
+		compare.ID = current.ID
+		keepSlug(&compare.Slug, &compare.Aliases, current.Slug, current.Aliases)
+
+		currentTree, err := manifest.FromCompare(ctx, current, s.files)
+		if err != nil {
+			return nil, err
+		}
+		desiredTree, err := manifest.FromCompare(ctx, compare, s.files)
+		if err != nil {
+			return nil, err
+		}
+
+		change.Paths = manifest.Diff(currentTree, desiredTree)
+		if current.IsReadOnly() {
+			if compare.RunName != current.RunName || slices.ContainsFunc(change.Paths, func(p string) bool { return p != manifest.ManifestName }) {
+				return nil, fmt.Errorf("%w: compare %q is generated from preset %q, use UpgradeComparePreset instead", cerrors.New(cerrors.READ_ONLY), current.Name, current.PresetID)
+			}
+			change.generated = true
+		}
+		change.Action = ActionUpdate
+		if len(change.Paths) == 0 {
+			change.Action = ActionUnchanged
+		}
+		changes = append(changes, change)
+	}
+
+	if prune {
+		for _, compare := range stored {
+			if !matched[compare.ID] {
+				changes = append(changes, Change{Action: ActionDelete, Kind: manifest.KindCompare, ID: compare.ID, Namespace: compare.Namespace, Name: compare.Name})
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// keepSlug keeps the stored slug when the manifest has none, since slugs are
+// never removed, and the stored aliases, which only the server maintains.
+func keepSlug(slug *string, aliases *[]string, currentSlug string, currentAliases []string) {
+	if *slug == "" {
+		*slug = currentSlug
+	}
+	*aliases = currentAliases
+}
+
+// Apply executes the plan in order and returns the changes it applied, which
+// stop at the first failing one. The IDs of created runners and compares are
+// filled into the plan.
+func (s *Syncer) Apply(ctx context.Context, plan *Plan) ([]Change, error) {
+	var applied []Change
+	created := map[string]string{}
+	for i := range plan.Changes {
+		change := &plan.Changes[i]
+
+		var err error
+		switch {
+		case change.Action == ActionUnchanged:
+		case change.Action == ActionDelete && change.Kind == manifest.KindRunner:
+			err = s.runners.DeleteByID(ctx, change.ID)
+		case change.Action == ActionDelete && change.Kind == manifest.KindCompare:
+			err = s.compares.DeleteByID(ctx, change.ID)
+		case change.Kind == manifest.KindRunner:
+			if change.parent != "" {
+				change.runner.ParentID = created[change.parent]
+			}
+			err = s.applyRunner(ctx, change)
+			created[qualify(change.Namespace, change.Name)] = change.ID
+		case change.Kind == manifest.KindCompare:
+			err = s.applyCompare(ctx, change)
+		}
+		if err != nil {
+			return applied, fmt.Errorf("Cannot %s %s %q : %w", change.Action, change.Kind, change.Name, err)
+		}
+		if change.Action != ActionUnchanged {
+			applied = append(applied, *change)
+		}
+	}
+	return applied, nil
+}
+
+func (s *Syncer) applyRunner(ctx context.Context, change *Change) error {
+	runner := change.runner
+	if change.Action == ActionCreate {
+		ID, err := s.runners.Create(ctx, &requests.CreateRunner{
+			Name:        runner.Name,
+			Slug:        runner.Slug,
+			Description: runner.Description,
+			ParentID:    runner.ParentID,
+			Variables:   runner.Variables,
+			Env:         runner.Env,
+
+			DefaultLimitProfileID: runner.DefaultLimitProfileID,
+			LimitMultipliers:      runner.LimitMultipliers,
+			Metadata:              runner.Metadata,
+
+			Namespace: runner.Namespace,
+		})
+		if err != nil {
+			return err
+		}
+		change.ID = ID
+	}
+
+	err := s.runners.UpdateByID(ctx, change.ID, &requests.UpdateRunner{
+		Name:         &runner.Name,
+		Description:  &runner.Description,
+		BuildScript:  &runner.BuildScript,
+		RunScript:    &runner.RunScript,
+		InitialFiles: runner.InitialFiles,
+		ParentID:     &runner.ParentID,
+		Variables:    runner.Variables,
+		Env:          runner.Env,
+
+		DefaultLimitProfileID: &runner.DefaultLimitProfileID,
+		LimitMultipliers:      runner.LimitMultipliers,
+		Metadata:              runner.Metadata,
+
+		StarterTemplate: &runner.StarterTemplate,
+		EntryPoint:      &runner.EntryPoint,
+	})
+	if err != nil || runner.Slug == "" {
+		return err
+	}
+
+	// Renaming keeps the previous slug as an alias.
+	return s.runners.RenameSlug(ctx, change.ID, runner.Slug)
+}
+
+func (s *Syncer) applyCompare(ctx context.Context, change *Change) error {
+	compare := change.compare
+	if change.Action == ActionCreate {
+		ID, err := s.compares.Create(ctx, &requests.CreateCompare{
+			Name:          compare.Name,
+			Slug:          compare.Slug,
+			Files:         compare.Files,
+			BuildScript:   compare.BuildScript,
+			RunScript:     compare.RunScript,
+			RunName:       compare.RunName,
+			Description:   compare.Description,
+			GoldenCases:   compare.GoldenCases,
+			PresetID:      compare.PresetID,
+			PresetVersion: compare.PresetVersion,
+			PresetParams:  compare.PresetParams,
+			Namespace:     compare.Namespace,
+		})
+		change.ID = ID
+		return err
+	}
+
+	body := &requests.UpdateCompare{
+		Name:        &compare.Name,
+		Description: &compare.Description,
+		GoldenCases: compare.GoldenCases,
+	}
+	if !change.generated {
+		body.Files = compare.Files
+		body.BuildScript = &compare.BuildScript
+		body.RunScript = &compare.RunScript
+		body.RunName = &compare.RunName
+	}
+
+	err := s.compares.UpdateByID(ctx, change.ID, body)
+	if err != nil || compare.Slug == "" {
+		return err
+	}
+
+	return s.compares.RenameSlug(ctx, change.ID, compare.Slug)
+}
+
+// qualify keys a runner or compare by its namespace and name.
+func qualify(namespace string, name string) string {
+	return namespace + "/" + name
+}
+
+// parentByName finds the parent a runner refers to by name among runners keyed
+// by qualify, in the namespace of the runner first, then in the global one.
+func parentByName(byName map[string]*models.Runner, runner *models.Runner) *models.Runner {
+	for _, namespace := range namespaces.Visible(runner.Namespace) {
+		if parent := byName[qualify(namespace, runner.ParentID)]; parent != nil {
+			return parent
+		}
+	}
+	return nil
+}
+
+// matcher finds a stored document by ID first, then by qualified name.
+func matcher[T any](stored []T, key func(doc *T) (string, string)) func(ID string, name string) *T {
+	byID := map[string]*T{}
+	byName := map[string]*T{}
+	for i := range stored {
+		ID, name := key(&stored[i])
+		byID[ID] = &stored[i]
+		byName[name] = &stored[i]
+	}
+
+	return func(ID string, name string) *T {
+		if doc, ok := byID[ID]; ok && ID != "" {
+			return doc
+		}
+		return byName[name]
+	}
+}
+
+// sortByParent orders runners so parents, referred to by ID or by name, come
+// before their children.
+func sortByParent(runners []*models.Runner) []*models.Runner {
+	var (
+		sorted []*models.Runner
+		visit  func(runner *models.Runner, depth int)
+	)
+	done := map[*models.Runner]bool{}
+	byID := map[string]*models.Runner{}
+	byName := map[string]*models.Runner{}
+	for _, runner := range runners {
+		byName[qualify(runner.Namespace, runner.Name)] = runner
+		if runner.ID != "" {
+			byID[runner.ID] = runner
+		}
+	}
+
+	visit = func(runner *models.Runner, depth int) {
+		if done[runner] || depth > len(runners) {
+			return
+		}
+		parent := byID[runner.ParentID]
+		if parent == nil {
+			parent = parentByName(byName, runner)
+		}
+		if parent != nil && parent != runner {
+			visit(parent, depth+1)
+		}
+		if !done[runner] {
+			done[runner] = true
+			sorted = append(sorted, runner)
+		}
+	}
+	for _, runner := range runners {
+		visit(runner, 0)
+	}
+	return sorted
+}
+
+func pointers[T any](docs []T) []*T {
+	ptrs := make([]*T, len(docs))
+	for i := range docs {
+		ptrs[i] = &docs[i]
+	}
+	return ptrs
+}
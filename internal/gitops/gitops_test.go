@@ -0,0 +1,360 @@
+package gitops
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/requests"
+	"github.com/CSKU-Lab/config-server/domain/services"
+	"github.com/CSKU-Lab/config-server/internal/adapters/filesystem"
+	"github.com/CSKU-Lab/config-server/internal/adapters/memory"
+	"github.com/CSKU-Lab/config-server/internal/manifest"
+)
+
+// acceptGoldenCases passes every compare, there is no grader in the tests.
+type acceptGoldenCases struct{}
+
+func (acceptGoldenCases) Verify(ctx context.Context, compare *models.Compare) error {
+	return nil
+}
+
+func newTestSyncer(t *testing.T) *Syncer {
+	t.Helper()
+
+	blobs, err := filesystem.NewBlobRepo(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewBlobRepo: %v", err)
+	}
+
+	runnerRepo := memory.NewRunnerRepo()
+	compareRepo := memory.NewCompareRepo()
+	sharedFileRepo := memory.NewSharedFileRepo()
+	revisions := services.NewRevisionService(memory.NewRevisionRepo(), runnerRepo, compareRepo)
+	files := services.NewFileService(blobs, sharedFileRepo)
+
+	return NewSyncer(
+		services.NewRunnerService(runnerRepo, memory.NewLimitProfileRepo(), files, nil, revisions),
+		services.NewCompareService(compareRepo, files, revisions, acceptGoldenCases{}),
+		files,
+	)
+}
+
+func entry(data string) manifest.Entry {
+	return manifest.Entry{Data: []byte(data), Mode: 0o644}
+}
+
+// definitions is a base runner, a runner inheriting from it by name and a
+// compare.
+func definitions() manifest.Tree {
+	return manifest.Tree{
+		"runners/base/manifest.yaml": entry("kind: runner\nname: base\n"),
+		"runners/base/run.sh":        entry("./main\n"),
+		"runners/cpp/manifest.yaml":  entry("kind: runner\nname: cpp\nslug: cpp\nparent_id: base\n"),
+		"runners/cpp/build.sh":       entry("g++ main.cpp\n"),
+		"compares/exact/manifest.yaml": entry("kind: compare\nname: exact\nrun_name: compare\n" +
+			"golden_cases:\n  - name: same\n    expected_output: a\n    actual_output: a\n    expected_verdict: accepted\n"),
+		"compares/exact/run.sh": entry("diff expected actual\n"),
+	}
+}
+
+type planned struct {
+	Action Action
+	Kind   manifest.Kind
+	Name   string
+	Paths  []string
+}
+
+func summarize(plan *Plan) []planned {
+	var changes []planned
+	for _, c := range plan.Changes {
+		changes = append(changes, planned{Action: c.Action, Kind: c.Kind, Name: c.Name, Paths: c.Paths})
+	}
+	return changes
+}
+
+func planAndApply(t *testing.T, syncer *Syncer, tree manifest.Tree, prune bool) []planned {
+	t.Helper()
+
+	ctx := context.Background()
+	plan, err := syncer.Plan(ctx, tree, prune)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	_, err = syncer.Apply(ctx, plan)
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	return summarize(plan)
+}
+
+func TestSplit(t *testing.T) {
+	tree := manifest.Tree{
+		"README.md":                        entry("not a definition"),
+		"manifest.yaml":                    entry("root"),
+		"runners/cpp/manifest.yaml":        entry("cpp"),
+		"runners/cpp/run.sh":               entry("run"),
+		"runners/cpp/files/manifest.yaml":  entry("nested"),
+		"runners/cpp17/manifest.yaml":      entry("cpp17"),
+		"compares/exact/manifest.yaml":     entry("exact"),
+		"compares/exact/files/expected.in": entry("input"),
+	}
+
+	// The root definition holds every other path.
+	got := Split(tree)
+	if want := []string{""}; !reflect.DeepEqual(slices.Sorted(maps.Keys(got)), want) {
+		t.Fatalf("Split directories = %v, want %v", slices.Sorted(maps.Keys(got)), want)
+	}
+	if !reflect.DeepEqual(got[""], tree) {
+		t.Errorf("Split root = %v, want %v", got[""], tree)
+	}
+
+	delete(tree, "manifest.yaml")
+	got = Split(tree)
+	want := map[string]manifest.Tree{
+		"runners/cpp": {
+			"manifest.yaml":       tree["runners/cpp/manifest.yaml"],
+			"run.sh":              tree["runners/cpp/run.sh"],
+			"files/manifest.yaml": tree["runners/cpp/files/manifest.yaml"],
+		},
+		"runners/cpp17": {
+			"manifest.yaml": tree["runners/cpp17/manifest.yaml"],
+		},
+		"compares/exact": {
+			"manifest.yaml":     tree["compares/exact/manifest.yaml"],
+			"files/expected.in": tree["compares/exact/files/expected.in"],
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Split = %v, want %v", got, want)
+	}
+}
+
+func TestPlan(t *testing.T) {
+	syncer := newTestSyncer(t)
+	tree := definitions()
+
+	// Parents are created before the runners inheriting from them.
+	got := planAndApply(t, syncer, tree, false)
+	want := []planned{
+		{Action: ActionCreate, Kind: manifest.KindRunner, Name: "base"},
+		{Action: ActionCreate, Kind: manifest.KindRunner, Name: "cpp"},
+		{Action: ActionCreate, Kind: manifest.KindCompare, Name: "exact"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("first plan = %v, want %v", got, want)
+	}
+
+	got = planAndApply(t, syncer, tree, false)
+	want = []planned{
+		{Action: ActionUnchanged, Kind: manifest.KindRunner, Name: "base"},
+		{Action: ActionUnchanged, Kind: manifest.KindRunner, Name: "cpp"},
+		{Action: ActionUnchanged, Kind: manifest.KindCompare, Name: "exact"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("plan of the applied tree = %v, want %v", got, want)
+	}
+
+	tree["runners/cpp/build.sh"] = entry("g++ -O2 main.cpp\n")
+	tree["runners/cpp/manifest.yaml"] = entry("kind: runner\nname: cpp\nslug: gcc\nparent_id: base\n")
+	delete(tree, "compares/exact/manifest.yaml")
+	delete(tree, "compares/exact/run.sh")
+
+	// Without prune, the compare missing from the tree is kept.
+	plan, err := syncer.Plan(context.Background(), tree, false)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	want = []planned{
+		{Action: ActionUnchanged, Kind: manifest.KindRunner, Name: "base"},
+		{Action: ActionUpdate, Kind: manifest.KindRunner, Name: "cpp", Paths: []string{"build.sh", manifest.ManifestName}},
+	}
+	if got := summarize(plan); !reflect.DeepEqual(got, want) {
+		t.Fatalf("plan without prune = %v, want %v", got, want)
+	}
+
+	got = planAndApply(t, syncer, tree, true)
+	want = append(want, planned{Action: ActionDelete, Kind: manifest.KindCompare, Name: "exact"})
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("plan with prune = %v, want %v", got, want)
+	}
+
+	// The previous slug is kept as an alias.
+	ID, err := syncer.runners.ResolveID(context.Background(), "cpp")
+	if err != nil {
+		t.Fatalf("ResolveID: %v", err)
+	}
+	runner, err := syncer.runners.GetRawByID(context.Background(), ID)
+	if err != nil {
+		t.Fatalf("GetRawByID: %v", err)
+	}
+	if runner.Slug != "gcc" || !slices.Contains(runner.Aliases, "cpp") {
+		t.Errorf("renamed runner has slug %q and aliases %v, want gcc and [cpp]", runner.Slug, runner.Aliases)
+	}
+
+	got = planAndApply(t, syncer, tree, true)
+	for _, c := range got {
+		if c.Action != ActionUnchanged {
+			t.Errorf("plan after the renaming has %v", c)
+		}
+	}
+}
+
+func TestPlanMatchesWithinNamespaces(t *testing.T) {
+	syncer := newTestSyncer(t)
+	tree := manifest.Tree{
+		"global/base/manifest.yaml":  entry("kind: runner\nname: base\n"),
+		"cs101/base/manifest.yaml":   entry("kind: runner\nname: base\nnamespace: cs101\n"),
+		"cs101/cpp/manifest.yaml":    entry("kind: runner\nname: cpp\nnamespace: cs101\nparent_id: base\n"),
+		"global/exact/manifest.yaml": entry("kind: compare\nname: exact\n"),
+		"cs101/exact/manifest.yaml":  entry("kind: compare\nname: exact\nnamespace: cs101\n"),
+	}
+
+	// The same name in two namespaces defines two runners and two compares.
+	got := planAndApply(t, syncer, tree, true)
+	want := []planned{
+		{Action: ActionCreate, Kind: manifest.KindRunner, Name: "base"},
+		{Action: ActionCreate, Kind: manifest.KindRunner, Name: "cpp"},
+		{Action: ActionCreate, Kind: manifest.KindRunner, Name: "base"},
+		{Action: ActionCreate, Kind: manifest.KindCompare, Name: "exact"},
+		{Action: ActionCreate, Kind: manifest.KindCompare, Name: "exact"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("first plan = %v, want %v", got, want)
+	}
+
+	got = planAndApply(t, syncer, tree, true)
+	for _, c := range got {
+		if c.Action != ActionUnchanged {
+			t.Errorf("plan of the applied tree has %v", c)
+		}
+	}
+
+	// The parent is the runner of the namespace, not the global one.
+	runners, err := syncer.runners.GetAllRaw(context.Background())
+	if err != nil {
+		t.Fatalf("GetAllRaw: %v", err)
+	}
+	byName := map[string]models.Runner{}
+	for _, runner := range runners {
+		byName[qualify(runner.Namespace, runner.Name)] = runner
+	}
+	if parent := byName[qualify("cs101", "cpp")].ParentID; parent != byName[qualify("cs101", "base")].ID {
+		t.Errorf("cpp inherits from %s, want the cs101 base %s", parent, byName[qualify("cs101", "base")].ID)
+	}
+}
+
+func TestApplyStopsAtTheFailedChange(t *testing.T) {
+	ctx := context.Background()
+	syncer := newTestSyncer(t)
+	tree := definitions()
+	planAndApply(t, syncer, tree, false)
+
+	tree["runners/base/run.sh"] = entry("./main --fast\n")
+	delete(tree, "compares/exact/manifest.yaml")
+	delete(tree, "compares/exact/run.sh")
+	plan, err := syncer.Plan(ctx, tree, true)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+
+	// The compare is gone before the plan deletes it.
+	ID := plan.Changes[len(plan.Changes)-1].ID
+	err = syncer.compares.DeleteByID(ctx, ID)
+	if err != nil {
+		t.Fatalf("DeleteByID: %v", err)
+	}
+
+	applied, err := syncer.Apply(ctx, plan)
+	if err == nil {
+		t.Fatal("Apply succeeded")
+	}
+	got := summarize(&Plan{Changes: applied})
+	want := []planned{{Action: ActionUpdate, Kind: manifest.KindRunner, Name: "base", Paths: []string{"run.sh"}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Apply applied %v, want %v", got, want)
+	}
+}
+
+func TestPlanPresetCompares(t *testing.T) {
+	ctx := context.Background()
+	syncer := newTestSyncer(t)
+	ID, err := syncer.compares.CreateFromPreset(ctx, &requests.CreateCompareFromPreset{Name: "exact", PresetID: "exact"})
+	if err != nil {
+		t.Fatalf("CreateFromPreset: %v", err)
+	}
+	compare, err := syncer.compares.GetByID(ctx, ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+
+	// Only what isn't generated from the preset may change.
+	compare.Description = "Exact match of the output"
+	tree, err := manifest.FromCompare(ctx, compare, syncer.files)
+	if err != nil {
+		t.Fatalf("FromCompare: %v", err)
+	}
+	got := planAndApply(t, syncer, tree, false)
+	want := []planned{{Action: ActionUpdate, Kind: manifest.KindCompare, Name: "exact", Paths: []string{manifest.ManifestName}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("plan of the described compare = %v, want %v", got, want)
+	}
+
+	compare.RunScript += "echo edited\n"
+	tree, err = manifest.FromCompare(ctx, compare, syncer.files)
+	if err != nil {
+		t.Fatalf("FromCompare: %v", err)
+	}
+	_, err = syncer.Plan(ctx, tree, false)
+	if !errors.Is(err, cerrors.READ_ONLY) {
+		t.Fatalf("plan of the edited compare error = %v, want %v", err, cerrors.READ_ONLY)
+	}
+}
+
+func TestPlanRejectsInvalidTrees(t *testing.T) {
+	tests := []struct {
+		name string
+		tree manifest.Tree
+	}{
+		{
+			name: "runner defined twice",
+			tree: manifest.Tree{
+				"a/manifest.yaml": entry("kind: runner\nname: cpp\n"),
+				"b/manifest.yaml": entry("kind: runner\nname: cpp\n"),
+			},
+		},
+		{
+			name: "compare defined twice",
+			tree: manifest.Tree{
+				"a/manifest.yaml": entry("kind: compare\nname: exact\n"),
+				"b/manifest.yaml": entry("kind: compare\nname: exact\n"),
+			},
+		},
+		{
+			name: "missing script",
+			tree: manifest.Tree{
+				"a/manifest.yaml": entry("kind: runner\nname: cpp\nrun_script: missing.sh\n"),
+			},
+		},
+		{
+			name: "unknown kind",
+			tree: manifest.Tree{
+				"a/manifest.yaml": entry("kind: checker\nname: cpp\n"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newTestSyncer(t).Plan(context.Background(), tt.tree, false)
+			if !errors.Is(err, cerrors.INVALID_DATA) {
+				t.Fatalf("Plan error = %v, want %v", err, cerrors.INVALID_DATA)
+			}
+		})
+	}
+}
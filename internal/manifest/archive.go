@@ -0,0 +1,257 @@
+package manifest
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+type Format string
+
+// ErrTooLarge is returned when an archive extracts to more than its limits.
+var ErrTooLarge = errors.New("archive is too large")
+
+// Limits bound what reading an archive may extract, so that a small archive
+// can't expand into more than the server is willing to hold.
+type Limits struct {
+	// Size is the total size of the extracted entries.
+	Size int64
+	// Entries is the number of entries, directories included.
+	Entries int
+}
+
+// DefaultLimits suit the archive of a runner, a compare or a manifest
+// directory.
+var DefaultLimits = Limits{Size: 256 << 20, Entries: 4096}
+
+const (
+	FormatZip   Format = "zip"
+	FormatTarGz Format = "tar.gz"
+)
+
+// WriteArchive writes the tree as a zip or tar.gz archive. Entries are
+// written in path order with a fixed timestamp so the same tree always
+// produces the same archive.
+func WriteArchive(w io.Writer, format Format, tree Tree) error {
+	paths := slices.Sorted(maps.Keys(tree))
+
+	switch format {
+	case FormatZip:
+		zw := zip.NewWriter(w)
+		for _, p := range paths {
+			header := &zip.FileHeader{Name: p, Method: zip.Deflate, Modified: time.Unix(0, 0).UTC()}
+			header.SetMode(fs.FileMode(entryMode(tree[p])))
+			fw, err := zw.CreateHeader(header)
+			if err != nil {
+				return err
+			}
+			if _, err := fw.Write(tree[p].Data); err != nil {
+				return err
+			}
+		}
+		return zw.Close()
+	case FormatTarGz:
+		gw := gzip.NewWriter(w)
+		tw := tar.NewWriter(gw)
+		for _, p := range paths {
+			err := tw.WriteHeader(&tar.Header{
+				Name:     p,
+				Mode:     int64(entryMode(tree[p])),
+				Size:     int64(len(tree[p].Data)),
+				Typeflag: tar.TypeReg,
+				ModTime:  time.Unix(0, 0),
+				Format:   tar.FormatPAX,
+			})
+			if err != nil {
+				return err
+			}
+			if _, err := tw.Write(tree[p].Data); err != nil {
+				return err
+			}
+		}
+		if err := tw.Close(); err != nil {
+			return err
+		}
+		return gw.Close()
+	}
+
+	return fmt.Errorf("unknown archive format %q", format)
+}
+
+// ReadArchive reads a zip or tar.gz archive, detected from its content. A
+// single top level directory wrapping everything is stripped, since that's
+// how most tools archive a directory. It fails with ErrTooLarge once the
+// archive goes past the limits.
+func ReadArchive(data []byte, limits Limits) (Tree, error) {
+	var (
+		tree Tree
+		err  error
+	)
+	budget := &budget{limits: limits}
+	switch {
+	case bytes.HasPrefix(data, []byte("PK\x03\x04")), bytes.HasPrefix(data, []byte("PK\x05\x06")):
+		tree, err = readZip(data, budget)
+	case bytes.HasPrefix(data, []byte{0x1f, 0x8b}):
+		tree, err = readTarGz(data, budget)
+	default:
+		return nil, fmt.Errorf("%w: archive is neither zip nor tar.gz", ErrInvalid)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return stripRoot(tree), nil
+}
+
+// budget tracks what is left of the limits while an archive is read.
+type budget struct {
+	limits  Limits
+	size    int64
+	entries int
+}
+
+func (b *budget) entry() error {
+	b.entries++
+	if b.entries > b.limits.Entries {
+		return fmt.Errorf("%w: more than %d entries", ErrTooLarge, b.limits.Entries)
+	}
+	return nil
+}
+
+// read reads the content of an entry, never reading more than one byte past
+// the size left.
+func (b *budget) read(r io.Reader) ([]byte, error) {
+	content, err := io.ReadAll(io.LimitReader(r, b.limits.Size-b.size+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	b.size += int64(len(content))
+	if b.size > b.limits.Size {
+		return nil, fmt.Errorf("%w: extracts to more than %d bytes", ErrTooLarge, b.limits.Size)
+	}
+	return content, nil
+}
+
+func readZip(data []byte, budget *budget) (Tree, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	tree := Tree{}
+	for _, f := range zr.File {
+		err := budget.entry()
+		if err != nil {
+			return nil, err
+		}
+		if f.FileInfo().IsDir() {
+			continue
+		}
+
+		name, err := entryName(f.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+		}
+		content, err := budget.read(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		tree[name] = Entry{Data: content, Mode: uint32(f.Mode().Perm())}
+	}
+	return tree, nil
+}
+
+func readTarGz(data []byte, budget *budget) (Tree, error) {
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	defer gr.Close()
+
+	tree := Tree{}
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+		}
+
+		err = budget.entry()
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name, err := entryName(header.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := budget.read(tr)
+		if err != nil {
+			return nil, err
+		}
+
+		tree[name] = Entry{Data: content, Mode: uint32(header.FileInfo().Mode().Perm())}
+	}
+	return tree, nil
+}
+
+func entryName(name string) (string, error) {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	if name == "" {
+		return "", fmt.Errorf("%w: empty entry name", ErrInvalid)
+	}
+	return name, nil
+}
+
+func entryMode(entry Entry) uint32 {
+	if entry.Mode == 0 {
+		return 0o644
+	}
+	return entry.Mode
+}
+
+func stripRoot(tree Tree) Tree {
+	if _, ok := tree[ManifestName]; ok {
+		return tree
+	}
+
+	root := ""
+	for p := range tree {
+		dir, _, ok := strings.Cut(p, "/")
+		if !ok || (root != "" && dir != root) {
+			return tree
+		}
+		root = dir
+	}
+
+	stripped := make(Tree, len(tree))
+	for p, entry := range tree {
+		stripped[strings.TrimPrefix(p, root+"/")] = entry
+	}
+	return stripped
+}
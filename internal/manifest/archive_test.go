@@ -0,0 +1,84 @@
+package manifest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func writeArchive(t *testing.T, format Format, tree Tree) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	err := WriteArchive(&buf, format, tree)
+	if err != nil {
+		t.Fatalf("WriteArchive: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadArchive(t *testing.T) {
+	tree := Tree{
+		ManifestName: {Data: []byte("version: 1\n"), Mode: 0o644},
+		"run.sh":     {Data: []byte("#!/bin/bash\n"), Mode: 0o755},
+	}
+	wrapped := Tree{
+		"checker/" + ManifestName: tree[ManifestName],
+		"checker/run.sh":          tree["run.sh"],
+	}
+
+	manyEntries := Tree{}
+	for i := range 10 {
+		manyEntries[fmt.Sprintf("file%d.txt", i)] = Entry{Data: []byte("x")}
+	}
+
+	// Zeros compress so well that the archive is far smaller than what it
+	// extracts to.
+	bomb := Tree{"zeros": {Data: make([]byte, 1<<20)}}
+
+	tests := []struct {
+		name   string
+		tree   Tree
+		limits Limits
+		want   Tree
+		err    error
+	}{
+		{name: "within limits", tree: tree, limits: DefaultLimits, want: tree},
+		{name: "strips root directory", tree: wrapped, limits: DefaultLimits, want: tree},
+		{name: "exact size", tree: tree, limits: Limits{Size: 23, Entries: 2}, want: tree},
+		{name: "too many entries", tree: manyEntries, limits: Limits{Size: 1 << 20, Entries: 9}, err: ErrTooLarge},
+		{name: "too large", tree: tree, limits: Limits{Size: 22, Entries: 2}, err: ErrTooLarge},
+		{name: "bomb", tree: bomb, limits: Limits{Size: 64 << 10, Entries: 10}, err: ErrTooLarge},
+	}
+
+	for _, format := range []Format{FormatZip, FormatTarGz} {
+		for _, tt := range tests {
+			t.Run(string(format)+"/"+tt.name, func(t *testing.T) {
+				data := writeArchive(t, format, tt.tree)
+				if tt.name == "bomb" && len(data) >= int(tt.limits.Size) {
+					t.Fatalf("archive of %d bytes doesn't exercise the limit", len(data))
+				}
+
+				got, err := ReadArchive(data, tt.limits)
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("ReadArchive error = %v, want %v", err, tt.err)
+				}
+				if tt.err != nil {
+					return
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("ReadArchive = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestReadArchiveRejectsUnknownFormat(t *testing.T) {
+	_, err := ReadArchive([]byte("not an archive"), DefaultLimits)
+	if !errors.Is(err, ErrInvalid) {
+		t.Fatalf("ReadArchive error = %v, want %v", err, ErrInvalid)
+	}
+}
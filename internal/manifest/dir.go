@@ -0,0 +1,50 @@
+package manifest
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ReadDir reads every regular file under dir into a tree, skipping hidden
+// files and directories such as .git.
+func ReadDir(dir string) (Tree, error) {
+	tree := Tree{}
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if p != dir && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		tree[filepath.ToSlash(rel)] = Entry{Data: data, Mode: uint32(info.Mode().Perm())}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
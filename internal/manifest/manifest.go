@@ -0,0 +1,475 @@
+package manifest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	Version = 1
+
+	ManifestName = "manifest.yaml"
+	buildName    = "build.sh"
+	runName      = "run.sh"
+	starterName  = "starter.tmpl"
+	filesDir     = "files/"
+)
+
+type Kind string
+
+const (
+	KindRunner  Kind = "runner"
+	KindCompare Kind = "compare"
+)
+
+var ErrInvalid = errors.New("invalid manifest")
+
+// Manifest describes a runner or a compare. Scripts and files are kept next
+// to it, the manifest only points at them:
+//
+//	manifest.yaml
+//	build.sh
+//	run.sh
+//	starter.tmpl
+//	files/<file name>
+//
+// Aliases are only exported for reference, the server keeps track of them as
+// the slug changes.
+type Manifest struct {
+	Version     int      `yaml:"version"`
+	Kind        Kind     `yaml:"kind"`
+	ID          string   `yaml:"id,omitempty"`
+	Namespace   string   `yaml:"namespace,omitempty"`
+	Name        string   `yaml:"name"`
+	Slug        string   `yaml:"slug,omitempty"`
+	Aliases     []string `yaml:"aliases,omitempty"`
+	Description string   `yaml:"description,omitempty"`
+	BuildScript string   `yaml:"build_script,omitempty"`
+	RunScript   string   `yaml:"run_script,omitempty"`
+	Files       []File   `yaml:"files,omitempty"`
+
+	// Compare only.
+	RunName       string            `yaml:"run_name,omitempty"`
+	GoldenCases   []GoldenCase      `yaml:"golden_cases,omitempty"`
+	PresetID      string            `yaml:"preset_id,omitempty"`
+	PresetVersion int               `yaml:"preset_version,omitempty"`
+	PresetParams  map[string]string `yaml:"preset_params,omitempty"`
+
+	// Runner only.
+	ParentID              string            `yaml:"parent_id,omitempty"`
+	Variables             map[string]string `yaml:"variables,omitempty"`
+	Env                   []EnvVar          `yaml:"env,omitempty"`
+	DefaultLimitProfileID string            `yaml:"default_limit_profile_id,omitempty"`
+	LimitMultipliers      *LimitMultipliers `yaml:"limit_multipliers,omitempty"`
+	Metadata              *RunnerMetadata   `yaml:"metadata,omitempty"`
+	StarterTemplate       string            `yaml:"starter_template,omitempty"`
+	EntryPoint            string            `yaml:"entry_point,omitempty"`
+}
+
+type File struct {
+	Name         string `yaml:"name"`
+	Mode         Mode   `yaml:"mode,omitempty"`
+	SharedFileID string `yaml:"shared_file_id,omitempty"`
+}
+
+// Mode is a file mode written in octal.
+type Mode uint32
+
+func (m Mode) MarshalYAML() (any, error) {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: fmt.Sprintf("0%o", uint32(m))}, nil
+}
+
+func (m *Mode) UnmarshalYAML(value *yaml.Node) error {
+	mode, err := strconv.ParseUint(value.Value, 0, 32)
+	if err != nil {
+		return fmt.Errorf("invalid file mode %q", value.Value)
+	}
+	*m = Mode(mode)
+	return nil
+}
+
+type GoldenCase struct {
+	Name            string `yaml:"name"`
+	ExpectedOutput  string `yaml:"expected_output"`
+	ActualOutput    string `yaml:"actual_output"`
+	ExpectedVerdict string `yaml:"expected_verdict"`
+}
+
+// EnvVar values of secrets are never exported, importing an empty secret
+// keeps the value already stored.
+type EnvVar struct {
+	Name   string `yaml:"name"`
+	Value  string `yaml:"value,omitempty"`
+	Secret bool   `yaml:"secret,omitempty"`
+}
+
+type LimitMultipliers struct {
+	CPUTime  float32 `yaml:"cpu_time,omitempty"`
+	WallTime float32 `yaml:"wall_time,omitempty"`
+	Memory   float32 `yaml:"memory,omitempty"`
+}
+
+type RunnerMetadata struct {
+	Language   string   `yaml:"language,omitempty"`
+	Version    string   `yaml:"version,omitempty"`
+	Extensions []string `yaml:"extensions,omitempty"`
+	EditorMode string   `yaml:"editor_mode,omitempty"`
+	Icon       string   `yaml:"icon,omitempty"`
+	Tags       []string `yaml:"tags,omitempty"`
+}
+
+// Entry is a file of a manifest tree.
+type Entry struct {
+	Data []byte
+	Mode uint32
+}
+
+// Tree maps slash separated paths to their entries.
+type Tree map[string]Entry
+
+// Diff returns the sorted paths whose entries differ between two trees,
+// including the paths only one of them has.
+func Diff(current Tree, desired Tree) []string {
+	var paths []string
+	for _, p := range slices.Sorted(maps.Keys(current)) {
+		other, ok := desired[p]
+		if !ok || other.Mode != current[p].Mode || !bytes.Equal(other.Data, current[p].Data) {
+			paths = append(paths, p)
+		}
+	}
+	for _, p := range slices.Sorted(maps.Keys(desired)) {
+		if _, ok := current[p]; !ok {
+			paths = append(paths, p)
+		}
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+// FromCompare builds the tree of a compare, loading its files from blobs.
+func FromCompare(ctx context.Context, compare *models.Compare, blobs models.BlobReader) (Tree, error) {
+	m := &Manifest{
+		Version:       Version,
+		Kind:          KindCompare,
+		ID:            compare.ID,
+		Name:          compare.Name,
+		Slug:          compare.Slug,
+		Aliases:       compare.Aliases,
+		Description:   compare.Description,
+		RunName:       compare.RunName,
+		PresetID:      compare.PresetID,
+		PresetVersion: compare.PresetVersion,
+		PresetParams:  compare.PresetParams,
+		Namespace:     compare.Namespace,
+	}
+	for _, golden := range compare.GoldenCases {
+		m.GoldenCases = append(m.GoldenCases, GoldenCase{
+			Name:            golden.Name,
+			ExpectedOutput:  golden.ExpectedOutput,
+			ActualOutput:    golden.ActualOutput,
+			ExpectedVerdict: string(golden.ExpectedVerdict),
+		})
+	}
+
+	return m.tree(ctx, compare.BuildScript, compare.RunScript, compare.Files, blobs)
+}
+
+// FromRunner builds the tree of a runner as stored, without anything
+// inherited from its parents.
+func FromRunner(ctx context.Context, runner *models.Runner, blobs models.BlobReader) (Tree, error) {
+	m := &Manifest{
+		Version:               Version,
+		Kind:                  KindRunner,
+		ID:                    runner.ID,
+		Name:                  runner.Name,
+		Slug:                  runner.Slug,
+		Aliases:               runner.Aliases,
+		Description:           runner.Description,
+		ParentID:              runner.ParentID,
+		Variables:             runner.Variables,
+		DefaultLimitProfileID: runner.DefaultLimitProfileID,
+		EntryPoint:            runner.EntryPoint,
+		Namespace:             runner.Namespace,
+	}
+	for _, e := range runner.Env {
+		env := EnvVar{Name: e.Name, Secret: e.Secret}
+		if !e.Secret {
+			env.Value = e.Value
+		}
+		m.Env = append(m.Env, env)
+	}
+	if runner.LimitMultipliers != nil {
+		m.LimitMultipliers = &LimitMultipliers{
+			CPUTime:  runner.LimitMultipliers.CPUTime,
+			WallTime: runner.LimitMultipliers.WallTime,
+			Memory:   runner.LimitMultipliers.Memory,
+		}
+	}
+	if runner.Metadata != nil {
+		m.Metadata = &RunnerMetadata{
+			Language:   runner.Metadata.Language,
+			Version:    runner.Metadata.Version,
+			Extensions: runner.Metadata.Extensions,
+			EditorMode: runner.Metadata.EditorMode,
+			Icon:       runner.Metadata.Icon,
+			Tags:       runner.Metadata.Tags,
+		}
+	}
+
+	if runner.StarterTemplate != "" {
+		m.StarterTemplate = starterName
+	}
+
+	tree, err := m.tree(ctx, runner.BuildScript, runner.RunScript, runner.InitialFiles, blobs)
+	if err != nil {
+		return nil, err
+	}
+	if runner.StarterTemplate != "" {
+		tree[starterName] = Entry{Data: []byte(runner.StarterTemplate), Mode: 0o644}
+	}
+	return tree, nil
+}
+
+func (m *Manifest) tree(ctx context.Context, buildScript string, runScript string, files []models.File, blobs models.BlobReader) (Tree, error) {
+	tree := Tree{}
+	if buildScript != "" {
+		m.BuildScript = buildName
+		tree[buildName] = Entry{Data: []byte(buildScript), Mode: 0o755}
+	}
+	if runScript != "" {
+		m.RunScript = runName
+		tree[runName] = Entry{Data: []byte(runScript), Mode: 0o755}
+	}
+
+	for _, f := range files {
+		m.Files = append(m.Files, File{Name: f.Name, Mode: Mode(f.Mode), SharedFileID: f.SharedFileID})
+		// Linked files are restored from the shared file on import.
+		if f.SharedFileID != "" {
+			continue
+		}
+
+		data, err := f.Load(ctx, blobs)
+		if err != nil {
+			return nil, fmt.Errorf("Cannot load file %s : %w", f.Name, err)
+		}
+		tree[filesDir+f.Name] = Entry{Data: data, Mode: f.Mode}
+	}
+
+	raw, err := yaml.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	tree[ManifestName] = Entry{Data: raw, Mode: 0o644}
+
+	return tree, nil
+}
+
+// Parse reads the manifest of a tree and checks that every path it refers to
+// exists.
+func Parse(tree Tree) (*Manifest, error) {
+	entry, ok := tree[ManifestName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s is missing", ErrInvalid, ManifestName)
+	}
+
+	var m Manifest
+	err := yaml.Unmarshal(entry.Data, &m)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	if m.Version == 0 {
+		m.Version = Version
+	}
+	if m.Version > Version {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalid, m.Version)
+	}
+	if m.Kind != KindRunner && m.Kind != KindCompare {
+		return nil, fmt.Errorf("%w: unknown kind %q", ErrInvalid, m.Kind)
+	}
+	if m.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalid)
+	}
+
+	// Scripts at their default path don't have to be listed.
+	if _, ok := tree[buildName]; ok && m.BuildScript == "" {
+		m.BuildScript = buildName
+	}
+	if _, ok := tree[runName]; ok && m.RunScript == "" {
+		m.RunScript = runName
+	}
+	if _, ok := tree[starterName]; ok && m.StarterTemplate == "" && m.Kind == KindRunner {
+		m.StarterTemplate = starterName
+	}
+
+	for _, script := range []string{m.BuildScript, m.RunScript, m.StarterTemplate} {
+		if _, ok := tree[script]; script != "" && !ok {
+			return nil, fmt.Errorf("%w: script %s is missing", ErrInvalid, script)
+		}
+	}
+
+	return &m, nil
+}
+
+// Compare returns the compare described by the tree. Its files hold their
+// content in Data.
+func (m *Manifest) Compare(tree Tree) (*models.Compare, error) {
+	if m.Kind != KindCompare {
+		return nil, fmt.Errorf("%w: expected a %s, got a %s", ErrInvalid, KindCompare, m.Kind)
+	}
+
+	files, err := m.files(tree)
+	if err != nil {
+		return nil, err
+	}
+
+	compare := &models.Compare{
+		ID:            m.ID,
+		Name:          m.Name,
+		Slug:          m.Slug,
+		Aliases:       m.Aliases,
+		Description:   m.Description,
+		BuildScript:   string(tree[m.BuildScript].Data),
+		RunScript:     string(tree[m.RunScript].Data),
+		RunName:       m.RunName,
+		Files:         files,
+		GoldenCases:   []models.GoldenCase{},
+		PresetID:      m.PresetID,
+		PresetVersion: m.PresetVersion,
+		PresetParams:  m.PresetParams,
+		Namespace:     m.Namespace,
+	}
+	for _, golden := range m.GoldenCases {
+		verdict := models.Verdict(golden.ExpectedVerdict)
+		if verdict != models.VerdictAccepted && verdict != models.VerdictWrongAnswer {
+			return nil, fmt.Errorf("%w: golden case %q has an unknown verdict %q", ErrInvalid, golden.Name, golden.ExpectedVerdict)
+		}
+
+		compare.GoldenCases = append(compare.GoldenCases, models.GoldenCase{
+			Name:            golden.Name,
+			ExpectedOutput:  golden.ExpectedOutput,
+			ActualOutput:    golden.ActualOutput,
+			ExpectedVerdict: verdict,
+		})
+	}
+
+	return compare, nil
+}
+
+// Runner returns the runner described by the tree. Its files hold their
+// content in Data.
+func (m *Manifest) Runner(tree Tree) (*models.Runner, error) {
+	if m.Kind != KindRunner {
+		return nil, fmt.Errorf("%w: expected a %s, got a %s", ErrInvalid, KindRunner, m.Kind)
+	}
+
+	files, err := m.files(tree)
+	if err != nil {
+		return nil, err
+	}
+
+	runner := &models.Runner{
+		ID:           m.ID,
+		Name:         m.Name,
+		Slug:         m.Slug,
+		Aliases:      m.Aliases,
+		Description:  m.Description,
+		BuildScript:  string(tree[m.BuildScript].Data),
+		RunScript:    string(tree[m.RunScript].Data),
+		InitialFiles: files,
+		ParentID:     m.ParentID,
+		Variables:    map[string]string{},
+		Env:          []models.EnvVar{},
+
+		DefaultLimitProfileID: m.DefaultLimitProfileID,
+		StarterTemplate:       string(tree[m.StarterTemplate].Data),
+		EntryPoint:            m.EntryPoint,
+		Namespace:             m.Namespace,
+	}
+	maps.Copy(runner.Variables, m.Variables)
+	for _, e := range m.Env {
+		runner.Env = append(runner.Env, models.EnvVar{Name: e.Name, Value: e.Value, Secret: e.Secret})
+	}
+	if m.LimitMultipliers != nil {
+		runner.LimitMultipliers = &models.LimitMultipliers{
+			CPUTime:  m.LimitMultipliers.CPUTime,
+			WallTime: m.LimitMultipliers.WallTime,
+			Memory:   m.LimitMultipliers.Memory,
+		}
+	}
+	if m.Metadata != nil {
+		runner.Metadata = &models.RunnerMetadata{
+			Language:   m.Metadata.Language,
+			Version:    m.Metadata.Version,
+			Extensions: m.Metadata.Extensions,
+			EditorMode: m.Metadata.EditorMode,
+			Icon:       m.Metadata.Icon,
+			Tags:       m.Metadata.Tags,
+		}
+	}
+
+	return runner, nil
+}
+
+// files returns the files listed in the manifest in order, followed by any
+// other file found under files/ so new files can simply be dropped in.
+func (m *Manifest) files(tree Tree) ([]models.File, error) {
+	files := []models.File{}
+	listed := map[string]bool{}
+	for _, f := range m.Files {
+		if err := checkName(f.Name); err != nil {
+			return nil, err
+		}
+		if listed[f.Name] {
+			return nil, fmt.Errorf("%w: file %s is listed twice", ErrInvalid, f.Name)
+		}
+		listed[f.Name] = true
+
+		if f.SharedFileID != "" {
+			files = append(files, models.File{Name: f.Name, Mode: uint32(f.Mode), SharedFileID: f.SharedFileID})
+			continue
+		}
+
+		entry, ok := tree[filesDir+f.Name]
+		if !ok {
+			return nil, fmt.Errorf("%w: file %s is missing", ErrInvalid, f.Name)
+		}
+
+		files = append(files, models.File{Name: f.Name, Mode: uint32(f.Mode), Data: nonNil(entry.Data)})
+	}
+
+	for _, p := range slices.Sorted(maps.Keys(tree)) {
+		name, ok := strings.CutPrefix(p, filesDir)
+		if !ok || name == "" || listed[name] {
+			continue
+		}
+		files = append(files, models.File{Name: name, Mode: tree[p].Mode, Data: nonNil(tree[p].Data)})
+	}
+
+	return files, nil
+}
+
+func checkName(name string) error {
+	if name == "" || path.IsAbs(name) || path.Clean(name) != name || name == ".." || strings.HasPrefix(name, "../") {
+		return fmt.Errorf("%w: invalid file name %q", ErrInvalid, name)
+	}
+	return nil
+}
+
+// nonNil keeps empty files distinct from files without content.
+func nonNil(data []byte) []byte {
+	if data == nil {
+		return []byte{}
+	}
+	return data
+}
@@ -0,0 +1,217 @@
+// Package migrate applies versioned schema migrations to the storage. The
+// applied versions are kept by a MigrationRepository, which also provides the
+// lock making sure a single replica migrates at a time.
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/CSKU-Lab/config-server/domain/cerrors"
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/domain/repositories"
+	"github.com/google/uuid"
+)
+
+const (
+	// lockLease is how long the lock outlives a replica that stopped while
+	// migrating.
+	lockLease = 10 * time.Minute
+	// lockRetry is how often a replica waiting for the lock tries again.
+	lockRetry = time.Second
+)
+
+type Migration struct {
+	// Version orders the migrations, starting at 1.
+	Version     int
+	Description string
+	Up          func(ctx context.Context) error
+	// Down reverts Up, nil when the migration can't be reverted.
+	Down func(ctx context.Context) error
+}
+
+// Status is a known or applied migration.
+type Status struct {
+	Version     int
+	Description string
+	Applied     bool
+	AppliedAt   time.Time
+	// Unknown is set for an applied migration missing from this build, e.g.
+	// after a rollback of the server.
+	Unknown bool
+}
+
+type Migrator struct {
+	repo       repositories.MigrationRepository
+	migrations []Migration
+	owner      string
+}
+
+// New creates a migrator applying migrations in version order.
+func New(repo repositories.MigrationRepository, migrations []Migration) (*Migrator, error) {
+	migrations = slices.Clone(migrations)
+	slices.SortFunc(migrations, func(a, b Migration) int {
+		return a.Version - b.Version
+	})
+	for i, migration := range migrations {
+		if migration.Version < 1 || migration.Up == nil {
+			return nil, fmt.Errorf("invalid migration %d %q", migration.Version, migration.Description)
+		}
+		if i > 0 && migrations[i-1].Version == migration.Version {
+			return nil, fmt.Errorf("duplicated migration version %d", migration.Version)
+		}
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	host, _ := os.Hostname()
+
+	return &Migrator{
+		repo:       repo,
+		migrations: migrations,
+		owner:      fmt.Sprintf("%s/%d/%s", host, os.Getpid(), id),
+	}, nil
+}
+
+// Up applies every migration not applied yet and returns them.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var done []Migration
+	err := m.withLock(ctx, func() error {
+		applied, err := m.appliedVersions(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if applied[migration.Version] {
+				continue
+			}
+
+			err := migration.Up(ctx)
+			if err != nil {
+				return fmt.Errorf("migration %d %q failed: %w", migration.Version, migration.Description, err)
+			}
+			err = m.repo.Add(ctx, &models.AppliedMigration{
+				Version:     migration.Version,
+				Description: migration.Description,
+				AppliedAt:   time.Now().UTC(),
+			})
+			if err != nil {
+				return err
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down reverts the last steps applied migrations, latest first, and returns
+// them.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var done []Migration
+	err := m.withLock(ctx, func() error {
+		applied, err := m.repo.Applied(ctx)
+		if err != nil {
+			return err
+		}
+
+		for i := len(applied) - 1; i >= 0 && len(done) < steps; i-- {
+			index := slices.IndexFunc(m.migrations, func(migration Migration) bool {
+				return migration.Version == applied[i].Version
+			})
+			if index < 0 {
+				return fmt.Errorf("migration %d %q is unknown to this build", applied[i].Version, applied[i].Description)
+			}
+			migration := m.migrations[index]
+			if migration.Down == nil {
+				return fmt.Errorf("migration %d %q can't be reverted", migration.Version, migration.Description)
+			}
+
+			err := migration.Down(ctx)
+			if err != nil {
+				return fmt.Errorf("reverting migration %d %q failed: %w", migration.Version, migration.Description, err)
+			}
+			err = m.repo.Remove(ctx, migration.Version)
+			if err != nil {
+				return err
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Status returns the known and applied migrations by version.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.repo.Applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		statuses = append(statuses, Status{
+			Version:     migration.Version,
+			Description: migration.Description,
+		})
+	}
+	for _, a := range applied {
+		i, found := slices.BinarySearchFunc(statuses, a.Version, func(s Status, version int) int {
+			return s.Version - version
+		})
+		if !found {
+			statuses = slices.Insert(statuses, i, Status{
+				Version:     a.Version,
+				Description: a.Description,
+				Unknown:     true,
+			})
+		}
+		statuses[i].Applied = true
+		statuses[i].AppliedAt = a.AppliedAt
+	}
+	return statuses, nil
+}
+
+func (m *Migrator) appliedVersions(ctx context.Context) (map[int]bool, error) {
+	applied, err := m.repo.Applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make(map[int]bool, len(applied))
+	for _, a := range applied {
+		versions[a.Version] = true
+	}
+	return versions, nil
+}
+
+// withLock runs fn once the lock is taken, waiting for other replicas to be
+// done migrating.
+func (m *Migrator) withLock(ctx context.Context, fn func() error) error {
+	for {
+		err := m.repo.Lock(ctx, m.owner, time.Now().UTC().Add(lockLease))
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, cerrors.DATA_IN_USE) {
+			return err
+		}
+
+		select {
+		case <-time.After(lockRetry):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	defer m.repo.Unlock(context.WithoutCancel(ctx), m.owner)
+
+	return fn()
+}
@@ -0,0 +1,243 @@
+package migrate_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/internal/adapters/memory"
+	"github.com/CSKU-Lab/config-server/internal/migrate"
+)
+
+// journal records the migrations run, in order.
+type journal struct {
+	mu  sync.Mutex
+	ran []string
+}
+
+func (j *journal) migration(version int, description string) migrate.Migration {
+	step := func(direction string) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			j.mu.Lock()
+			defer j.mu.Unlock()
+			j.ran = append(j.ran, direction+" "+description)
+			return nil
+		}
+	}
+	return migrate.Migration{Version: version, Description: description, Up: step("up"), Down: step("down")}
+}
+
+func (j *journal) take() []string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	ran := j.ran
+	j.ran = nil
+	return ran
+}
+
+func versions(migrations []migrate.Migration) []int {
+	var result []int
+	for _, migration := range migrations {
+		result = append(result, migration.Version)
+	}
+	return result
+}
+
+func TestMigratorAppliesInVersionOrder(t *testing.T) {
+	ctx := context.Background()
+	j := &journal{}
+	repo := memory.NewMigrationRepo()
+	migrations := []migrate.Migration{j.migration(3, "third"), j.migration(1, "first"), j.migration(2, "second")}
+
+	m, err := migrate.New(repo, migrations[1:])
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	done, err := m.Up(ctx)
+	if err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+	if got, want := versions(done), []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Up applied %v, want %v", got, want)
+	}
+
+	// A newer build only applies what is missing.
+	m, err = migrate.New(repo, migrations)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	done, err = m.Up(ctx)
+	if err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+	if got, want := versions(done), []int{3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("second Up applied %v, want %v", got, want)
+	}
+
+	done, err = m.Down(ctx, 2)
+	if err != nil {
+		t.Fatalf("Down: %v", err)
+	}
+	if got, want := versions(done), []int{3, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Down reverted %v, want %v", got, want)
+	}
+
+	want := []string{"up first", "up second", "up third", "down third", "down second"}
+	if got := j.take(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ran %v, want %v", got, want)
+	}
+
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	var applied []bool
+	for _, status := range statuses {
+		applied = append(applied, status.Applied)
+	}
+	if want := []bool{true, false, false}; !reflect.DeepEqual(applied, want) {
+		t.Errorf("Status applied = %v, want %v", applied, want)
+	}
+}
+
+func TestMigratorStatusOfUnknownMigrations(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewMigrationRepo()
+	err := repo.Add(ctx, &models.AppliedMigration{Version: 2, Description: "from a newer build"})
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	j := &journal{}
+	m, err := migrate.New(repo, []migrate.Migration{j.migration(1, "first")})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	want := []migrate.Status{
+		{Version: 1, Description: "first"},
+		{Version: 2, Description: "from a newer build", Applied: true, Unknown: true},
+	}
+	if !reflect.DeepEqual(statuses, want) {
+		t.Errorf("Status = %+v, want %+v", statuses, want)
+	}
+
+	// Reverting it needs the build which knows it.
+	_, err = m.Down(ctx, 1)
+	if err == nil {
+		t.Error("Down of an unknown migration succeeded")
+	}
+}
+
+func TestNewRejectsInvalidMigrations(t *testing.T) {
+	j := &journal{}
+	noUp := j.migration(2, "no up")
+	noUp.Up = nil
+
+	tests := []struct {
+		name       string
+		migrations []migrate.Migration
+	}{
+		{name: "version 0", migrations: []migrate.Migration{j.migration(0, "zero")}},
+		{name: "duplicated version", migrations: []migrate.Migration{j.migration(1, "a"), j.migration(1, "b")}},
+		{name: "without up", migrations: []migrate.Migration{j.migration(1, "first"), noUp}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := migrate.New(memory.NewMigrationRepo(), tt.migrations)
+			if err == nil {
+				t.Error("New succeeded")
+			}
+		})
+	}
+}
+
+func TestMigratorStopsAtTheFailedMigration(t *testing.T) {
+	ctx := context.Background()
+	j := &journal{}
+	failure := errors.New("cannot migrate")
+	broken := j.migration(2, "broken")
+	broken.Up = func(ctx context.Context) error { return failure }
+
+	m, err := migrate.New(memory.NewMigrationRepo(), []migrate.Migration{j.migration(1, "first"), broken, j.migration(3, "third")})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	done, err := m.Up(ctx)
+	if !errors.Is(err, failure) {
+		t.Fatalf("Up error = %v, want %v", err, failure)
+	}
+	if got, want := versions(done), []int{1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Up applied %v, want %v", got, want)
+	}
+	if got, want := j.take(), []string{"up first"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ran %v, want %v", got, want)
+	}
+}
+
+func TestMigratorWaitsForTheLock(t *testing.T) {
+	repo := memory.NewMigrationRepo()
+	j := &journal{}
+
+	started, release := make(chan struct{}), make(chan struct{})
+	slow := j.migration(1, "slow")
+	up := slow.Up
+	slow.Up = func(ctx context.Context) error {
+		close(started)
+		<-release
+		return up(ctx)
+	}
+	migrations := []migrate.Migration{slow}
+
+	first, err := migrate.New(repo, migrations)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	second, err := migrate.New(repo, migrations)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	firstDone := make(chan error)
+	go func() {
+		_, err := first.Up(context.Background())
+		firstDone <- err
+	}()
+	<-started
+
+	// Another replica gives up waiting while the lock is held.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = second.Up(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Up while locked error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	secondDone := make(chan []migrate.Migration)
+	go func() {
+		done, err := second.Up(context.Background())
+		if err != nil {
+			t.Errorf("second Up: %v", err)
+		}
+		secondDone <- done
+	}()
+
+	close(release)
+	if err := <-firstDone; err != nil {
+		t.Fatalf("first Up: %v", err)
+	}
+	if done := <-secondDone; len(done) != 0 {
+		t.Errorf("second Up applied %v after the first one", versions(done))
+	}
+	if got, want := j.take(), []string{"up slow"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ran %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,101 @@
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const sealedPrefix = "enc:v1:"
+
+// Box encrypts secret values with AES-256-GCM using a key read from a local
+// key file, e.g. one generated with `openssl rand -hex 32`.
+type Box struct {
+	aead cipher.AEAD
+}
+
+func NewBoxFromKeyFile(path string) (*Box, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot read secrets key file : %v", err)
+	}
+
+	key, err := parseKey(strings.TrimSpace(string(raw)))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBox(key)
+}
+
+func NewBox(key []byte) (*Box, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Box{
+		aead: aead,
+	}, nil
+}
+
+func parseKey(s string) ([]byte, error) {
+	if key, err := hex.DecodeString(s); err == nil && len(key) == 32 {
+		return key, nil
+	}
+	if key, err := base64.StdEncoding.DecodeString(s); err == nil && len(key) == 32 {
+		return key, nil
+	}
+	if len(s) == 32 {
+		return []byte(s), nil
+	}
+	return nil, errors.New("secrets key must be 32 bytes, raw, hex or base64 encoded")
+}
+
+func (b *Box) IsSealed(value string) bool {
+	return strings.HasPrefix(value, sealedPrefix)
+}
+
+func (b *Box) Seal(plaintext string) (string, error) {
+	nonce := make([]byte, b.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := b.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return sealedPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (b *Box) Open(value string) (string, error) {
+	encoded, ok := strings.CutPrefix(value, sealedPrefix)
+	if !ok {
+		return "", errors.New("value is not sealed")
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", err
+	}
+
+	nonceSize := b.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", errors.New("sealed value is too short")
+	}
+
+	plaintext, err := b.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
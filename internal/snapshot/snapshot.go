@@ -0,0 +1,89 @@
+// Package snapshot encodes the whole configuration into a single archive:
+//
+//	snapshot.json     every document, as relaxed extended JSON
+//	blobs/<sha256>    the content of every file
+//
+// The documents keep the field names of the storage, so a snapshot can be
+// restored into any backend.
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/CSKU-Lab/config-server/domain/models"
+	"github.com/CSKU-Lab/config-server/internal/manifest"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+const (
+	Format = "config-server-snapshot"
+
+	documentName = "snapshot.json"
+	blobsDir     = "blobs/"
+)
+
+var ErrInvalid = errors.New("invalid snapshot")
+
+// Limits bound what reading a snapshot may extract. Blobs make snapshots far
+// bigger than the archive of a single runner or compare.
+var Limits = manifest.Limits{Size: 4 << 30, Entries: 1 << 20}
+
+type document struct {
+	Format          string `bson:"format"`
+	models.Snapshot `bson:",inline"`
+}
+
+// Write writes the snapshot and the blobs it references as a tar.gz archive.
+func Write(ctx context.Context, w io.Writer, snapshot *models.Snapshot, blobs models.BlobReader) error {
+	data, err := bson.MarshalExtJSONIndent(&document{Format: Format, Snapshot: *snapshot}, false, false, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tree := manifest.Tree{documentName: {Data: data, Mode: 0o644}}
+	for _, digest := range snapshot.Digests() {
+		blob, err := blobs.Get(ctx, digest)
+		if err != nil {
+			return fmt.Errorf("Cannot read blob %s : %w", digest, err)
+		}
+		tree[blobsDir+digest] = manifest.Entry{Data: blob, Mode: 0o644}
+	}
+
+	return manifest.WriteArchive(w, manifest.FormatTarGz, tree)
+}
+
+// Read reads a snapshot archive and returns the blobs it holds keyed by their
+// digest. Snapshots extracting past Limits fail with manifest.ErrTooLarge.
+func Read(data []byte) (*models.Snapshot, map[string][]byte, error) {
+	tree, err := manifest.ReadArchive(data, Limits)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+
+	entry, ok := tree[documentName]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %s is missing", ErrInvalid, documentName)
+	}
+
+	var doc document
+	err = bson.UnmarshalExtJSON(entry.Data, false, &doc)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	if doc.Format != Format {
+		return nil, nil, fmt.Errorf("%w: unknown format %q", ErrInvalid, doc.Format)
+	}
+
+	blobs := map[string][]byte{}
+	for p, entry := range tree {
+		if digest, ok := strings.CutPrefix(p, blobsDir); ok {
+			blobs[digest] = entry.Data
+		}
+	}
+
+	return &doc.Snapshot, blobs, nil
+}
@@ -1,19 +1,26 @@
 #!/bin/bash
 
-# grpcurl -plaintext -d '{"name" : "C", "version": "98", "build_script" "run_script": "python3 main.py"}' \
-#   localhost:8081 \
-#   config.v1.ConfigService.AddLanguage
+# configctl (go run ./cmd/configctl) covers most of these, grpcurl is handy
+# for RPCs it doesn't.
 
-# grpcurl -plaintext -d '{"id" : "python_3.11.2", "run_script": "#!/bin/bash\n\npython3 main.py"}' \
-#   localhost:8081 \
-#   config.v1.ConfigService.UpdateLanguage
+ADDR=${CONFIG_SERVER_ADDR:-localhost:8081}
+AUTH=()
+if [ -n "$CONFIG_SERVER_TOKEN" ]; then
+  AUTH=(-H "authorization: Bearer $CONFIG_SERVER_TOKEN")
+fi
 
+# grpcurl -plaintext "${AUTH[@]}" -d '{"name": "python-3.12", "description": "Python 3.12"}' \
+#   "$ADDR" \
+#   config.v1.ConfigService.CreateRunner
 
-grpcurl -plaintext -d '{"include_name" : true, "include_version" : true}' \
-  localhost:8081 \
-  config.v1.ConfigService.GetLanguages
+# grpcurl -plaintext "${AUTH[@]}" -d '{"id": "RUNNER_ID", "run_script": "#!/bin/bash\n\npython3 main.py"}' \
+#   "$ADDR" \
+#   config.v1.ConfigService.UpdateRunner
 
+grpcurl -plaintext "${AUTH[@]}" -d '{"include_metadata": true}' \
+  "$ADDR" \
+  config.v1.ConfigService.GetAllRunners
 
-# grpcurl -plaintext -d '{"id" : "test_1"}' \
-#   localhost:8081 \
-#   config.v1.ConfigService.DeleteLanguage
+# grpcurl -plaintext "${AUTH[@]}" -d '{"id": "RUNNER_ID"}' \
+#   "$ADDR" \
+#   config.v1.ConfigService.DeleteRunner
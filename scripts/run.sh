@@ -1,3 +1,3 @@
 #!/bin/zsh
 
-doppler run -- go run cmd/grpc/grpc.go
+doppler run -- go run ./cmd/grpc